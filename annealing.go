@@ -0,0 +1,78 @@
+package munkres
+
+import (
+	"math"
+	"math/rand"
+)
+
+// The fraction of its initial temperature to which SolveAnnealing cools.
+const finalTemperature = 1e-3
+
+// An AnnealingProblem describes a variant of the assignment problem and
+// the schedule of the search for SolveAnnealing.
+type AnnealingProblem struct {
+	LocalSearchProblem
+	// Temperature is the initial temperature of the search, which must be
+	// positive. It cools geometrically to a thousandth of this.
+	Temperature float64
+	// Steps is the number of moves proposed, which must not be negative.
+	Steps int
+}
+
+// Check that the problem is valid.
+func (p *AnnealingProblem) valid() bool {
+	return p.LocalSearchProblem.valid() && p.Temperature > 0 &&
+		!math.IsInf(p.Temperature, 1) && p.Steps >= 0
+}
+
+// SolveAnnealing approximately solves a variant of the assignment problem
+// whose side constraints break the structure that the Hungarian algorithm
+// relies on, by simulated annealing. The search starts from the optimal
+// assignment without the side constraints, found by the Hungarian
+// algorithm, and each step proposes to exchange the jobs of two workers of
+// the padded square matrix, so that a worker may also move to a job no
+// worker has or give its job to an unassigned worker. A move is accepted
+// as by the Metropolis algorithm, on the objective plus the penalized
+// violation of the constraints, with the temperature falling from step to
+// step. Random numbers are drawn from rng.
+//
+// costMatrix is subject to the same restrictions as for
+// NewHungarianAlgorithm, and the problem must be valid, or
+// ErrorInvalidOption is returned.
+//
+// return the assignment of least objective value found which satisfies
+// every constraint, which assigns as many workers as Execute would, and
+// its value; or ErrorInfeasible if none was found.
+func SolveAnnealing(costMatrix [][]float64, problem AnnealingProblem, rng *rand.Rand) (Assignment, float64, error) {
+	h, err := NewHungarianAlgorithm(costMatrix)
+	if err != nil {
+		return nil, 0, err
+	}
+	if !problem.valid() {
+		return nil, 0, ErrorInvalidOption
+	}
+	s := newLocalSearch(costMatrix, h.Execute(), &problem.LocalSearchProblem)
+	dim := len(s.jobByWorker)
+	energy := s.energy(s.value, s.violation)
+	for step := 0; step < problem.Steps && dim > 1; step++ {
+		x, y := rng.Intn(dim), rng.Intn(dim-1)
+		if y >= x {
+			y++
+		}
+		if !s.moves(x, y) {
+			continue
+		}
+		s.exchange(x, y)
+		value, violation := s.evaluate()
+		e := s.energy(value, violation)
+		temperature := problem.Temperature *
+			math.Pow(finalTemperature, float64(step)/float64(problem.Steps))
+		if e > energy && rng.Float64() >= math.Exp((energy-e)/temperature) {
+			s.exchange(x, y)
+			continue
+		}
+		s.value, s.violation, energy = value, violation, e
+		s.record()
+	}
+	return s.result()
+}
@@ -0,0 +1,114 @@
+package munkres_test
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/charles-haynes/munkres"
+)
+
+// return a constraint that the assignment covers at least minimum[k] jobs
+// of each class k, as for SolveCoverage.
+func coverageConstraint(classOf, minimum []int) func(munkres.Assignment) float64 {
+	return func(a munkres.Assignment) float64 {
+		covered := make([]int, len(minimum))
+		for _, j := range a {
+			if j != -1 && classOf[j] >= 0 {
+				covered[classOf[j]]++
+			}
+		}
+		violation := 0.0
+		for k := range minimum {
+			violation += math.Max(0, float64(minimum[k]-covered[k]))
+		}
+		return violation
+	}
+}
+
+func TestSolveAnnealing(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 50; i++ {
+		c := randomMatrix(rng, 1+rng.Intn(5), 1+rng.Intn(5))
+		cols := len(c[0])
+		classOf := make([]int, cols)
+		for j := range classOf {
+			classOf[j] = rng.Intn(3) - 1
+		}
+		minimum := []int{rng.Intn(2), rng.Intn(2)}
+		want, feasible := bruteForceCoverage(c, classOf, minimum)
+		a, got, err := munkres.SolveAnnealing(c, munkres.AnnealingProblem{
+			LocalSearchProblem: munkres.LocalSearchProblem{
+				Constraints: []func(munkres.Assignment) float64{
+					coverageConstraint(classOf, minimum),
+				},
+				Penalty: 10,
+			},
+			Temperature: 10,
+			Steps:       5000,
+		}, rng)
+		if !feasible {
+			if !errors.Is(err, munkres.ErrorInfeasible) {
+				t.Errorf("%v %v %v: want %v got %v", c, classOf, minimum,
+					munkres.ErrorInfeasible, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%v %v %v: %v", c, classOf, minimum, err)
+		}
+		cost, _ := munkres.ComputeCost(c, a)
+		h, _ := munkres.NewHungarianAlgorithm(c)
+		if got != want || cost != got || count(a) != count(h.Execute()) {
+			t.Errorf("%v %v %v: want %f got %v cost %f", c, classOf, minimum,
+				want, a, got)
+		}
+	}
+}
+
+// return an objective of the greatest cost of any assigned worker.
+func greatestCost(c [][]float64) func(munkres.Assignment) float64 {
+	return func(a munkres.Assignment) float64 {
+		greatest := 0.0
+		for w, j := range a {
+			if j != -1 {
+				greatest = math.Max(greatest, c[w][j])
+			}
+		}
+		return greatest
+	}
+}
+
+func TestSolveAnnealingObjective(t *testing.T) {
+	// Minimize the greatest cost rather than the total.
+	c := [][]float64{{0, 5}, {1, 9}}
+	a, got, err := munkres.SolveAnnealing(c, munkres.AnnealingProblem{
+		LocalSearchProblem: munkres.LocalSearchProblem{
+			Objective: greatestCost(c),
+			Penalty:   1,
+		},
+		Temperature: 1,
+		Steps:       100,
+	}, rand.New(rand.NewSource(1)))
+	if err != nil || got != 5 || a[0] != 1 || a[1] != 0 {
+		t.Errorf("want [1 0] 5 got %v %f, %v", a, got, err)
+	}
+}
+
+func TestSolveAnnealingErrors(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	c := [][]float64{{1, 2}, {2, 10}}
+	for _, p := range []munkres.AnnealingProblem{
+		{LocalSearchProblem: munkres.LocalSearchProblem{Penalty: 0}, Temperature: 1},
+		{LocalSearchProblem: munkres.LocalSearchProblem{Penalty: 1}, Temperature: math.Inf(1)},
+		{LocalSearchProblem: munkres.LocalSearchProblem{Penalty: 1}, Temperature: 1, Steps: -1},
+	} {
+		if _, _, err := munkres.SolveAnnealing(c, p, rng); err != munkres.ErrorInvalidOption {
+			t.Errorf("%+v: want %v got %v", p, munkres.ErrorInvalidOption, err)
+		}
+	}
+	if _, _, err := munkres.SolveAnnealing([][]float64{{1, 2}, {3}}, munkres.AnnealingProblem{LocalSearchProblem: munkres.LocalSearchProblem{Penalty: 1}, Temperature: 1}, rng); !errors.Is(err, munkres.ErrorIrregularCostMatrix) {
+		t.Errorf("want %v got %v", munkres.ErrorIrregularCostMatrix, err)
+	}
+}
@@ -0,0 +1,103 @@
+package munkres
+
+import "math"
+
+// The Quality of an approximate assignment bounds how far it can be from
+// optimal.
+type Quality struct {
+	// Cost is the total cost of the assignment.
+	Cost float64
+	// LowerBound is a lower bound on the cost of an optimal assignment.
+	LowerBound float64
+	// Gap is Cost - LowerBound, an upper bound on how much more the
+	// assignment costs than an optimal one.
+	Gap float64
+}
+
+// Gap returns how much more an assignment, such as one made by a greedy
+// or legacy allocator, costs than an optimal one for the cost matrix,
+// found by solving it. The assignment must be valid for the matrix, as
+// for ComputeCost, and complete: it must assign as many workers as there
+// are workers or jobs, whichever is fewer, since leaving workers out
+// would make it cheaper than an optimal one.
+//
+// return the errors of ComputeCost and of NewHungarianAlgorithm, or
+// ErrorIncompleteAssignment.
+func Gap(costMatrix [][]float64, assignment []int) (float64, error) {
+	cost, err := ComputeCost(costMatrix, assignment)
+	if err != nil {
+		return 0, err
+	}
+	h, err := NewHungarianAlgorithm(costMatrix)
+	if err != nil {
+		return 0, err
+	}
+	optimal := h.Execute()
+	assigned := 0
+	for _, j := range assignment {
+		if j != -1 {
+			assigned++
+		}
+	}
+	if assigned < h.rows && assigned < h.cols {
+		return 0, ErrorIncompleteAssignment
+	}
+	return cost - Assignment(optimal).Cost(costMatrix), nil
+}
+
+// return the quality of the assignment for the cost matrix.
+func quality(costMatrix [][]float64, a Assignment) Quality {
+	q := Quality{Cost: a.Cost(costMatrix), LowerBound: lowerBound(costMatrix)}
+	q.Gap = q.Cost - q.LowerBound
+	return q
+}
+
+// return a lower bound on the cost of an optimal assignment, found in time
+// O(n^2) as the value of a dual feasible solution. Every worker of a cost
+// matrix with at least as many jobs as workers is assigned, so the least
+// cost of each worker is a dual feasible price for it; if the matrix is
+// square the remaining least cost of each job is also a feasible price
+// for it. Similarly with the roles of workers and jobs exchanged. The
+// better of these is returned.
+func lowerBound(costMatrix [][]float64) float64 {
+	rows, cols := len(costMatrix), 0
+	if rows > 0 {
+		cols = len(costMatrix[0])
+	}
+	best := math.Inf(-1)
+	if rows <= cols {
+		best = reductionBound(rows, cols, rows == cols,
+			func(w, j int) float64 { return costMatrix[w][j] })
+	}
+	if cols <= rows {
+		best = math.Max(best, reductionBound(cols, rows, rows == cols,
+			func(j, w int) float64 { return costMatrix[w][j] }))
+	}
+	return best
+}
+
+// return the sum of the least cost of each of n rows of an n by m matrix
+// and, if both is true, of the least remaining cost of each column after
+// subtracting those.
+func reductionBound(n, m int, both bool, cost func(i, k int) float64) float64 {
+	total := 0.0
+	least := make([]float64, n)
+	for i := 0; i < n; i++ {
+		least[i] = math.Inf(1)
+		for k := 0; k < m; k++ {
+			least[i] = math.Min(least[i], cost(i, k))
+		}
+		total += least[i]
+	}
+	if !both {
+		return total
+	}
+	for k := 0; k < m; k++ {
+		remaining := math.Inf(1)
+		for i := 0; i < n; i++ {
+			remaining = math.Min(remaining, cost(i, k)-least[i])
+		}
+		total += remaining
+	}
+	return total
+}
@@ -0,0 +1,109 @@
+package munkres
+
+// An Allocator supplies the memory for the internal state of the
+// algorithm. Each method returns a zeroed slice of length n. None of the
+// slices contain pointers, so memory from an Allocator is never scanned
+// by the garbage collector.
+//
+// An Allocator may be backed by an Arena, or by the experimental Go
+// arena package through arena.MakeSlice.
+type Allocator interface {
+	Float64s(n int) []float64
+	Ints(n int) []int
+	Bools(n int) []bool
+}
+
+// WithAllocator makes NewHungarianAlgorithm allocate its internal state,
+// including its copy of the cost matrix, from a. The algorithm must not
+// be used once that memory has been freed.
+func WithAllocator(a Allocator) Option {
+	return func(o *options) {
+		o.allocator = a
+	}
+}
+
+// The default Allocator, which allocates from the heap.
+type heapAllocator struct{}
+
+func (heapAllocator) Float64s(n int) []float64 { return make([]float64, n) }
+func (heapAllocator) Ints(n int) []int         { return make([]int, n) }
+func (heapAllocator) Bools(n int) []bool       { return make([]bool, n) }
+
+// An Arena is a bump Allocator. Allocation takes the next free elements
+// of a slab, which grows by doubling, and Reset frees everything that
+// has been allocated in time O(1), ready for the next solve. An Arena is
+// not safe for concurrent use.
+type Arena struct {
+	floats []float64
+	ints   []int
+	bools  []bool
+}
+
+// NewArena returns an Arena whose slabs initially have room for the
+// state of an n x n problem, so that solves of up to that size allocate
+// nothing further after the first.
+func NewArena(n int) *Arena {
+	return &Arena{
+		floats: make([]float64, 0, n*n+5*n),
+		ints:   make([]int, 0, 6*n),
+		bools:  make([]bool, 0, n),
+	}
+}
+
+// Reset frees everything allocated from the arena. Nothing allocated
+// before the reset may be used afterwards.
+func (a *Arena) Reset() {
+	a.floats = a.floats[:0]
+	a.ints = a.ints[:0]
+	a.bools = a.bools[:0]
+}
+
+// Float64s returns a zeroed slice of n float64s from the arena.
+func (a *Arena) Float64s(n int) []float64 {
+	if len(a.floats)+n > cap(a.floats) {
+		// Start a new slab; anything already allocated from the old
+		// one keeps it alive until it is no longer used.
+		a.floats = make([]float64, 0, grow(cap(a.floats), n))
+	}
+	s := a.floats[len(a.floats) : len(a.floats)+n : len(a.floats)+n]
+	a.floats = a.floats[:len(a.floats)+n]
+	for i := range s {
+		s[i] = 0
+	}
+	return s
+}
+
+// Ints returns a zeroed slice of n ints from the arena.
+func (a *Arena) Ints(n int) []int {
+	if len(a.ints)+n > cap(a.ints) {
+		a.ints = make([]int, 0, grow(cap(a.ints), n))
+	}
+	s := a.ints[len(a.ints) : len(a.ints)+n : len(a.ints)+n]
+	a.ints = a.ints[:len(a.ints)+n]
+	for i := range s {
+		s[i] = 0
+	}
+	return s
+}
+
+// Bools returns a zeroed slice of n bools from the arena.
+func (a *Arena) Bools(n int) []bool {
+	if len(a.bools)+n > cap(a.bools) {
+		a.bools = make([]bool, 0, grow(cap(a.bools), n))
+	}
+	s := a.bools[len(a.bools) : len(a.bools)+n : len(a.bools)+n]
+	a.bools = a.bools[:len(a.bools)+n]
+	for i := range s {
+		s[i] = false
+	}
+	return s
+}
+
+// return the capacity of a new slab to replace one of capacity c which
+// has no room for n more elements.
+func grow(c, n int) int {
+	if c *= 2; c < n {
+		c = n
+	}
+	return c
+}
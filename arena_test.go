@@ -0,0 +1,46 @@
+package munkres_test
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/charles-haynes/munkres"
+)
+
+func TestArena(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for _, arena := range []*munkres.Arena{munkres.NewArena(0), munkres.NewArena(12)} {
+		for i := 0; i < 50; i++ {
+			c := randomMatrix(rng, 1+rng.Intn(12), 1+rng.Intn(12))
+			h, _ := munkres.NewHungarianAlgorithm(c)
+			want := h.Execute()
+			h, err := munkres.NewHungarianAlgorithm(c, munkres.WithAllocator(arena))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := h.Execute(); !reflect.DeepEqual(got, want) {
+				t.Errorf("%v: got %v, want %v", c, got, want)
+			}
+			arena.Reset()
+		}
+	}
+}
+
+func TestArenaZeroes(t *testing.T) {
+	arena := munkres.NewArena(2)
+	f, n, b := arena.Float64s(3), arena.Ints(3), arena.Bools(3)
+	for i := range f {
+		f[i], n[i], b[i] = 1, 1, true
+	}
+	arena.Reset()
+	if got := arena.Float64s(3); !reflect.DeepEqual(got, []float64{0, 0, 0}) {
+		t.Errorf("want zeroed float64s got %v", got)
+	}
+	if got := arena.Ints(3); !reflect.DeepEqual(got, []int{0, 0, 0}) {
+		t.Errorf("want zeroed ints got %v", got)
+	}
+	if got := arena.Bools(3); !reflect.DeepEqual(got, []bool{false, false, false}) {
+		t.Errorf("want zeroed bools got %v", got)
+	}
+}
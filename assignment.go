@@ -0,0 +1,108 @@
+package munkres
+
+// An Assignment gives, for each worker, the job assigned to it, or -1 if
+// the worker is unassigned, as returned by Execute.
+type Assignment []int
+
+// Cost returns the total cost of the assignment for the cost matrix.
+func (a Assignment) Cost(costMatrix [][]float64) float64 {
+	total := 0.0
+	for w, j := range a {
+		if j != -1 {
+			total += costMatrix[w][j]
+		}
+	}
+	return total
+}
+
+// AssignmentFromPairs builds the assignment of rows workers to cols jobs
+// which assigns each pair's worker, its first element, to its job, its
+// second, leaving the workers in no pair unassigned, so that matchings
+// produced elsewhere or edited by hand can be costed and compared like
+// those returned by the solvers.
+//
+// return ErrorDimensionMismatch if a pair is not of a worker and a job of
+// the matrix, or ErrorDuplicateWorker or ErrorDuplicateJob if a worker or
+// job is in more than one pair.
+func AssignmentFromPairs(rows, cols int, pairs [][2]int) (Assignment, error) {
+	if rows < 0 || cols < 0 {
+		return nil, ErrorDimensionMismatch
+	}
+	a := Assignment(unassigned(rows))
+	assigned := make([]bool, cols)
+	for _, p := range pairs {
+		w, j := p[0], p[1]
+		if w < 0 || w >= rows || j < 0 || j >= cols {
+			return nil, ErrorDimensionMismatch
+		}
+		if a[w] != -1 {
+			return nil, ErrorDuplicateWorker
+		}
+		if assigned[j] {
+			return nil, ErrorDuplicateJob
+		}
+		a[w], assigned[j] = j, true
+	}
+	return a, nil
+}
+
+// ComputeCost returns the total cost of an assignment for the cost
+// matrix, as Assignment.Cost does, after checking that it is a valid
+// assignment for the matrix, such as one produced elsewhere: that it has
+// an entry for each worker, each of which is -1 or a job of the matrix,
+// and that no job is assigned to more than one worker. Forbidden (+Inf)
+// costs are allowed, so an assignment which uses one costs +Inf.
+//
+// return ErrorDimensionMismatch if the assignment does not fit the
+// matrix, or ErrorDuplicateJob if it assigns a job twice, or the error
+// from checking the matrix as for NewHungarianAlgorithm.
+func ComputeCost(costMatrix [][]float64, assignment []int) (float64, error) {
+	if err := checkCostMatrix(costMatrix, true); err != nil {
+		return 0, err
+	}
+	cols := 0
+	if len(costMatrix) > 0 {
+		cols = len(costMatrix[0])
+	}
+	a := Assignment(assignment)
+	if err := a.check(len(costMatrix), cols); err != nil {
+		return 0, err
+	}
+	return a.Cost(costMatrix), nil
+}
+
+// Check that a is an assignment of rows workers to cols jobs.
+func (a Assignment) check(rows, cols int) error {
+	if len(a) != rows {
+		return ErrorDimensionMismatch
+	}
+	assigned := make([]bool, cols)
+	for _, j := range a {
+		if j == -1 {
+			continue
+		}
+		if j < 0 || j >= cols {
+			return ErrorDimensionMismatch
+		}
+		if assigned[j] {
+			return ErrorDuplicateJob
+		}
+		assigned[j] = true
+	}
+	return nil
+}
+
+// PermutationMatrix returns the assignment as a dense 0/1 matrix with a
+// row for each worker and cols columns, holding 1 where a worker is
+// assigned a job, so that it can be used directly in matrix pipelines,
+// such as to reorder the rows of a confusion matrix.
+func (a Assignment) PermutationMatrix(cols int) [][]float64 {
+	m := make([][]float64, len(a))
+	for w, j := range a {
+		m[w] = make([]float64, cols)
+		if j != -1 {
+			m[w][j] = 1
+		}
+	}
+	return m
+}
@@ -0,0 +1,70 @@
+package munkres_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/charles-haynes/munkres"
+)
+
+func TestPermutationMatrix(t *testing.T) {
+	got := munkres.Assignment{2, -1, 0}.PermutationMatrix(3)
+	want := [][]float64{
+		{0, 0, 1},
+		{0, 0, 0},
+		{1, 0, 0},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("want %v got %v", want, got)
+	}
+}
+
+func TestComputeCost(t *testing.T) {
+	c := [][]float64{{1, 2, 3}, {4, 5, 6}}
+	for _, d := range []struct {
+		name       string
+		assignment []int
+		cost       float64
+		err        error
+	}{
+		{"complete", []int{2, 0}, 7, nil},
+		{"unassigned", []int{-1, 1}, 5, nil},
+		{"too short", []int{0}, 0, munkres.ErrorDimensionMismatch},
+		{"job out of range", []int{3, 0}, 0, munkres.ErrorDimensionMismatch},
+		{"negative job", []int{-2, 0}, 0, munkres.ErrorDimensionMismatch},
+		{"duplicate job", []int{1, 1}, 0, munkres.ErrorDuplicateJob},
+	} {
+		cost, err := munkres.ComputeCost(c, d.assignment)
+		if err != d.err || cost != d.cost {
+			t.Errorf("%s: want %v, %v got %v, %v", d.name, d.cost, d.err, cost, err)
+		}
+	}
+	if _, err := munkres.ComputeCost([][]float64{{1, 2}, {3}}, []int{0, 0}); !errors.Is(err, munkres.ErrorIrregularCostMatrix) {
+		t.Errorf("want err = %s got %v", munkres.ErrorIrregularCostMatrix, err)
+	}
+}
+
+func TestAssignmentFromPairs(t *testing.T) {
+	a, err := munkres.AssignmentFromPairs(3, 2, [][2]int{{2, 0}, {0, 1}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (munkres.Assignment{1, -1, 0}); !reflect.DeepEqual(a, want) {
+		t.Errorf("want %v got %v", want, a)
+	}
+	for _, d := range []struct {
+		name  string
+		pairs [][2]int
+		err   error
+	}{
+		{"worker out of range", [][2]int{{3, 0}}, munkres.ErrorDimensionMismatch},
+		{"job out of range", [][2]int{{0, -1}}, munkres.ErrorDimensionMismatch},
+		{"duplicate worker", [][2]int{{0, 0}, {0, 1}}, munkres.ErrorDuplicateWorker},
+		{"duplicate job", [][2]int{{0, 1}, {1, 1}}, munkres.ErrorDuplicateJob},
+	} {
+		if _, err := munkres.AssignmentFromPairs(3, 2, d.pairs); err != d.err {
+			t.Errorf("%s: want err = %v got %v", d.name, d.err, err)
+		}
+	}
+}
@@ -0,0 +1,229 @@
+package munkres
+
+import "math"
+
+// An AssociationResult is the outcome of SolveAssociation3D.
+type AssociationResult struct {
+	// Tracks are the associations found, each giving the index of its
+	// measurement in each of the three scans, where 0 means that the
+	// track was not detected in that scan.
+	Tracks [][3]int
+	// Cost is the total cost of Tracks, or +Inf if none were found.
+	Cost float64
+	// LowerBound is the best lower bound found on the cost of any
+	// association.
+	LowerBound float64
+	// Gap is Cost - LowerBound, the duality gap, which is zero if Tracks
+	// is known to be optimal.
+	Gap float64
+	// Multipliers are the Lagrange multipliers of the third scan's
+	// measurements which gave LowerBound, indexed as in costs.
+	Multipliers []float64
+}
+
+// SolveAssociation3D approximately solves the data association problem
+// for three scans of measurements, as in multi-target tracking, by the
+// Lagrangian relaxation of Deb, Pattipati and Poore. Each measurement of
+// each scan must be explained by exactly one track, and a track may be
+// missing from any of the scans, so that false alarms are tracks seen
+// in only one scan. (Association between just two scans is an assignment
+// problem, solved exactly by NewHungarianAlgorithm.)
+//
+// costs[i][j][k] holds the cost of the track through measurement i of
+// the first scan, j of the second and k of the third, where index 0 in
+// each scan stands for a missed detection, and so must have dimensions
+// (n1+1)×(n2+1)×(n3+1) and be finite. costs[0][0][0] is ignored.
+//
+// The constraints of the third scan are moved into the costs, weighted
+// by multipliers, leaving a two dimensional assignment problem which
+// gives a lower bound. A feasible association is recovered from each
+// relaxed solution by optimally assigning the third scan to its pairs.
+// The multipliers are updated by subgradient steps for up to the given
+// number of iterations, and the best association and bound are returned.
+func SolveAssociation3D(costs [][][]float64, iterations int) (AssociationResult, error) {
+	if len(costs) == 0 || len(costs[0]) == 0 || len(costs[0][0]) == 0 {
+		return AssociationResult{}, ErrorDimensionMismatch
+	}
+	n1, n2, n3 := len(costs)-1, len(costs[0])-1, len(costs[0][0])-1
+	for _, c := range costs {
+		if len(c) != n2+1 {
+			return AssociationResult{}, ErrorDimensionMismatch
+		}
+		if err := checkCostMatrix(c, false); err != nil {
+			return AssociationResult{}, err
+		}
+		if len(c[0]) != n3+1 {
+			return AssociationResult{}, ErrorDimensionMismatch
+		}
+	}
+
+	result := AssociationResult{
+		Cost:        math.Inf(1),
+		LowerBound:  math.Inf(-1),
+		Multipliers: make([]float64, n3+1),
+	}
+	multipliers := make([]float64, n3+1)
+	subgradient := make([]float64, n3+1)
+	// The relaxed cost of each pair of the first two scans, and the
+	// measurement of the third scan which achieves it.
+	relaxed := make([][]float64, n1+1)
+	best := make([][]int, n1+1)
+	for i := range relaxed {
+		relaxed[i] = make([]float64, n2+1)
+		best[i] = make([]int, n2+1)
+	}
+	theta, stalled := 2.0, 0
+	for it := 0; it < iterations; it++ {
+		for i := range costs {
+			for j := range costs[i] {
+				relaxed[i][j], best[i][j] = math.Inf(1), 0
+				for k, c := range costs[i][j] {
+					if r := c - multipliers[k]; r < relaxed[i][j] {
+						relaxed[i][j], best[i][j] = r, k
+					}
+				}
+			}
+		}
+		bound := 0.0
+		for k := 1; k <= n3; k++ {
+			bound += multipliers[k]
+			subgradient[k] = 1
+			// Tracks seen only in the third scan are unconstrained
+			// in the relaxation, so are taken if they pay.
+			if r := costs[0][0][k] - multipliers[k]; r < 0 {
+				bound += r
+				subgradient[k]--
+			}
+		}
+		pairs := associatePairs(relaxed)
+		for _, p := range pairs {
+			bound += relaxed[p[0]][p[1]]
+			if k := best[p[0]][p[1]]; k != 0 {
+				subgradient[k]--
+			}
+		}
+		if bound > result.LowerBound {
+			result.LowerBound = bound
+			copy(result.Multipliers, multipliers)
+			stalled = 0
+		} else if stalled++; stalled >= 5 {
+			theta /= 2
+			stalled = 0
+		}
+		if tracks, cost := recoverTracks(costs, pairs); cost < result.Cost {
+			result.Tracks, result.Cost = tracks, cost
+		}
+		norm := 0.0
+		for _, g := range subgradient[1:] {
+			norm += g * g
+		}
+		if norm == 0 || result.Cost-result.LowerBound <= 0 {
+			// The relaxed solution is feasible, and so optimal.
+			break
+		}
+		step := theta * (result.Cost - bound) / norm
+		for k := 1; k <= n3; k++ {
+			multipliers[k] += step * subgradient[k]
+		}
+	}
+	result.Gap = result.Cost - result.LowerBound
+	return result, nil
+}
+
+// Solve the association of the first two scans under the given pair
+// costs, indexed as for SolveAssociation3D.
+//
+// return the pairs associated, omitting (0, 0).
+func associatePairs(costs [][]float64) [][2]int {
+	paired := make([][]float64, len(costs)-1)
+	for i := range paired {
+		paired[i] = costs[i+1][1:]
+	}
+	missed := make([]float64, len(costs[0])-1)
+	for j := range missed {
+		missed[j] = costs[0][j+1]
+	}
+	unmatched := make([]float64, len(paired))
+	for i := range unmatched {
+		unmatched[i] = costs[i+1][0]
+	}
+	rows, cols := matchWithMisses(paired, unmatched, missed)
+	var pairs [][2]int
+	for i, j := range rows {
+		pairs = append(pairs, [2]int{i + 1, j + 1})
+	}
+	for j, i := range cols {
+		if i == -1 {
+			pairs = append(pairs, [2]int{0, j + 1})
+		}
+	}
+	return pairs
+}
+
+// Assign the third scan to the given pairs of the first two.
+//
+// return the tracks and their total cost.
+func recoverTracks(costs [][][]float64, pairs [][2]int) ([][3]int, float64) {
+	paired := make([][]float64, len(pairs))
+	unmatched := make([]float64, len(pairs))
+	for p, pair := range pairs {
+		paired[p] = costs[pair[0]][pair[1]][1:]
+		unmatched[p] = costs[pair[0]][pair[1]][0]
+	}
+	missed := costs[0][0][1:]
+	rows, cols := matchWithMisses(paired, unmatched, missed)
+	var tracks [][3]int
+	cost := 0.0
+	for p, k := range rows {
+		tracks = append(tracks, [3]int{pairs[p][0], pairs[p][1], k + 1})
+		cost += costs[pairs[p][0]][pairs[p][1]][k+1]
+	}
+	for k, p := range cols {
+		if p == -1 {
+			tracks = append(tracks, [3]int{0, 0, k + 1})
+			cost += missed[k]
+		}
+	}
+	return tracks, cost
+}
+
+// Solve the assignment problem in which worker w may be left unassigned
+// at a cost of unmatched[w], and job j at a cost of missed[j], by padding
+// the cost matrix with a dummy job for each worker and a dummy worker for
+// each job.
+//
+// return the job of each worker and the worker of each job, or -1 for
+// those left unassigned.
+func matchWithMisses(costMatrix [][]float64, unmatched, missed []float64) ([]int, []int) {
+	workers, jobs := len(unmatched), len(missed)
+	padded := make([][]float64, workers+jobs)
+	for w := range padded {
+		padded[w] = make([]float64, jobs+workers)
+		for j := range padded[w] {
+			padded[w][j] = math.Inf(1)
+		}
+	}
+	for w := 0; w < workers; w++ {
+		copy(padded[w], costMatrix[w])
+		padded[w][jobs+w] = unmatched[w]
+	}
+	for j := 0; j < jobs; j++ {
+		padded[workers+j][j] = missed[j]
+		for w := 0; w < workers; w++ {
+			padded[workers+j][jobs+w] = 0
+		}
+	}
+	h, _ := NewHungarianAlgorithm(padded, allowForbidden())
+	assignment := h.Execute()
+	rows, cols := make([]int, workers), make([]int, jobs)
+	for j := range cols {
+		cols[j] = -1
+	}
+	for w := range rows {
+		rows[w] = -1
+		if j := assignment[w]; j < jobs {
+			rows[w], cols[j] = j, w
+		}
+	}
+	return rows, cols
+}
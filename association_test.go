@@ -0,0 +1,141 @@
+package munkres_test
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/charles-haynes/munkres"
+)
+
+func randomAssociation(rng *rand.Rand, n1, n2, n3 int) [][][]float64 {
+	costs := make([][][]float64, n1+1)
+	for i := range costs {
+		costs[i] = randomMatrix(rng, n2+1, n3+1)
+	}
+	return costs
+}
+
+// return the optimal cost of the association problem by brute force.
+func bruteAssociation(costs [][][]float64) float64 {
+	n1, n2, n3 := len(costs)-1, len(costs[0])-1, len(costs[0][0])-1
+	usedJ, usedK := make([]bool, n2+1), make([]bool, n3+1)
+	best := math.Inf(1)
+	// Tracks with a measurement in the second scan but not the first.
+	var second func(j int, cost float64)
+	second = func(j int, cost float64) {
+		if j > n2 {
+			for k := 1; k <= n3; k++ {
+				if !usedK[k] {
+					cost += costs[0][0][k]
+				}
+			}
+			best = math.Min(best, cost)
+			return
+		}
+		if usedJ[j] {
+			second(j+1, cost)
+			return
+		}
+		for k := 0; k <= n3; k++ {
+			if k == 0 || !usedK[k] {
+				usedK[k] = k != 0
+				second(j+1, cost+costs[0][j][k])
+				usedK[k] = false
+			}
+		}
+	}
+	// Tracks with a measurement in the first scan.
+	var first func(i int, cost float64)
+	first = func(i int, cost float64) {
+		if i > n1 {
+			second(1, cost)
+			return
+		}
+		for j := 0; j <= n2; j++ {
+			for k := 0; k <= n3; k++ {
+				if j != 0 && usedJ[j] || k != 0 && usedK[k] {
+					continue
+				}
+				usedJ[j], usedK[k] = j != 0, k != 0
+				first(i+1, cost+costs[i][j][k])
+				usedJ[j], usedK[k] = false, false
+			}
+		}
+	}
+	first(1, 0)
+	return best
+}
+
+func TestSolveAssociation3D(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 50; i++ {
+		n1, n2, n3 := rng.Intn(4), rng.Intn(4), rng.Intn(4)
+		costs := randomAssociation(rng, n1, n2, n3)
+		res, err := munkres.SolveAssociation3D(costs, 50)
+		if err != nil {
+			t.Fatal(err)
+		}
+		// Every measurement is explained exactly once.
+		counts := [3][]int{make([]int, n1+1), make([]int, n2+1), make([]int, n3+1)}
+		cost := 0.0
+		for _, track := range res.Tracks {
+			for s, m := range track {
+				counts[s][m]++
+			}
+			cost += costs[track[0]][track[1]][track[2]]
+		}
+		for s := range counts {
+			for m, c := range counts[s][1:] {
+				if c != 1 {
+					t.Fatalf("scan %d measurement %d explained %d times in %v", s, m+1, c, res.Tracks)
+				}
+			}
+		}
+		if math.Abs(cost-res.Cost) > 1e-9 {
+			t.Errorf("want cost = %f got %f", cost, res.Cost)
+		}
+		best := bruteAssociation(costs)
+		if res.LowerBound > best+1e-9 || res.Cost < best-1e-9 {
+			t.Errorf("want bound %f <= optimum %f <= cost %f", res.LowerBound, best, res.Cost)
+		}
+		if res.Gap != res.Cost-res.LowerBound {
+			t.Errorf("want gap = %f got %f", res.Cost-res.LowerBound, res.Gap)
+		}
+	}
+}
+
+func TestSolveAssociation3DTracks(t *testing.T) {
+	// Two targets seen in every scan, whose tracks are cheap, and
+	// expensive missed detections, so the association is exact.
+	costs := randomAssociation(rand.New(rand.NewSource(1)), 2, 2, 2)
+	for i := range costs {
+		for j := range costs[i] {
+			for k := range costs[i][j] {
+				costs[i][j][k] = 100
+			}
+		}
+	}
+	costs[1][2][1], costs[2][1][2] = 1, 1
+	res, err := munkres.SolveAssociation3D(costs, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Cost != 2 || res.Gap > 1e-9 {
+		t.Errorf("want cost = 2 with no gap got %v", res)
+	}
+}
+
+func TestSolveAssociation3DErrors(t *testing.T) {
+	if _, err := munkres.SolveAssociation3D(nil, 1); err != munkres.ErrorDimensionMismatch {
+		t.Errorf("want err = %s got %v", munkres.ErrorDimensionMismatch, err)
+	}
+	irregular := [][][]float64{{{0, 1}, {1, 1}}, {{1, 1}}}
+	if _, err := munkres.SolveAssociation3D(irregular, 1); err != munkres.ErrorDimensionMismatch {
+		t.Errorf("want err = %s got %v", munkres.ErrorDimensionMismatch, err)
+	}
+	if _, err := munkres.SolveAssociation3D([][][]float64{{{0, math.Inf(1)}}}, 1); !errors.Is(err, munkres.ErrorInfiniteCost) {
+		t.Errorf("want err = %s got %v", munkres.ErrorInfiniteCost, err)
+	}
+}
@@ -0,0 +1,262 @@
+package munkres
+
+import (
+	"math"
+	"sync"
+)
+
+// The schedule of epsilon values used by the auction algorithm: epsilon
+// starts at start and is multiplied by factor after each round of bidding
+// until it is no greater than end.
+type epsilonSchedule struct {
+	start, factor, end float64
+}
+
+func (s epsilonSchedule) valid() bool {
+	return s.end > 0 && s.start >= s.end && s.factor > 0 && s.factor < 1
+}
+
+// WithEpsilonScaling sets the schedule of epsilon values used by
+// SolveAuction. Bidding starts with a coarse epsilon of start, which
+// quickly settles on approximately right prices, and repeats with epsilon
+// multiplied by factor each time until it is no greater than end, so that
+// each round only has to refine the prices of the round before. Without
+// scaling the auction algorithm stalls badly on cost matrices with a
+// large range of costs.
+//
+// start must be at least end, end must be positive and factor must be
+// between 0 and 1; otherwise SolveAuction returns ErrorInvalidOption.
+func WithEpsilonScaling(start, factor, end float64) Option {
+	return func(o *options) {
+		o.epsilon = epsilonSchedule{start: start, factor: factor, end: end}
+	}
+}
+
+// return the default epsilon schedule for a square cost matrix: starting
+// at a quarter of the range of costs and shrinking by a factor of five,
+// down to less than 1/n if all costs are integers, which makes the result
+// optimal, and to a negligible fraction of the range of costs otherwise.
+func defaultEpsilonSchedule(costMatrix [][]float64) epsilonSchedule {
+	lo, hi := math.Inf(1), math.Inf(-1)
+	integral := true
+	for w := range costMatrix {
+		for _, c := range costMatrix[w] {
+			lo, hi = math.Min(lo, c), math.Max(hi, c)
+			integral = integral && c == math.Trunc(c)
+		}
+	}
+	n := float64(len(costMatrix))
+	end := (hi - lo) * 1e-9 / n
+	if integral {
+		end = 1 / (n + 1)
+	}
+	if end == 0 {
+		end = 1 / (n + 1)
+	}
+	return epsilonSchedule{
+		start:  math.Max((hi-lo)/4, end),
+		factor: 0.2,
+		end:    end,
+	}
+}
+
+// SolveAuction solves the assignment problem with Bertsekas's auction
+// algorithm, in which unassigned workers bid for their most profitable
+// jobs, raising the prices of the jobs by the difference between the best
+// and second best profits plus epsilon, until every worker is assigned.
+// Bidding is repeated with epsilon shrinking according to the schedule
+// set by WithEpsilonScaling.
+//
+// WithParallelism makes the workers bid simultaneously, with their bids
+// computed by the given number of goroutines, after which each job goes
+// to its highest bidder. This is the Jacobi variant of the algorithm,
+// which does more bidding in total than the serial Gauss-Seidel variant
+// but is much faster on large problems with several cores.
+//
+// The total cost of the result is within n times the final epsilon of
+// the optimum, where n is the larger of the number of workers and jobs; so with the
+// default schedule the result is optimal if all costs are integers, and
+// very nearly optimal otherwise. The cost matrix is padded to be square,
+// as for Execute, and is subject to the same restrictions as for
+// NewHungarianAlgorithm.
+func SolveAuction(costMatrix [][]float64, opts ...Option) (Assignment, error) {
+	if err := checkCostMatrix(costMatrix, false); err != nil {
+		return nil, err
+	}
+	o := newOptions(opts)
+	rows, cols := len(costMatrix), 0
+	if rows > 0 {
+		cols = len(costMatrix[0])
+	}
+	if err := o.check(rows, cols); err != nil {
+		return nil, err
+	}
+	if rows == 0 {
+		return nil, nil
+	}
+	a := newAuction(costMatrix)
+	schedule := o.epsilon
+	if schedule == (epsilonSchedule{}) {
+		schedule = defaultEpsilonSchedule(a.benefit)
+	}
+	for epsilon := schedule.start; ; epsilon *= schedule.factor {
+		epsilon = math.Max(epsilon, schedule.end)
+		if o.parallelism > 1 {
+			a.bidParallel(epsilon, o.parallelism)
+		} else {
+			a.bid(epsilon)
+		}
+		if epsilon <= schedule.end {
+			break
+		}
+	}
+	result := Assignment(a.jobByWorker[:rows])
+	for w, j := range result {
+		if j >= cols {
+			result[w] = -1
+		}
+	}
+	return result, nil
+}
+
+// The state of the auction algorithm for a square matrix of benefits, the
+// negated costs of the padded cost matrix.
+type auction struct {
+	benefit                  [][]float64
+	price                    []float64
+	jobByWorker, workerByJob []int
+}
+
+func newAuction(costMatrix [][]float64) *auction {
+	dim := len(costMatrix)
+	if len(costMatrix[0]) > dim {
+		dim = len(costMatrix[0])
+	}
+	a := &auction{
+		benefit:     make([][]float64, dim),
+		price:       make([]float64, dim),
+		jobByWorker: make([]int, dim),
+		workerByJob: make([]int, dim),
+	}
+	for w := range a.benefit {
+		a.benefit[w] = make([]float64, dim)
+		if w < len(costMatrix) {
+			for j, c := range costMatrix[w] {
+				a.benefit[w][j] = -c
+			}
+		}
+	}
+	return a
+}
+
+// Start a round of bidding with no assignment.
+//
+// return every worker, as they are all unassigned.
+func (a *auction) reset() []int {
+	unassigned := make([]int, len(a.jobByWorker))
+	for i := range a.jobByWorker {
+		a.jobByWorker[i] = -1
+		a.workerByJob[i] = -1
+		unassigned[i] = i
+	}
+	return unassigned
+}
+
+// return the most profitable job for worker w at the current prices, and
+// the price at which the worker bids for it: the price at which it would
+// be as profitable as the second most profitable job, plus epsilon.
+func (a *auction) bestBid(w int, epsilon float64) (int, float64) {
+	best, second := math.Inf(-1), math.Inf(-1)
+	bestJob := -1
+	for j, b := range a.benefit[w] {
+		profit := b - a.price[j]
+		if profit > best {
+			best, second, bestJob = profit, best, j
+		} else if profit > second {
+			second = profit
+		}
+	}
+	if math.IsInf(second, -1) {
+		// With a single job any raise will do.
+		second = best
+	}
+	return bestJob, a.price[bestJob] + best - second + epsilon
+}
+
+// Assign job j to worker w at the given price.
+//
+// return the worker previously assigned the job, or -1 if none.
+func (a *auction) award(w, j int, price float64) int {
+	previous := a.workerByJob[j]
+	if previous != -1 {
+		a.jobByWorker[previous] = -1
+	}
+	a.price[j] = price
+	a.jobByWorker[w] = j
+	a.workerByJob[j] = w
+	return previous
+}
+
+// Run a round of bidding with the given epsilon, starting from no
+// assignment and the current prices, until every worker is assigned. Each
+// unassigned worker bids in turn.
+func (a *auction) bid(epsilon float64) {
+	unassigned := a.reset()
+	for len(unassigned) > 0 {
+		w := unassigned[len(unassigned)-1]
+		unassigned = unassigned[:len(unassigned)-1]
+		j, price := a.bestBid(w, epsilon)
+		if previous := a.award(w, j, price); previous != -1 {
+			unassigned = append(unassigned, previous)
+		}
+	}
+}
+
+// Run a round of bidding like bid, except that all unassigned workers bid
+// at once, with their bids computed by the given number of goroutines.
+func (a *auction) bidParallel(epsilon float64, goroutines int) {
+	unassigned := a.reset()
+	jobs := make([]int, len(unassigned))
+	prices := make([]float64, len(unassigned))
+	highest := make([]int, len(a.price))
+	for i := range highest {
+		highest[i] = -1
+	}
+	for len(unassigned) > 0 {
+		var wg sync.WaitGroup
+		chunk := (len(unassigned) + goroutines - 1) / goroutines
+		for lo := 0; lo < len(unassigned); lo += chunk {
+			hi := lo + chunk
+			if hi > len(unassigned) {
+				hi = len(unassigned)
+			}
+			wg.Add(1)
+			go func(lo, hi int) {
+				defer wg.Done()
+				for i := lo; i < hi; i++ {
+					jobs[i], prices[i] = a.bestBid(unassigned[i], epsilon)
+				}
+			}(lo, hi)
+		}
+		wg.Wait()
+		// Find the highest bidder for each job, preferring the
+		// earliest bid to break ties so the result is deterministic.
+		for i, j := range jobs[:len(unassigned)] {
+			if k := highest[j]; k == -1 || prices[i] > prices[k] {
+				highest[j] = i
+			}
+		}
+		var outbid []int
+		for i, j := range jobs[:len(unassigned)] {
+			if highest[j] != i {
+				outbid = append(outbid, unassigned[i])
+				continue
+			}
+			highest[j] = -1
+			if previous := a.award(unassigned[i], j, prices[i]); previous != -1 {
+				outbid = append(outbid, previous)
+			}
+		}
+		unassigned = outbid
+	}
+}
@@ -0,0 +1,128 @@
+package munkres_test
+
+import (
+	"math"
+	"math/rand"
+	"runtime"
+	"testing"
+
+	"github.com/charles-haynes/munkres"
+)
+
+func TestSolveAuction(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 100; i++ {
+		rows, cols := 1+rng.Intn(8), 1+rng.Intn(8)
+		c := randomMatrix(rng, rows, cols)
+		for w := range c {
+			for j := range c[w] {
+				c[w][j] *= 1000
+			}
+		}
+		h, _ := munkres.NewHungarianAlgorithm(c)
+		want, _ := munkres.ComputeCost(c, h.Execute())
+
+		// Integer costs give an optimal result by default.
+		a, err := munkres.SolveAuction(c)
+		if err != nil {
+			t.Fatal(err)
+		}
+		cost, err := munkres.ComputeCost(c, a)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if cost != want {
+			t.Errorf("%v: want cost = %f got %f", c, want, cost)
+		}
+
+		// Otherwise the result is within n epsilon of optimal.
+		epsilon := 10.0
+		a, err = munkres.SolveAuction(c, munkres.WithEpsilonScaling(1000, 0.5, epsilon))
+		if err != nil {
+			t.Fatal(err)
+		}
+		cost, err = munkres.ComputeCost(c, a)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if n := math.Max(float64(rows), float64(cols)); cost > want+n*epsilon {
+			t.Errorf("%v: cost %f too far above optimum %f", c, cost, want)
+		}
+	}
+}
+
+func TestSolveAuctionParallel(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 50; i++ {
+		c := randomMatrix(rng, 1+rng.Intn(20), 1+rng.Intn(20))
+		h, _ := munkres.NewHungarianAlgorithm(c)
+		want, _ := munkres.ComputeCost(c, h.Execute())
+		a, err := munkres.SolveAuction(c, munkres.WithParallelism(4))
+		if err != nil {
+			t.Fatal(err)
+		}
+		cost, err := munkres.ComputeCost(c, a)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if cost != want {
+			t.Errorf("%v: want cost = %f got %f", c, want, cost)
+		}
+	}
+	if _, err := munkres.SolveAuction([][]float64{{1}}, munkres.WithParallelism(-1)); err != munkres.ErrorInvalidOption {
+		t.Errorf("want err = %s got %v", munkres.ErrorInvalidOption, err)
+	}
+}
+
+func TestSolveAuctionInvalidSchedule(t *testing.T) {
+	c := [][]float64{{1, 2}, {3, 4}}
+	for _, s := range [][3]float64{{1, 0.5, 0}, {1, 1, 0.1}, {0.1, 0.5, 1}} {
+		_, err := munkres.SolveAuction(c, munkres.WithEpsilonScaling(s[0], s[1], s[2]))
+		if err != munkres.ErrorInvalidOption {
+			t.Errorf("%v: want err = %s got %v", s, munkres.ErrorInvalidOption, err)
+		}
+	}
+}
+
+// The benchmarks compare the auction algorithm, serial and parallel, with
+// the Hungarian algorithm on uniformly random integer costs. Larger n,
+// such as the 10000 at which the parallel auction pays off most, can be
+// benchmarked by changing benchmarkSize, given enough memory.
+const benchmarkSize = 1000
+
+func benchmarkMatrix(n int) [][]float64 {
+	rng := rand.New(rand.NewSource(1))
+	c := make([][]float64, n)
+	for w := range c {
+		c[w] = make([]float64, n)
+		for j := range c[w] {
+			c[w][j] = float64(rng.Intn(1000 * n))
+		}
+	}
+	return c
+}
+
+func BenchmarkHungarian(b *testing.B) {
+	c := benchmarkMatrix(benchmarkSize)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		h, _ := munkres.NewHungarianAlgorithm(c)
+		h.Execute()
+	}
+}
+
+func BenchmarkAuction(b *testing.B) {
+	c := benchmarkMatrix(benchmarkSize)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		munkres.SolveAuction(c)
+	}
+}
+
+func BenchmarkAuctionParallel(b *testing.B) {
+	c := benchmarkMatrix(benchmarkSize)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		munkres.SolveAuction(c, munkres.WithParallelism(runtime.GOMAXPROCS(0)))
+	}
+}
@@ -0,0 +1,104 @@
+package munkres
+
+import "math"
+
+// AutoStats records the profile of a cost matrix and the backend which
+// ChooseBackend chose for it, so that a caller who knows better can
+// solve it with another through LookupBackend.
+type AutoStats struct {
+	// Backend is the name of the registered backend chosen, and Reason
+	// why it was.
+	Backend, Reason string
+	// Rows and Cols are the dimensions of the matrix, and Bytes the
+	// memory the copy made by NewHungarianAlgorithm would take.
+	Rows, Cols int
+	Bytes      int64
+	// Integral is true if every cost is an integer, and Range is the
+	// difference between the greatest and least costs.
+	Integral bool
+	Range    float64
+	// Ties is the fraction of the costs equal to another of the same
+	// row, as for Describe.
+	Ties float64
+}
+
+// The profile thresholds of ChooseBackend. The auction algorithm is
+// several times faster than the Hungarian algorithm on integer costs
+// with few ties, such as those of Machol-Wien instances, but no faster
+// otherwise; the float32 copy of SolveMixedPrecision takes half the
+// memory of the Hungarian algorithm's, and the row solver none, but both
+// are slower.
+const (
+	autoAuctionMinDim  = 100
+	autoAuctionMaxTies = 0.05
+	autoMixedMinBytes  = 1 << 30
+	autoRowsMinBytes   = 4 << 30
+)
+
+// ChooseBackend profiles a cost matrix by its dimensions, by whether its
+// costs are integers, and by its ties, as computed by Describe, and
+// chooses the registered backend expected to solve it fastest within
+// reasonable memory: the auction algorithm for large square integer
+// matrices with few ties, for which it is exact; the row solver or
+// SolveMixedPrecision for matrices whose copy by NewHungarianAlgorithm
+// would take gigabytes; and otherwise the Hungarian algorithm.
+//
+// return the errors of NewHungarianAlgorithm for costMatrix.
+func ChooseBackend(costMatrix [][]float64) (AutoStats, error) {
+	d, err := Describe(costMatrix)
+	if err != nil {
+		return AutoStats{}, err
+	}
+	if d.Forbidden > 0 {
+		return AutoStats{}, checkCostMatrix(costMatrix, false)
+	}
+	s := AutoStats{Rows: len(costMatrix), Integral: true, Ties: d.Ties}
+	if s.Rows > 0 {
+		s.Cols = len(costMatrix[0])
+	}
+	if s.Rows == 0 || s.Cols == 0 {
+		s.Backend, s.Reason = "hungarian", "empty matrix"
+		return s, nil
+	}
+	s.Range = d.Max - d.Min
+	for _, row := range costMatrix {
+		for _, c := range row {
+			if c != math.Trunc(c) {
+				s.Integral = false
+			}
+		}
+	}
+	dim := s.Rows
+	if s.Cols > dim {
+		dim = s.Cols
+	}
+	s.Bytes = int64(dim) * int64(dim) * 8
+	switch {
+	case s.Bytes >= autoRowsMinBytes:
+		s.Backend, s.Reason = "rows", "the matrix is too large to copy"
+	case s.Bytes >= autoMixedMinBytes:
+		s.Backend, s.Reason = "mixed", "the matrix is too large to copy in float64"
+	case s.Integral && s.Rows == s.Cols && dim >= autoAuctionMinDim && s.Ties <= autoAuctionMaxTies:
+		s.Backend, s.Reason = "auction", "the costs are integers with few ties"
+	default:
+		s.Backend, s.Reason = "hungarian", "the default"
+	}
+	return s, nil
+}
+
+// SolveAuto solves the assignment problem with the backend chosen by
+// ChooseBackend, returning its choice with the assignment.
+//
+// return the errors of ChooseBackend, or of the backend.
+func SolveAuto(costMatrix [][]float64) (Assignment, AutoStats, error) {
+	s, err := ChooseBackend(costMatrix)
+	if err != nil {
+		return nil, s, err
+	}
+	solve, ok := LookupBackend(s.Backend)
+	if !ok {
+		return nil, s, ErrorInvalidOption
+	}
+	a, err := solve(costMatrix)
+	return a, s, err
+}
@@ -0,0 +1,58 @@
+package munkres_test
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/charles-haynes/munkres"
+	"github.com/charles-haynes/munkres/generate"
+)
+
+func TestChooseBackend(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	ties := make([][]float64, 200)
+	for w := range ties {
+		ties[w] = make([]float64, 200)
+		for j := range ties[w] {
+			ties[w][j] = float64(rng.Intn(10))
+		}
+	}
+	for _, test := range []struct {
+		name    string
+		c       [][]float64
+		backend string
+	}{
+		{"empty", nil, "hungarian"},
+		{"machol-wien", generate.MacholWien(150), "auction"},
+		{"small", generate.MacholWien(20), "hungarian"},
+		{"ties", ties, "hungarian"},
+		{"fractional", randomMatrix(rng, 150, 150), "hungarian"},
+	} {
+		s, err := munkres.ChooseBackend(test.c)
+		if err != nil || s.Backend != test.backend || s.Reason == "" {
+			t.Errorf("%s: want %s got %+v (%v)", test.name, test.backend, s, err)
+		}
+	}
+	s, _ := munkres.ChooseBackend(generate.MacholWien(150))
+	if !s.Integral || s.Rows != 150 || s.Cols != 150 || s.Bytes != 150*150*8 || s.Range != 150*150-1 || s.Ties != 0 {
+		t.Errorf("want the profile of an integral 150 x 150 matrix got %+v", s)
+	}
+	if _, err := munkres.ChooseBackend([][]float64{{math.Inf(1)}}); err == nil {
+		t.Errorf("want an error for a forbidden cost")
+	}
+}
+
+func TestSolveAuto(t *testing.T) {
+	for _, c := range [][][]float64{generate.MacholWien(120), randomMatrix(rand.New(rand.NewSource(2)), 30, 40)} {
+		a, s, err := munkres.SolveAuto(c)
+		if err != nil {
+			t.Fatal(err)
+		}
+		h, _ := munkres.NewHungarianAlgorithm(c)
+		want := munkres.Assignment(h.Execute()).Cost(c)
+		if got := a.Cost(c); math.Abs(got-want) > 1e-9*math.Max(1, want) {
+			t.Errorf("%s: want cost %v got %v", s.Backend, want, got)
+		}
+	}
+}
@@ -0,0 +1,134 @@
+package munkres
+
+import "math"
+
+// An Axial3DResult is the outcome of SolveAxial3D.
+type Axial3DResult struct {
+	// Jobs and Slots give the assignment found: worker w does job
+	// Jobs[w] in slot Slots[w].
+	Jobs, Slots []int
+	// Cost is the cost of the assignment.
+	Cost float64
+	// LowerBound is a lower bound on the cost of any assignment.
+	LowerBound float64
+	// Gap is Cost - LowerBound, which is zero if the assignment is known
+	// to be optimal.
+	Gap float64
+}
+
+// SolveAxial3D approximately solves the axial three dimensional assignment
+// problem, in which each of n workers does one of n jobs in one of n time
+// slots, and each job and each slot is used exactly once. The problem is
+// NP-hard, so a heuristic is used: starting from the solution of a two
+// dimensional relaxation, the assignment is improved by fixing one of the
+// workers' jobs, their slots, or their job-slot pairs and optimally
+// reassigning the other, by the Hungarian algorithm, until none of those
+// moves improves the cost.
+//
+// costs[w][j][s] holds the cost of worker w doing job j in slot s, and
+// must be an n×n×n cube of finite numbers.
+//
+// The lower bound is the best of the three relaxations which drop the
+// constraint on the jobs, the slots or the workers respectively.
+func SolveAxial3D(costs [][][]float64) (Axial3DResult, error) {
+	n := len(costs)
+	for _, c := range costs {
+		if len(c) != n {
+			return Axial3DResult{}, ErrorDimensionMismatch
+		}
+		if err := checkCostMatrix(c, false); err != nil {
+			return Axial3DResult{}, err
+		}
+		if len(c[0]) != n {
+			return Axial3DResult{}, ErrorDimensionMismatch
+		}
+	}
+	matrix := make([][]float64, n)
+	for w := range matrix {
+		matrix[w] = make([]float64, n)
+	}
+	// solve the assignment problem on the cost(a, b) for a, b in [0, n).
+	solve := func(cost func(a, b int) float64) []int {
+		for a := range matrix {
+			for b := range matrix[a] {
+				matrix[a][b] = cost(a, b)
+			}
+		}
+		h, _ := NewHungarianAlgorithm(matrix)
+		return h.Execute()
+	}
+
+	// Relax each of the three constraints in turn, keeping the best
+	// bound. The relaxation dropping the slot constraint also gives the
+	// starting jobs.
+	result := Axial3DResult{LowerBound: math.Inf(-1)}
+	relaxations := []func(a, b int) float64{
+		// worker a, job b, best slot
+		func(a, b int) float64 { return minOf(costs[a][b]) },
+		// worker a, slot b, best job
+		func(a, b int) float64 {
+			m := math.Inf(1)
+			for j := range costs[a] {
+				m = math.Min(m, costs[a][j][b])
+			}
+			return m
+		},
+		// job a, slot b, best worker
+		func(a, b int) float64 {
+			m := math.Inf(1)
+			for w := range costs {
+				m = math.Min(m, costs[w][a][b])
+			}
+			return m
+		},
+	}
+	for i, relaxation := range relaxations {
+		assignment := solve(relaxation)
+		bound := 0.0
+		for a, b := range assignment {
+			bound += relaxation(a, b)
+		}
+		result.LowerBound = math.Max(result.LowerBound, bound)
+		if i == 0 {
+			result.Jobs = assignment
+		}
+	}
+
+	result.Slots = solve(func(w, s int) float64 { return costs[w][result.Jobs[w]][s] })
+	result.Cost = axialCost(costs, result.Jobs, result.Slots)
+	for improved := n > 1; improved; {
+		jobs := solve(func(w, j int) float64 { return costs[w][j][result.Slots[w]] })
+		improved = result.improve(costs, jobs, result.Slots)
+		slots := solve(func(w, s int) float64 { return costs[w][result.Jobs[w]][s] })
+		improved = result.improve(costs, result.Jobs, slots) || improved
+		// Reassign the workers to the job-slot pairs now in use.
+		pairs := solve(func(w, p int) float64 { return costs[w][result.Jobs[p]][result.Slots[p]] })
+		jobs, slots = make([]int, n), make([]int, n)
+		for w, p := range pairs {
+			jobs[w], slots[w] = result.Jobs[p], result.Slots[p]
+		}
+		improved = result.improve(costs, jobs, slots) || improved
+	}
+	result.Gap = result.Cost - result.LowerBound
+	return result, nil
+}
+
+// replace the assignment with the given one if it is cheaper.
+//
+// return whether it was replaced.
+func (r *Axial3DResult) improve(costs [][][]float64, jobs, slots []int) bool {
+	cost := axialCost(costs, jobs, slots)
+	if cost >= r.Cost {
+		return false
+	}
+	r.Jobs, r.Slots, r.Cost = jobs, slots, cost
+	return true
+}
+
+func axialCost(costs [][][]float64, jobs, slots []int) float64 {
+	total := 0.0
+	for w := range costs {
+		total += costs[w][jobs[w]][slots[w]]
+	}
+	return total
+}
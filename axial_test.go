@@ -0,0 +1,111 @@
+package munkres_test
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/charles-haynes/munkres"
+)
+
+func randomCube(rng *rand.Rand, n int) [][][]float64 {
+	costs := make([][][]float64, n)
+	for w := range costs {
+		costs[w] = randomMatrix(rng, n, n)
+	}
+	return costs
+}
+
+// return the optimal cost of the axial assignment problem by brute force.
+func bruteAxial(costs [][][]float64) float64 {
+	n := len(costs)
+	best := math.Inf(1)
+	usedJob, usedSlot := make([]bool, n), make([]bool, n)
+	var visit func(w int, cost float64)
+	visit = func(w int, cost float64) {
+		if w == n {
+			best = math.Min(best, cost)
+			return
+		}
+		for j := 0; j < n; j++ {
+			for s := 0; s < n; s++ {
+				if usedJob[j] || usedSlot[s] {
+					continue
+				}
+				usedJob[j], usedSlot[s] = true, true
+				visit(w+1, cost+costs[w][j][s])
+				usedJob[j], usedSlot[s] = false, false
+			}
+		}
+	}
+	visit(0, 0)
+	return best
+}
+
+func TestSolveAxial3D(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 50; i++ {
+		n := 1 + rng.Intn(4)
+		costs := randomCube(rng, n)
+		res, err := munkres.SolveAxial3D(costs)
+		if err != nil {
+			t.Fatal(err)
+		}
+		usedJob, usedSlot := make([]bool, n), make([]bool, n)
+		cost := 0.0
+		for w := range costs {
+			j, s := res.Jobs[w], res.Slots[w]
+			if usedJob[j] || usedSlot[s] {
+				t.Fatalf("job %d or slot %d used twice in %v", j, s, res)
+			}
+			usedJob[j], usedSlot[s] = true, true
+			cost += costs[w][j][s]
+		}
+		if cost != res.Cost {
+			t.Errorf("want cost = %f got %f", cost, res.Cost)
+		}
+		best := bruteAxial(costs)
+		if res.LowerBound > best || res.Cost < best {
+			t.Errorf("want bound %f <= optimum %f <= cost %f", res.LowerBound, best, res.Cost)
+		}
+		if res.Gap != res.Cost-res.LowerBound {
+			t.Errorf("want gap = %f got %f", res.Cost-res.LowerBound, res.Gap)
+		}
+	}
+}
+
+func TestSolveAxial3DSeparable(t *testing.T) {
+	// A separable cost is solved exactly, with no gap.
+	n := 4
+	costs := make([][][]float64, n)
+	for w := range costs {
+		costs[w] = make([][]float64, n)
+		for j := range costs[w] {
+			costs[w][j] = make([]float64, n)
+			for s := range costs[w][j] {
+				costs[w][j][s] = math.Abs(float64(w-j)) + math.Abs(float64(w-s))
+			}
+		}
+	}
+	res, err := munkres.SolveAxial3D(costs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Cost != 0 || res.Gap != 0 {
+		t.Errorf("want cost = 0 and gap = 0 got %v", res)
+	}
+}
+
+func TestSolveAxial3DErrors(t *testing.T) {
+	if _, err := munkres.SolveAxial3D([][][]float64{{{1, 2}}, {{3, 4}}}); err != munkres.ErrorDimensionMismatch {
+		t.Errorf("want err = %s got %v", munkres.ErrorDimensionMismatch, err)
+	}
+	if _, err := munkres.SolveAxial3D([][][]float64{{{math.NaN()}}}); !errors.Is(err, munkres.ErrorNaNCost) {
+		t.Errorf("want err = %s got %v", munkres.ErrorNaNCost, err)
+	}
+	res, err := munkres.SolveAxial3D(nil)
+	if err != nil || len(res.Jobs) != 0 || res.Cost != 0 {
+		t.Errorf("want an empty result got %v, %v", res, err)
+	}
+}
@@ -0,0 +1,115 @@
+package munkres
+
+import "math"
+
+// A Backup describes the fallback for a single worker should its job in
+// the optimal assignment become unavailable to it.
+type Backup struct {
+	// Job is the worker's job in the optimal assignment, or -1 if the
+	// worker is unassigned.
+	Job int
+	// Alternative is the job given to the worker by the best
+	// assignment which does not assign it Job, or -1 if the worker is
+	// unassigned in that assignment.
+	Alternative int
+	// SwitchCost is the increase in total cost of that assignment over
+	// the optimum. Other workers may also be reassigned in order to
+	// achieve it. It is +Inf if no such assignment exists and 0 if the
+	// worker is unassigned in the optimum.
+	SwitchCost float64
+}
+
+// WithBackups makes Execute additionally compute, for every worker, the
+// best alternative job should its optimal job become unavailable to it.
+// The results are available from Backups.
+//
+// Each backup is found by repairing the optimal matching with a single
+// phase of the algorithm from the current labeling, so computing all of
+// them takes time O(n^3).
+func WithBackups() Option {
+	return func(o *options) {
+		o.backups = true
+	}
+}
+
+// Backups returns the backup for each worker computed by Execute, or nil
+// if the algorithm was not constructed WithBackups.
+func (h *HungarianAlgorithm) Backups() []Backup {
+	return h.backups
+}
+
+// The matching and labeling of the algorithm, saved so that it can be
+// restored after exploring a modified problem.
+type matchingState struct {
+	labelByWorker, labelByJob          []float64
+	matchJobByWorker, matchWorkerByJob []int
+}
+
+func (h *HungarianAlgorithm) saveState(s *matchingState) {
+	s.labelByWorker = append(s.labelByWorker[:0], h.labelByWorker...)
+	s.labelByJob = append(s.labelByJob[:0], h.labelByJob...)
+	s.matchJobByWorker = append(s.matchJobByWorker[:0], h.matchJobByWorker...)
+	s.matchWorkerByJob = append(s.matchWorkerByJob[:0], h.matchWorkerByJob...)
+}
+
+func (h *HungarianAlgorithm) restoreState(s *matchingState) {
+	copy(h.labelByWorker, s.labelByWorker)
+	copy(h.labelByJob, s.labelByJob)
+	copy(h.matchJobByWorker, s.matchJobByWorker)
+	copy(h.matchWorkerByJob, s.matchWorkerByJob)
+}
+
+// Compute the backup of every original worker from the optimal matching.
+func (h *HungarianAlgorithm) computeBackups() {
+	h.backups = make([]Backup, h.rows)
+	var saved matchingState
+	h.saveState(&saved)
+	for w := range h.backups {
+		h.backups[w] = Backup{Job: -1, Alternative: -1}
+		j := h.matchJobByWorker[w]
+		if j >= h.cols {
+			continue
+		}
+		h.backups[w].Job = j
+		h.backups[w].Alternative, h.backups[w].SwitchCost =
+			h.repairWithout(w, j)
+		h.restoreState(&saved)
+	}
+}
+
+// Repair an optimal matching in which worker w is matched to job j so that
+// it becomes optimal subject to w not being matched to j. The caller is
+// responsible for restoring the matching and labeling afterwards.
+//
+// return the job of w in the repaired matching, or -1 if it is a padding
+// job, and the increase in total cost; or -1 and +Inf if there is no such
+// matching.
+func (h *HungarianAlgorithm) repairWithout(w, j int) (int, float64) {
+	before := h.matchedCost()
+	cost := h.costs[w*h.dim+j]
+	h.costs[w*h.dim+j] = math.Inf(1)
+	defer func() { h.costs[w*h.dim+j] = cost }()
+
+	h.matchJobByWorker[w] = -1
+	h.matchWorkerByJob[j] = -1
+	h.initializePhase(w)
+	if !h.executePhase() {
+		return -1, math.Inf(1)
+	}
+	alternative := h.matchJobByWorker[w]
+	if alternative >= h.cols {
+		alternative = -1
+	}
+	return alternative, h.matchedCost() - before
+}
+
+// return the total reduced cost of the matched edges.
+func (h *HungarianAlgorithm) matchedCost() float64 {
+	total := 0.0
+	for w, j := range h.matchJobByWorker {
+		if j != -1 {
+			total += h.costs[w*h.dim+j]
+		}
+	}
+	return total
+}
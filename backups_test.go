@@ -0,0 +1,68 @@
+package munkres_test
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/charles-haynes/munkres"
+)
+
+func TestBackups(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 100; i++ {
+		rows, cols := 1+rng.Intn(5), 1+rng.Intn(5)
+		c := randomMatrix(rng, rows, cols)
+		h, err := munkres.NewHungarianAlgorithm(c, munkres.WithBackups())
+		if err != nil {
+			t.Fatal(err)
+		}
+		res := h.Execute()
+		optimum, _ := munkres.ComputeCost(c, res)
+		backups := h.Backups()
+		if len(backups) != rows {
+			t.Fatalf("%v: want %d backups got %d", c, rows, len(backups))
+		}
+		for w, b := range backups {
+			if b.Job != res[w] {
+				t.Errorf("%v: worker %d: want job %d got %d",
+					c, w, res[w], b.Job)
+			}
+			if b.Job == -1 {
+				if b.Alternative != -1 || b.SwitchCost != 0 {
+					t.Errorf("%v: worker %d: unassigned worker has backup %+v",
+						c, w, b)
+				}
+				continue
+			}
+			want, ok := bruteForceCost(c, func(v, j int) bool {
+				return v != w || j != b.Job
+			})
+			if !ok {
+				if b.Alternative != -1 || !math.IsInf(b.SwitchCost, 1) {
+					t.Errorf("%v: worker %d: want no backup got %+v",
+						c, w, b)
+				}
+				continue
+			}
+			if b.Alternative == b.Job {
+				t.Errorf("%v: worker %d: alternative is its job", c, w)
+			}
+			if math.Abs(optimum+b.SwitchCost-want) > 1e-9 {
+				t.Errorf("%v: worker %d: want switch cost %f got %f",
+					c, w, want-optimum, b.SwitchCost)
+			}
+		}
+	}
+}
+
+func TestNoBackups(t *testing.T) {
+	h, err := munkres.NewHungarianAlgorithm([][]float64{{1, 2}, {3, 4}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	h.Execute()
+	if b := h.Backups(); b != nil {
+		t.Errorf("want no backups got %v", b)
+	}
+}
@@ -0,0 +1,92 @@
+package munkres
+
+import "math"
+
+// A Banded is an n x n cost matrix whose costs are finite only within a
+// band around the diagonal, as in sequence alignment: worker w may only
+// be assigned to jobs w-width to w+width. Only the band is stored, so
+// that memory, and the time to solve it, scale with the width of the
+// band rather than with n^2. A Banded is a Matrix, with +Inf outside the
+// band.
+type Banded struct {
+	n, width int
+	// The costs of the band by row, each of 2*width+1 entries for jobs
+	// w-width to w+width, +Inf where they are unset or outside the
+	// matrix.
+	costs []float64
+}
+
+// NewBanded returns an n x n matrix with a band of the given width, the
+// costs of which are all +Inf, forbidding every assignment, until set.
+func NewBanded(n, width int) (*Banded, error) {
+	if n < 0 || width < 0 {
+		return nil, ErrorDimensionMismatch
+	}
+	if width >= n {
+		width = n - 1
+	}
+	b := &Banded{n: n, width: width}
+	if n > 0 {
+		b.costs = make([]float64, n*(2*width+1))
+	}
+	for i := range b.costs {
+		b.costs[i] = math.Inf(1)
+	}
+	return b, nil
+}
+
+// return the index of the cost of worker w and job j, or -1 outside the
+// band.
+func (b *Banded) index(w, j int) int {
+	if w < 0 || w >= b.n || j < 0 || j >= b.n || j < w-b.width || j > w+b.width {
+		return -1
+	}
+	return w*(2*b.width+1) + j - w + b.width
+}
+
+// Set sets the cost of assigning worker w to job j, which must be within
+// the band, or ErrorDimensionMismatch is returned. The cost must be a
+// non-infinite number, or +Inf to forbid the assignment again.
+func (b *Banded) Set(w, j int, cost float64) error {
+	i := b.index(w, j)
+	if i == -1 {
+		return ErrorDimensionMismatch
+	}
+	if err := checkCost(w, j, cost, true); err != nil {
+		return err
+	}
+	b.costs[i] = cost
+	return nil
+}
+
+// Dims returns n, the number of rows and of columns.
+func (b *Banded) Dims() (rows, cols int) {
+	return b.n, b.n
+}
+
+// At returns the cost of assigning worker w to job j, or +Inf outside the
+// band.
+func (b *Banded) At(w, j int) float64 {
+	if i := b.index(w, j); i != -1 {
+		return b.costs[i]
+	}
+	return math.Inf(1)
+}
+
+// Solve solves the assignment problem as a minimum cost flow over the
+// finite costs of the band.
+//
+// return the minimum cost assignment as for Execute, in which every worker
+// is assigned, or ErrorInfeasible if forbidden costs prevent it.
+func (b *Banded) Solve() ([]int, error) {
+	var edges []sparseEdge
+	for w := 0; w < b.n; w++ {
+		for j := w - b.width; j <= w+b.width; j++ {
+			if i := b.index(w, j); i != -1 && !math.IsInf(b.costs[i], 1) {
+				edges = append(edges, sparseEdge{w, j, b.costs[i]})
+			}
+		}
+	}
+	result, _, _, err := solveSparse(b.n, b.n, edges)
+	return result, err
+}
@@ -0,0 +1,66 @@
+package munkres_test
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/charles-haynes/munkres"
+)
+
+func TestBanded(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 100; i++ {
+		n, width := 1+rng.Intn(7), rng.Intn(4)
+		b, err := munkres.NewBanded(n, width)
+		if err != nil {
+			t.Fatal(err)
+		}
+		c := randomMatrix(rng, n, n)
+		inBand := func(w, j int) bool { return j >= w-width && j <= w+width }
+		for w := range c {
+			for j := range c[w] {
+				if !inBand(w, j) {
+					c[w][j] = math.Inf(1)
+				} else if err := b.Set(w, j, c[w][j]); err != nil {
+					t.Fatal(err)
+				}
+			}
+		}
+		for w := range c {
+			for j := range c[w] {
+				if got := b.At(w, j); got != c[w][j] {
+					t.Fatalf("want (%d, %d) = %f got %f", w, j, c[w][j], got)
+				}
+			}
+		}
+		want, _ := bruteForceCost(c, inBand)
+		a, err := b.Solve()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, err := munkres.ComputeCost(c, a); err != nil || math.Abs(got-want) > 1e-9 {
+			t.Errorf("%v: want cost %f got %f (%v)", c, want, got, err)
+		}
+	}
+}
+
+func TestBandedInvalid(t *testing.T) {
+	if _, err := munkres.NewBanded(-1, 0); err != munkres.ErrorDimensionMismatch {
+		t.Errorf("want %v got %v", munkres.ErrorDimensionMismatch, err)
+	}
+	b, _ := munkres.NewBanded(3, 1)
+	if err := b.Set(0, 2, 1); err != munkres.ErrorDimensionMismatch {
+		t.Errorf("want %v got %v", munkres.ErrorDimensionMismatch, err)
+	}
+	if err := b.Set(0, 1, math.NaN()); !errors.Is(err, munkres.ErrorNaNCost) {
+		t.Errorf("want %v got %v", munkres.ErrorNaNCost, err)
+	}
+	// Worker 2 has no job until one is set.
+	b.Set(0, 0, 1)
+	b.Set(1, 1, 1)
+	if _, err := b.Solve(); err != munkres.ErrorInfeasible {
+		t.Errorf("want %v got %v", munkres.ErrorInfeasible, err)
+	}
+}
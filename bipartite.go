@@ -0,0 +1,86 @@
+package munkres
+
+import "math"
+
+// A Bipartite is an assignment problem built up incrementally, one worker,
+// job and cost at a time, rather than as a cost matrix. Workers and jobs
+// are identified by the indices returned when they are added, which are
+// also the indices used in the solution. A worker may only be assigned to
+// a job whose cost has been set.
+type Bipartite struct {
+	costMatrix [][]float64
+	jobs       int
+}
+
+// NewBipartite creates an empty problem.
+func NewBipartite() *Bipartite {
+	return &Bipartite{}
+}
+
+// AddWorker adds a worker which may not yet be assigned to any job.
+//
+// return the index of the worker.
+func (b *Bipartite) AddWorker() int {
+	row := make([]float64, b.jobs)
+	for j := range row {
+		row[j] = math.Inf(1)
+	}
+	b.costMatrix = append(b.costMatrix, row)
+	return len(b.costMatrix) - 1
+}
+
+// AddJob adds a job to which no worker may yet be assigned.
+//
+// return the index of the job.
+func (b *Bipartite) AddJob() int {
+	for w := range b.costMatrix {
+		b.costMatrix[w] = append(b.costMatrix[w], math.Inf(1))
+	}
+	b.jobs++
+	return b.jobs - 1
+}
+
+// Workers returns the number of workers added.
+func (b *Bipartite) Workers() int {
+	return len(b.costMatrix)
+}
+
+// Jobs returns the number of jobs added.
+func (b *Bipartite) Jobs() int {
+	return b.jobs
+}
+
+// SetCost sets the cost of assigning worker w to job j, allowing the
+// assignment. The cost must be a non-infinite number.
+func (b *Bipartite) SetCost(w, j int, cost float64) error {
+	if w < 0 || w >= len(b.costMatrix) || j < 0 || j >= b.jobs {
+		return ErrorDimensionMismatch
+	}
+	if err := checkCost(w, j, cost, false); err != nil {
+		return err
+	}
+	b.costMatrix[w][j] = cost
+	return nil
+}
+
+// Forbid the assignment of worker w to job j, removing any cost set for it.
+func (b *Bipartite) Forbid(w, j int) error {
+	if w < 0 || w >= len(b.costMatrix) || j < 0 || j >= b.jobs {
+		return ErrorDimensionMismatch
+	}
+	b.costMatrix[w][j] = math.Inf(1)
+	return nil
+}
+
+// Solve the problem.
+//
+// return the minimum cost assignment as for Execute, in which as many
+// workers are assigned as there are workers or jobs, whichever is fewer.
+// ErrorInfeasible is returned if forbidden assignments prevent this.
+func (b *Bipartite) Solve(opts ...Option) ([]int, error) {
+	h, err := NewHungarianAlgorithm(b.costMatrix, append(opts, allowForbidden())...)
+	if err != nil {
+		return nil, err
+	}
+	return h.execute()
+}
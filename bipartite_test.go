@@ -0,0 +1,111 @@
+package munkres_test
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/charles-haynes/munkres"
+)
+
+func TestBipartite(t *testing.T) {
+	b := munkres.NewBipartite()
+	alice, bob := b.AddWorker(), b.AddWorker()
+	wash := b.AddJob()
+	for _, d := range []struct {
+		w, j int
+		cost float64
+	}{
+		{alice, wash, 2},
+		{bob, wash, 1},
+	} {
+		if err := b.SetCost(d.w, d.j, d.cost); err != nil {
+			t.Fatal(err)
+		}
+	}
+	dry := b.AddJob()
+	if err := b.SetCost(alice, dry, 5); err != nil {
+		t.Fatal(err)
+	}
+	if b.Workers() != 2 || b.Jobs() != 2 {
+		t.Fatalf("want 2 workers and jobs got %d and %d", b.Workers(), b.Jobs())
+	}
+	// Bob can only wash, so Alice must dry despite the cost.
+	res, err := b.Solve()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []int{dry, wash}; !reflect.DeepEqual(res, want) {
+		t.Errorf("want res = %v got %v", want, res)
+	}
+
+	if err := b.Forbid(alice, dry); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := b.Solve(); err != munkres.ErrorInfeasible {
+		t.Errorf("want err = %s got %v", munkres.ErrorInfeasible, err)
+	}
+}
+
+func TestBipartiteErrors(t *testing.T) {
+	b := munkres.NewBipartite()
+	w, j := b.AddWorker(), b.AddJob()
+	if err := b.SetCost(w, j+1, 1); err != munkres.ErrorDimensionMismatch {
+		t.Errorf("want err = %s got %v", munkres.ErrorDimensionMismatch, err)
+	}
+	if err := b.Forbid(w+1, j); err != munkres.ErrorDimensionMismatch {
+		t.Errorf("want err = %s got %v", munkres.ErrorDimensionMismatch, err)
+	}
+	if err := b.SetCost(w, j, math.Inf(1)); !errors.Is(err, munkres.ErrorInfiniteCost) {
+		t.Errorf("want err = %s got %v", munkres.ErrorInfiniteCost, err)
+	}
+	if err := b.SetCost(w, j, math.NaN()); !errors.Is(err, munkres.ErrorNaNCost) {
+		t.Errorf("want err = %s got %v", munkres.ErrorNaNCost, err)
+	}
+}
+
+func TestBipartiteRandom(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 100; i++ {
+		rows, cols := 1+rng.Intn(5), 1+rng.Intn(5)
+		c := randomMatrix(rng, rows, cols)
+		b := munkres.NewBipartite()
+		for w := 0; w < rows; w++ {
+			b.AddWorker()
+		}
+		for j := 0; j < cols; j++ {
+			b.AddJob()
+		}
+		allowed := func(w, j int) bool { return c[w][j] < 7 }
+		for w := range c {
+			for j := range c[w] {
+				if allowed(w, j) {
+					b.SetCost(w, j, c[w][j])
+				}
+			}
+		}
+		want, ok := bruteForceCost(c, allowed)
+		res, err := b.Solve()
+		if !ok {
+			if err != munkres.ErrorInfeasible {
+				t.Errorf("%v: want err = %s got %v",
+					c, munkres.ErrorInfeasible, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%v: %s", c, err)
+		}
+		for w, j := range res {
+			if j != -1 && !allowed(w, j) {
+				t.Errorf("%v: forbidden pair (%d, %d) assigned", c, w, j)
+			}
+		}
+		cost, _ := munkres.ComputeCost(c, res)
+		if math.Abs(cost-want) > 1e-9 {
+			t.Errorf("%v: want cost = %f got %f", c, want, cost)
+		}
+	}
+}
@@ -0,0 +1,179 @@
+package munkres
+
+import (
+	"math"
+	"sync"
+)
+
+// A Block is a set of rows and columns of a cost matrix such that every
+// pair which may be assigned, having a finite cost, is either within the
+// block or has neither its row nor its column in it. The rows and columns
+// are in increasing order.
+type Block struct {
+	Rows, Cols []int
+}
+
+// FindBlocks finds the independent blocks of a cost matrix in which +Inf
+// entries forbid the assignment of the worker to the job: the matrix is
+// block-diagonal after permuting its rows and columns, with the blocks on
+// the diagonal. Rows and columns with no finite entries are in no block.
+// costMatrix is otherwise subject to the same restrictions as for
+// NewHungarianAlgorithm.
+//
+// return the blocks, in increasing order of their first rows.
+func FindBlocks(costMatrix [][]float64) ([]Block, error) {
+	if err := checkCostMatrix(costMatrix, true); err != nil {
+		return nil, err
+	}
+	rows, cols := len(costMatrix), 0
+	if rows > 0 {
+		cols = len(costMatrix[0])
+	}
+	return components(rows, cols, func(link func(w, j int)) {
+		for w, row := range costMatrix {
+			for j, c := range row {
+				if !math.IsInf(c, 1) {
+					link(w, j)
+				}
+			}
+		}
+	}), nil
+}
+
+// Find the connected components of the bipartite graph of rows workers
+// and cols jobs with the edges which pairs passes to link.
+//
+// return the components with at least one edge as blocks, in increasing
+// order of their first rows.
+func components(rows, cols int, pairs func(link func(w, j int))) []Block {
+	// A union-find forest over the rows, then the columns.
+	parent := make([]int, rows+cols)
+	for i := range parent {
+		parent[i] = i
+	}
+	var find func(i int) int
+	find = func(i int) int {
+		if parent[i] != i {
+			parent[i] = find(parent[i])
+		}
+		return parent[i]
+	}
+	linked := make([]bool, rows+cols)
+	pairs(func(w, j int) {
+		linked[w], linked[rows+j] = true, true
+		if a, b := find(w), find(rows+j); a != b {
+			parent[b] = a
+		}
+	})
+	var blocks []Block
+	index := map[int]int{}
+	for i, ok := range linked {
+		if !ok {
+			continue
+		}
+		root := find(i)
+		b, seen := index[root]
+		if !seen {
+			b = len(blocks)
+			index[root] = b
+			blocks = append(blocks, Block{})
+		}
+		if i < rows {
+			blocks[b].Rows = append(blocks[b].Rows, i)
+		} else {
+			blocks[b].Cols = append(blocks[b].Cols, i-rows)
+		}
+	}
+	return blocks
+}
+
+// SolveBlocks solves the assignment problem for a cost matrix in which
+// +Inf entries forbid the assignment of the worker to the job, by solving
+// each of its blocks, as found by FindBlocks, separately, which turns one
+// large solve into many small ones when the blocks are small. The blocks
+// are solved in parallel using up to the number of goroutines set by
+// WithParallelism; other options are ignored.
+//
+// return the minimum cost assignment as for Execute, in which as many
+// workers are assigned as there are workers or jobs, whichever is fewer.
+// ErrorInfeasible is returned if forbidden assignments prevent this.
+func SolveBlocks(costMatrix [][]float64, opts ...Option) ([]int, error) {
+	blocks, err := FindBlocks(costMatrix)
+	if err != nil {
+		return nil, err
+	}
+	o := newOptions(opts)
+	if o.parallelism < 0 {
+		return nil, ErrorInvalidOption
+	}
+	result := unassigned(len(costMatrix))
+	errs := make([]error, len(blocks))
+	solve := func(b int) {
+		block := blocks[b]
+		sub := make([][]float64, len(block.Rows))
+		for i, w := range block.Rows {
+			sub[i] = make([]float64, len(block.Cols))
+			for k, j := range block.Cols {
+				sub[i][k] = costMatrix[w][j]
+			}
+		}
+		h, err := NewHungarianAlgorithm(sub, allowForbidden())
+		if err == nil {
+			var a []int
+			if a, err = h.execute(); err == nil {
+				// Each block writes only its own rows of the result.
+				for i, k := range a {
+					if k != -1 {
+						result[block.Rows[i]] = block.Cols[k]
+					}
+				}
+			}
+		}
+		errs[b] = err
+	}
+	goroutines := o.parallelism
+	if goroutines <= 1 {
+		for b := range blocks {
+			solve(b)
+		}
+	} else {
+		next := make(chan int)
+		var wg sync.WaitGroup
+		for g := 0; g < goroutines; g++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for b := range next {
+					solve(b)
+				}
+			}()
+		}
+		for b := range blocks {
+			next <- b
+		}
+		close(next)
+		wg.Wait()
+	}
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+	rows, cols := len(costMatrix), 0
+	if rows > 0 {
+		cols = len(costMatrix[0])
+	}
+	want := rows
+	if cols < want {
+		want = cols
+	}
+	for _, j := range result {
+		if j != -1 {
+			want--
+		}
+	}
+	if want > 0 {
+		return nil, ErrorInfeasible
+	}
+	return result, nil
+}
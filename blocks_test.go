@@ -0,0 +1,66 @@
+package munkres_test
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/charles-haynes/munkres"
+)
+
+func TestFindBlocks(t *testing.T) {
+	inf := math.Inf(1)
+	c := [][]float64{
+		{inf, 1, inf, inf},
+		{2, inf, inf, 3},
+		{inf, inf, inf, inf},
+		{inf, 4, inf, inf},
+		{5, inf, inf, inf},
+	}
+	want := []munkres.Block{
+		{Rows: []int{0, 3}, Cols: []int{1}},
+		{Rows: []int{1, 4}, Cols: []int{0, 3}},
+	}
+	if got, err := munkres.FindBlocks(c); err != nil || !reflect.DeepEqual(got, want) {
+		t.Errorf("want %v got %v (%v)", want, got, err)
+	}
+	if _, err := munkres.FindBlocks([][]float64{{math.NaN()}}); !errors.Is(err, munkres.ErrorNaNCost) {
+		t.Errorf("want %v got %v", munkres.ErrorNaNCost, err)
+	}
+}
+
+func TestSolveBlocks(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 200; i++ {
+		c := randomMatrix(rng, 1+rng.Intn(6), 1+rng.Intn(6))
+		for w := range c {
+			for j := range c[w] {
+				if rng.Intn(3) > 0 {
+					c[w][j] = math.Inf(1)
+				}
+			}
+		}
+		allowed := func(w, j int) bool { return !math.IsInf(c[w][j], 1) }
+		want, ok := bruteForceCost(c, allowed)
+		for _, goroutines := range []int{1, 3} {
+			a, err := munkres.SolveBlocks(c, munkres.WithParallelism(goroutines))
+			if !ok {
+				if err != munkres.ErrorInfeasible {
+					t.Errorf("%v: want %v got %v", c, munkres.ErrorInfeasible, err)
+				}
+				continue
+			}
+			if err != nil {
+				t.Fatalf("%v: %v", c, err)
+			}
+			if got, err := munkres.ComputeCost(c, a); err != nil || math.Abs(got-want) > 1e-9 {
+				t.Errorf("%v: want cost %f got %f (%v)", c, want, got, err)
+			}
+		}
+	}
+	if _, err := munkres.SolveBlocks(nil, munkres.WithParallelism(-1)); err != munkres.ErrorInvalidOption {
+		t.Errorf("want %v got %v", munkres.ErrorInvalidOption, err)
+	}
+}
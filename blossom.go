@@ -0,0 +1,666 @@
+package munkres
+
+import "math"
+
+// MinWeightPerfectMatching solves the minimum weight perfect matching
+// problem on a general, not necessarily bipartite, graph, such as pairing
+// players or finding a minimum T-join, by Edmonds' blossom algorithm.
+// costMatrix[u][v] holds the cost of matching vertex u with vertex v, or
+// +Inf if they may not be matched; it must be square and symmetric, and
+// its diagonal is ignored.
+//
+// return the matching as an Assignment giving the vertex matched with
+// each vertex, so that each matched pair appears twice and the cost of
+// the matching is half of its Cost. ErrorInfeasible is returned if there
+// is no perfect matching, as when there are an odd number of vertices.
+func MinWeightPerfectMatching(costMatrix [][]float64) (Assignment, error) {
+	if err := checkGraph(costMatrix); err != nil {
+		return nil, err
+	}
+	// Maximizing the weight of a maximum cardinality matching under
+	// weights which fall as the costs rise minimizes the cost among the
+	// perfect matchings, since they all have the same number of edges.
+	top := math.Inf(-1)
+	for u := range costMatrix {
+		for v := u + 1; v < len(costMatrix); v++ {
+			if c := costMatrix[u][v]; !math.IsInf(c, 1) {
+				top = math.Max(top, c)
+			}
+		}
+	}
+	var edges []graphEdge
+	for u := range costMatrix {
+		for v := u + 1; v < len(costMatrix); v++ {
+			if c := costMatrix[u][v]; !math.IsInf(c, 1) {
+				edges = append(edges, graphEdge{u, v, top + 1 - c})
+			}
+		}
+	}
+	mate := maxWeightMatching(len(costMatrix), edges, true)
+	for _, u := range mate {
+		if u == -1 {
+			return nil, ErrorInfeasible
+		}
+	}
+	return mate, nil
+}
+
+// MaxWeightMatching solves the maximum weight matching problem on a
+// general graph, in which, unlike MinWeightPerfectMatching, vertices may
+// be left unmatched. weights[u][v] holds the weight gained by matching
+// vertex u with vertex v; it must be square, symmetric and finite, and its
+// diagonal is ignored. Since an edge of weight zero or less never adds to
+// the total, such edges are never matched, and can be used to mark pairs
+// which may not be matched.
+//
+// return the matching as for MinWeightPerfectMatching, with -1 for the
+// unmatched vertices.
+func MaxWeightMatching(weights [][]float64) (Assignment, error) {
+	if err := checkCostMatrix(weights, false); err != nil {
+		return nil, err
+	}
+	if err := checkGraph(weights); err != nil {
+		return nil, err
+	}
+	var edges []graphEdge
+	for u := range weights {
+		for v := u + 1; v < len(weights); v++ {
+			if w := weights[u][v]; w > 0 {
+				edges = append(edges, graphEdge{u, v, w})
+			}
+		}
+	}
+	return maxWeightMatching(len(weights), edges, false), nil
+}
+
+// Check that costMatrix is the cost matrix of a general graph: square,
+// symmetric and free of NaNs and negative infinities.
+func checkGraph(costMatrix [][]float64) error {
+	if err := checkCostMatrix(costMatrix, true); err != nil {
+		return err
+	}
+	if len(costMatrix) > 0 && len(costMatrix[0]) != len(costMatrix) {
+		return ErrorDimensionMismatch
+	}
+	for u := range costMatrix {
+		for v := u + 1; v < len(costMatrix); v++ {
+			if costMatrix[u][v] != costMatrix[v][u] {
+				return ErrorAsymmetricCostMatrix
+			}
+		}
+	}
+	return nil
+}
+
+// A graphEdge is an undirected edge of a general graph.
+type graphEdge struct {
+	u, v   int
+	weight float64
+}
+
+// The state of the blossom algorithm, following Galil's "Efficient
+// algorithms for finding maximum matching in graphs" and the
+// implementation by Joris van Rantwijk. Vertices are numbered [0, n) and
+// blossoms [n, 2n). Each edge k has two endpoints, 2k at its u and 2k+1 at
+// its v, so that p^1 is the other end of endpoint p.
+type blossomMatcher struct {
+	n     int
+	edges []graphEdge
+	// The vertex at each endpoint.
+	endpoint []int
+	// The endpoints of the edges incident to each vertex, at their far
+	// ends.
+	neighbours [][]int
+	// The endpoint at the far end of each vertex's matched edge, or -1.
+	mate []int
+	// The label of each top-level vertex or blossom: 0 for none, 1 for S
+	// (outer) and 2 for T (inner). Bit 4 marks blossoms during
+	// scanBlossom.
+	label []int
+	// The endpoint through which each labelled vertex or blossom got its
+	// label, or -1 for free vertices.
+	labelEnd []int
+	// The top-level blossom containing each vertex.
+	inBlossom []int
+	// The parent of each vertex or blossom, or -1 at the top level.
+	parent []int
+	// The sub-blossoms of each blossom, in order around the cycle from
+	// its base, and the endpoints of the edges joining them.
+	children  [][]int
+	endpoints [][]int
+	// The base vertex of each blossom, or -1 for unused blossoms.
+	base []int
+	// The least slack edge from each vertex or blossom to an S blossom,
+	// or -1.
+	bestEdge []int
+	// The least slack edges from each S blossom to other S blossoms.
+	bestEdges [][]int
+	unused    []int
+	// The dual variable of each vertex and blossom.
+	dual []float64
+	// Whether each edge is known to have zero slack.
+	allowed []bool
+	queue   []int
+}
+
+// Find a maximum weight matching of the graph on n vertices with the given
+// edges, or if maxCardinality is true a maximum weight matching among
+// those of maximum cardinality, in O(n^3) time.
+//
+// return the vertex matched with each vertex, or -1 if it is unmatched.
+func maxWeightMatching(n int, edges []graphEdge, maxCardinality bool) []int {
+	m := blossomMatcher{
+		n:          n,
+		edges:      edges,
+		endpoint:   make([]int, 2*len(edges)),
+		neighbours: make([][]int, n),
+		mate:       make([]int, n),
+		label:      make([]int, 2*n),
+		labelEnd:   make([]int, 2*n),
+		inBlossom:  make([]int, n),
+		parent:     make([]int, 2*n),
+		children:   make([][]int, 2*n),
+		endpoints:  make([][]int, 2*n),
+		base:       make([]int, 2*n),
+		bestEdge:   make([]int, 2*n),
+		bestEdges:  make([][]int, 2*n),
+		dual:       make([]float64, 2*n),
+		allowed:    make([]bool, len(edges)),
+	}
+	maxWeight := 0.0
+	for k, e := range edges {
+		m.endpoint[2*k], m.endpoint[2*k+1] = e.u, e.v
+		m.neighbours[e.u] = append(m.neighbours[e.u], 2*k+1)
+		m.neighbours[e.v] = append(m.neighbours[e.v], 2*k)
+		maxWeight = math.Max(maxWeight, e.weight)
+	}
+	for v := 0; v < n; v++ {
+		m.mate[v] = -1
+		m.inBlossom[v] = v
+		m.base[v], m.base[n+v] = v, -1
+		m.dual[v] = maxWeight
+		m.unused = append(m.unused, n+v)
+	}
+	for v := range m.parent {
+		m.labelEnd[v] = -1
+		m.parent[v] = -1
+		m.bestEdge[v] = -1
+	}
+
+	// Each stage augments the matching by one edge, or ends the search.
+	for stage := 0; stage < n; stage++ {
+		if !m.stage(maxCardinality) {
+			break
+		}
+		// Expand the S blossoms whose duals have fallen to zero, which
+		// are no longer needed.
+		for b := n; b < 2*n; b++ {
+			if m.parent[b] == -1 && m.base[b] >= 0 && m.label[b] == 1 && m.dual[b] == 0 {
+				m.expand(b, true)
+			}
+		}
+	}
+
+	mate := make([]int, n)
+	for v, p := range m.mate {
+		mate[v] = -1
+		if p >= 0 {
+			mate[v] = m.endpoint[p]
+		}
+	}
+	return mate
+}
+
+// Grow alternating trees from the free vertices, updating the duals as
+// needed, until the matching can be augmented.
+//
+// return false if no augmenting path remains.
+func (m *blossomMatcher) stage(maxCardinality bool) bool {
+	n := m.n
+	for i := range m.label {
+		m.label[i] = 0
+		m.bestEdge[i] = -1
+		if i >= n {
+			m.bestEdges[i] = nil
+		}
+	}
+	for k := range m.allowed {
+		m.allowed[k] = false
+	}
+	m.queue = m.queue[:0]
+	for v := 0; v < n; v++ {
+		if m.mate[v] == -1 && m.label[m.inBlossom[v]] == 0 {
+			m.assignLabel(v, 1, -1)
+		}
+	}
+
+	for {
+		for len(m.queue) > 0 {
+			v := m.queue[len(m.queue)-1]
+			m.queue = m.queue[:len(m.queue)-1]
+			for _, p := range m.neighbours[v] {
+				k, w := p/2, m.endpoint[p]
+				if m.inBlossom[v] == m.inBlossom[w] {
+					continue
+				}
+				slack := 0.0
+				if !m.allowed[k] {
+					if slack = m.slack(k); slack <= 0 {
+						m.allowed[k] = true
+					}
+				}
+				switch bw := m.inBlossom[w]; {
+				case m.allowed[k] && m.label[bw] == 0:
+					// w is free: label it T and its mate S.
+					m.assignLabel(w, 2, p^1)
+				case m.allowed[k] && m.label[bw] == 1:
+					// Two S vertices: either a new blossom or an
+					// augmenting path.
+					if base := m.scanBlossom(v, w); base >= 0 {
+						m.addBlossom(base, k)
+					} else {
+						m.augment(k)
+						return true
+					}
+				case m.allowed[k] && m.label[w] == 0:
+					// w is inside a T blossom but not yet reached
+					// from an S vertex; note the edge reaching it
+					// for when the blossom is expanded.
+					m.label[w], m.labelEnd[w] = 2, p^1
+				case !m.allowed[k] && m.label[bw] == 1:
+					b := m.inBlossom[v]
+					if m.bestEdge[b] == -1 || slack < m.slack(m.bestEdge[b]) {
+						m.bestEdge[b] = k
+					}
+				case !m.allowed[k] && m.label[w] == 0:
+					if m.bestEdge[w] == -1 || slack < m.slack(m.bestEdge[w]) {
+						m.bestEdge[w] = k
+					}
+				}
+			}
+		}
+
+		// No augmenting path under the current duals, so change them
+		// by the largest amount which keeps them feasible.
+		deltaType, delta, deltaEdge, deltaBlossom := -1, 0.0, -1, -1
+		if !maxCardinality {
+			// The duals of S vertices may fall to zero.
+			deltaType, delta = 1, minOf(m.dual[:n])
+		}
+		for v := 0; v < n; v++ {
+			// An edge from a free vertex to an S vertex may become
+			// tight.
+			if m.label[m.inBlossom[v]] == 0 && m.bestEdge[v] != -1 {
+				if d := m.slack(m.bestEdge[v]); deltaType == -1 || d < delta {
+					deltaType, delta, deltaEdge = 2, d, m.bestEdge[v]
+				}
+			}
+		}
+		for b := 0; b < 2*n; b++ {
+			// An edge between S blossoms may become tight.
+			if m.parent[b] == -1 && m.label[b] == 1 && m.bestEdge[b] != -1 {
+				if d := m.slack(m.bestEdge[b]) / 2; deltaType == -1 || d < delta {
+					deltaType, delta, deltaEdge = 3, d, m.bestEdge[b]
+				}
+			}
+		}
+		for b := n; b < 2*n; b++ {
+			// The dual of a T blossom may fall to zero.
+			if m.base[b] >= 0 && m.parent[b] == -1 && m.label[b] == 2 &&
+				(deltaType == -1 || m.dual[b] < delta) {
+				deltaType, delta, deltaBlossom = 4, m.dual[b], b
+			}
+		}
+		if deltaType == -1 {
+			// No further progress is possible in maximum
+			// cardinality mode; make a final update to leave the
+			// duals optimal.
+			deltaType, delta = 1, math.Max(0, minOf(m.dual[:n]))
+		}
+
+		for v := 0; v < n; v++ {
+			switch m.label[m.inBlossom[v]] {
+			case 1:
+				m.dual[v] -= delta
+			case 2:
+				m.dual[v] += delta
+			}
+		}
+		for b := n; b < 2*n; b++ {
+			if m.base[b] >= 0 && m.parent[b] == -1 {
+				switch m.label[b] {
+				case 1:
+					m.dual[b] += delta
+				case 2:
+					m.dual[b] -= delta
+				}
+			}
+		}
+
+		switch deltaType {
+		case 1:
+			return false
+		case 2:
+			m.allowed[deltaEdge] = true
+			e := m.edges[deltaEdge]
+			if m.label[m.inBlossom[e.u]] == 0 {
+				e.u = e.v
+			}
+			m.queue = append(m.queue, e.u)
+		case 3:
+			m.allowed[deltaEdge] = true
+			m.queue = append(m.queue, m.edges[deltaEdge].u)
+		case 4:
+			m.expand(deltaBlossom, false)
+		}
+	}
+}
+
+func (m *blossomMatcher) slack(k int) float64 {
+	e := m.edges[k]
+	return m.dual[e.u] + m.dual[e.v] - 2*e.weight
+}
+
+// Append the vertices of blossom b to leaves.
+func (m *blossomMatcher) leaves(leaves []int, b int) []int {
+	if b < m.n {
+		return append(leaves, b)
+	}
+	for _, t := range m.children[b] {
+		leaves = m.leaves(leaves, t)
+	}
+	return leaves
+}
+
+// Label the top-level blossom containing vertex w with t, reached through
+// endpoint p. The mate of a T blossom's base is labelled S in turn.
+func (m *blossomMatcher) assignLabel(w, t, p int) {
+	b := m.inBlossom[w]
+	m.label[w], m.label[b] = t, t
+	m.labelEnd[w], m.labelEnd[b] = p, p
+	m.bestEdge[w], m.bestEdge[b] = -1, -1
+	if t == 1 {
+		m.queue = m.leaves(m.queue, b)
+		return
+	}
+	p = m.mate[m.base[b]]
+	m.assignLabel(m.endpoint[p], 1, p^1)
+}
+
+// Trace back from the S vertices v and w towards the roots of their trees.
+//
+// return the base of the new blossom formed if the paths meet, or -1 if
+// they reach different roots, giving an augmenting path.
+func (m *blossomMatcher) scanBlossom(v, w int) int {
+	var path []int
+	base := -1
+	for v != -1 || w != -1 {
+		b := m.inBlossom[v]
+		if m.label[b]&4 != 0 {
+			base = m.base[b]
+			break
+		}
+		path = append(path, b)
+		m.label[b] = 5
+		if m.labelEnd[b] == -1 {
+			// The root of the tree.
+			v = -1
+		} else {
+			v = m.endpoint[m.labelEnd[b]]
+			v = m.endpoint[m.labelEnd[m.inBlossom[v]]]
+		}
+		if w != -1 {
+			v, w = w, v
+		}
+	}
+	for _, b := range path {
+		m.label[b] = 1
+	}
+	return base
+}
+
+// Construct a new blossom with the given base, through the S vertices
+// joined by edge k, and label it S.
+func (m *blossomMatcher) addBlossom(base, k int) {
+	e := m.edges[k]
+	bb, bv, bw := m.inBlossom[base], m.inBlossom[e.u], m.inBlossom[e.v]
+	b := m.unused[len(m.unused)-1]
+	m.unused = m.unused[:len(m.unused)-1]
+	m.base[b] = base
+	m.parent[b] = -1
+	m.parent[bb] = b
+
+	// Trace the cycle from the edge back to the base on each side.
+	var path, endps []int
+	for bv != bb {
+		m.parent[bv] = b
+		path = append(path, bv)
+		endps = append(endps, m.labelEnd[bv])
+		bv = m.inBlossom[m.endpoint[m.labelEnd[bv]]]
+	}
+	path = append(path, bb)
+	reverseInts(path)
+	reverseInts(endps)
+	endps = append(endps, 2*k)
+	for bw != bb {
+		m.parent[bw] = b
+		path = append(path, bw)
+		endps = append(endps, m.labelEnd[bw]^1)
+		bw = m.inBlossom[m.endpoint[m.labelEnd[bw]]]
+	}
+	m.children[b], m.endpoints[b] = path, endps
+
+	m.label[b] = 1
+	m.labelEnd[b] = m.labelEnd[bb]
+	m.dual[b] = 0
+	for _, v := range m.leaves(nil, b) {
+		if m.label[m.inBlossom[v]] == 2 {
+			// Former T vertices are now S, so must be scanned.
+			m.queue = append(m.queue, v)
+		}
+		m.inBlossom[v] = b
+	}
+
+	// Find the least slack edges from the new blossom to each other S
+	// blossom.
+	bestTo := make([]int, 2*m.n)
+	for i := range bestTo {
+		bestTo[i] = -1
+	}
+	for _, bv := range path {
+		var lists [][]int
+		if m.bestEdges[bv] == nil {
+			for _, v := range m.leaves(nil, bv) {
+				list := make([]int, len(m.neighbours[v]))
+				for i, p := range m.neighbours[v] {
+					list[i] = p / 2
+				}
+				lists = append(lists, list)
+			}
+		} else {
+			lists = [][]int{m.bestEdges[bv]}
+		}
+		for _, list := range lists {
+			for _, k := range list {
+				j := m.edges[k].v
+				if m.inBlossom[j] == b {
+					j = m.edges[k].u
+				}
+				bj := m.inBlossom[j]
+				if bj != b && m.label[bj] == 1 &&
+					(bestTo[bj] == -1 || m.slack(k) < m.slack(bestTo[bj])) {
+					bestTo[bj] = k
+				}
+			}
+		}
+		m.bestEdges[bv] = nil
+		m.bestEdge[bv] = -1
+	}
+	m.bestEdges[b] = []int{}
+	m.bestEdge[b] = -1
+	for _, k := range bestTo {
+		if k == -1 {
+			continue
+		}
+		m.bestEdges[b] = append(m.bestEdges[b], k)
+		if m.bestEdge[b] == -1 || m.slack(k) < m.slack(m.bestEdge[b]) {
+			m.bestEdge[b] = k
+		}
+	}
+}
+
+// Expand blossom b into its sub-blossoms. At the end of a stage, those
+// sub-blossoms with zero duals are expanded recursively; otherwise b is a
+// T blossom, and its sub-blossoms are relabelled.
+func (m *blossomMatcher) expand(b int, endStage bool) {
+	for _, s := range m.children[b] {
+		m.parent[s] = -1
+		switch {
+		case s < m.n:
+			m.inBlossom[s] = s
+		case endStage && m.dual[s] == 0:
+			m.expand(s, endStage)
+		default:
+			for _, v := range m.leaves(nil, s) {
+				m.inBlossom[v] = s
+			}
+		}
+	}
+
+	if !endStage && m.label[b] == 2 {
+		// Relabel the even length path around the cycle from the
+		// sub-blossom through which b was reached to its base.
+		children, endps := m.children[b], m.endpoints[b]
+		entry := m.inBlossom[m.endpoint[m.labelEnd[b]^1]]
+		j := indexOf(children, entry)
+		step, trick := -1, 1
+		if j&1 != 0 {
+			j -= len(children)
+			step, trick = 1, 0
+		}
+		at := func(i int) int { return (i + len(children)) % len(children) }
+		p := m.labelEnd[b]
+		for j != 0 {
+			m.label[m.endpoint[p^1]] = 0
+			m.label[m.endpoint[endps[at(j-trick)]^trick^1]] = 0
+			m.assignLabel(m.endpoint[p^1], 2, p)
+			m.allowed[endps[at(j-trick)]/2] = true
+			j += step
+			p = endps[at(j-trick)] ^ trick
+			m.allowed[p/2] = true
+			j += step
+		}
+		bv := children[at(j)]
+		m.label[m.endpoint[p^1]], m.label[bv] = 2, 2
+		m.labelEnd[m.endpoint[p^1]], m.labelEnd[bv] = p, p
+		m.bestEdge[bv] = -1
+		j += step
+		// The remaining sub-blossoms are unlabelled unless one of
+		// their vertices was reached from outside.
+		for children[at(j)] != entry {
+			bv := children[at(j)]
+			j += step
+			if m.label[bv] == 1 {
+				continue
+			}
+			for _, v := range m.leaves(nil, bv) {
+				if m.label[v] != 0 {
+					m.label[v] = 0
+					m.label[m.endpoint[m.mate[m.base[bv]]]] = 0
+					m.assignLabel(v, 2, m.labelEnd[v])
+					break
+				}
+			}
+		}
+	}
+
+	m.label[b], m.labelEnd[b] = -1, -1
+	m.children[b], m.endpoints[b] = nil, nil
+	m.base[b] = -1
+	m.bestEdges[b] = nil
+	m.bestEdge[b] = -1
+	m.unused = append(m.unused, b)
+}
+
+// Swap the matched and unmatched edges along the even length path around
+// blossom b from vertex v to the base, making v the new base.
+func (m *blossomMatcher) augmentBlossom(b, v int) {
+	t := v
+	for m.parent[t] != b {
+		t = m.parent[t]
+	}
+	if t >= m.n {
+		m.augmentBlossom(t, v)
+	}
+	children, endps := m.children[b], m.endpoints[b]
+	i := indexOf(children, t)
+	j, step, trick := i, -1, 1
+	if i&1 != 0 {
+		j -= len(children)
+		step, trick = 1, 0
+	}
+	at := func(i int) int { return (i + len(children)) % len(children) }
+	for j != 0 {
+		j += step
+		t = children[at(j)]
+		p := endps[at(j-trick)] ^ trick
+		if t >= m.n {
+			m.augmentBlossom(t, m.endpoint[p])
+		}
+		j += step
+		t = children[at(j)]
+		if t >= m.n {
+			m.augmentBlossom(t, m.endpoint[p^1])
+		}
+		m.mate[m.endpoint[p]] = p ^ 1
+		m.mate[m.endpoint[p^1]] = p
+	}
+	// Rotate the cycle so that the new base comes first.
+	m.children[b] = append(append([]int{}, children[i:]...), children[:i]...)
+	m.endpoints[b] = append(append([]int{}, endps[i:]...), endps[:i]...)
+	m.base[b] = m.base[m.children[b][0]]
+}
+
+// Augment the matching along the path through edge k between the S
+// vertices at its ends and the roots of their trees.
+func (m *blossomMatcher) augment(k int) {
+	e := m.edges[k]
+	for _, sp := range [2][2]int{{e.u, 2*k + 1}, {e.v, 2 * k}} {
+		s, p := sp[0], sp[1]
+		for {
+			bs := m.inBlossom[s]
+			if bs >= m.n {
+				m.augmentBlossom(bs, s)
+			}
+			m.mate[s] = p
+			if m.labelEnd[bs] == -1 {
+				// Reached the root.
+				break
+			}
+			t := m.endpoint[m.labelEnd[bs]]
+			bt := m.inBlossom[t]
+			s = m.endpoint[m.labelEnd[bt]]
+			j := m.endpoint[m.labelEnd[bt]^1]
+			if bt >= m.n {
+				m.augmentBlossom(bt, j)
+			}
+			m.mate[j] = m.labelEnd[bt]
+			p = m.labelEnd[bt] ^ 1
+		}
+	}
+}
+
+func indexOf(s []int, x int) int {
+	for i, y := range s {
+		if y == x {
+			return i
+		}
+	}
+	return -1
+}
+
+func reverseInts(s []int) {
+	for i, j := 0, len(s)-1; i < j; i, j = i+1, j-1 {
+		s[i], s[j] = s[j], s[i]
+	}
+}
@@ -0,0 +1,198 @@
+package munkres_test
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/charles-haynes/munkres"
+)
+
+// return a random symmetric cost matrix on n vertices in which each edge
+// is forbidden with probability sparsity.
+func randomGraph(rng *rand.Rand, n int, sparsity float64) [][]float64 {
+	c := make([][]float64, n)
+	for u := range c {
+		c[u] = make([]float64, n)
+	}
+	for u := range c {
+		for v := u + 1; v < n; v++ {
+			c[u][v] = rng.Float64() * 10
+			if rng.Float64() < sparsity {
+				c[u][v] = math.Inf(1)
+			}
+			c[v][u] = c[u][v]
+		}
+	}
+	return c
+}
+
+// return the cost of the cheapest perfect matching by brute force, or
+// +Inf if there is none.
+func brutePerfectMatching(c [][]float64) float64 {
+	matched := make([]bool, len(c))
+	var visit func() float64
+	visit = func() float64 {
+		u := 0
+		for u < len(c) && matched[u] {
+			u++
+		}
+		if u == len(c) {
+			return 0
+		}
+		best := math.Inf(1)
+		matched[u] = true
+		for v := u + 1; v < len(c); v++ {
+			if !matched[v] && !math.IsInf(c[u][v], 1) {
+				matched[v] = true
+				best = math.Min(best, c[u][v]+visit())
+				matched[v] = false
+			}
+		}
+		matched[u] = false
+		return best
+	}
+	return visit()
+}
+
+func TestMinWeightPerfectMatching(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 200; i++ {
+		n := 2 * rng.Intn(6)
+		c := randomGraph(rng, n, 0.3*rng.Float64())
+		want := brutePerfectMatching(c)
+		mate, err := munkres.MinWeightPerfectMatching(c)
+		if math.IsInf(want, 1) {
+			if err != munkres.ErrorInfeasible {
+				t.Errorf("want err = %s got %v", munkres.ErrorInfeasible, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		for u, v := range mate {
+			if v == u || mate[v] != u {
+				t.Fatalf("not a matching: %v", mate)
+			}
+		}
+		if got := mate.Cost(c) / 2; math.Abs(got-want) > 1e-9 {
+			t.Errorf("want cost = %f got %f for %v", want, got, c)
+		}
+	}
+}
+
+func TestMinWeightPerfectMatchingBlossom(t *testing.T) {
+	// Two triangles joined by a single edge, which a bipartite matching
+	// cannot represent.
+	inf := math.Inf(1)
+	c := [][]float64{
+		{0, 1, 1, inf, inf, inf},
+		{1, 0, 1, inf, inf, inf},
+		{1, 1, 0, 5, inf, inf},
+		{inf, inf, 5, 0, 1, 1},
+		{inf, inf, inf, 1, 0, 1},
+		{inf, inf, inf, 1, 1, 0},
+	}
+	mate, err := munkres.MinWeightPerfectMatching(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if mate[2] != 3 || mate.Cost(c)/2 != 7 {
+		t.Errorf("want the bridge matched at cost 7 got %v", mate)
+	}
+}
+
+func TestMinWeightPerfectMatchingErrors(t *testing.T) {
+	if _, err := munkres.MinWeightPerfectMatching([][]float64{{0, 1, 2}, {1, 0, 3}, {2, 3, 0}}); err != munkres.ErrorInfeasible {
+		t.Errorf("want err = %s got %v", munkres.ErrorInfeasible, err)
+	}
+	if _, err := munkres.MinWeightPerfectMatching([][]float64{{0, 1}, {2, 0}}); err != munkres.ErrorAsymmetricCostMatrix {
+		t.Errorf("want err = %s got %v", munkres.ErrorAsymmetricCostMatrix, err)
+	}
+	if _, err := munkres.MinWeightPerfectMatching([][]float64{{0, 1}}); err != munkres.ErrorDimensionMismatch {
+		t.Errorf("want err = %s got %v", munkres.ErrorDimensionMismatch, err)
+	}
+	if mate, err := munkres.MinWeightPerfectMatching(nil); err != nil || len(mate) != 0 {
+		t.Errorf("want an empty matching got %v, %v", mate, err)
+	}
+}
+
+// return the weight of the heaviest matching by brute force.
+func bruteMaxWeightMatching(w [][]float64) float64 {
+	matched := make([]bool, len(w))
+	var visit func(u int) float64
+	visit = func(u int) float64 {
+		for u < len(w) && matched[u] {
+			u++
+		}
+		if u == len(w) {
+			return 0
+		}
+		// Leave u unmatched, or match it with a later vertex.
+		best := visit(u + 1)
+		matched[u] = true
+		for v := u + 1; v < len(w); v++ {
+			if !matched[v] {
+				matched[v] = true
+				best = math.Max(best, w[u][v]+visit(u+1))
+				matched[v] = false
+			}
+		}
+		matched[u] = false
+		return best
+	}
+	return visit(0)
+}
+
+func TestMaxWeightMatching(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 200; i++ {
+		n := rng.Intn(10)
+		w := randomGraph(rng, n, 0)
+		for u := range w {
+			for v := range w[u] {
+				// Some edges are worth leaving out.
+				w[u][v] -= 3
+			}
+		}
+		mate, err := munkres.MaxWeightMatching(w)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got := 0.0
+		for u, v := range mate {
+			if v == -1 {
+				continue
+			}
+			if v == u || mate[v] != u {
+				t.Fatalf("not a matching: %v", mate)
+			}
+			got += w[u][v] / 2
+		}
+		if want := bruteMaxWeightMatching(w); math.Abs(got-want) > 1e-9 {
+			t.Errorf("want weight = %f got %f for %v", want, got, w)
+		}
+	}
+}
+
+func TestMaxWeightMatchingUnmatched(t *testing.T) {
+	// A path of three vertices matches only its heavier edge.
+	w := [][]float64{
+		{0, 2, 0},
+		{2, 0, 3},
+		{0, 3, 0},
+	}
+	mate, err := munkres.MaxWeightMatching(w)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (munkres.Assignment{-1, 2, 1}); !reflect.DeepEqual(mate, want) {
+		t.Errorf("want %v got %v", want, mate)
+	}
+	if _, err := munkres.MaxWeightMatching([][]float64{{0, math.Inf(1)}, {math.Inf(1), 0}}); !errors.Is(err, munkres.ErrorInfiniteCost) {
+		t.Errorf("want err = %s got %v", munkres.ErrorInfiniteCost, err)
+	}
+}
@@ -0,0 +1,73 @@
+package munkres
+
+// SolveCapacitated finds a minimum cost allocation of units of work from
+// workers to jobs, where worker i can supply at most capacity[i] units and
+// job j requires exactly demand[j] units. This generalises the assignment
+// problem, in which every capacity and demand is 1, without replicating
+// rows and columns of the cost matrix.
+//
+// costMatrix[i][j] holds the cost of each unit supplied by worker i to job
+// j, and is subject to the same restrictions as for
+// NewHungarianAlgorithm. The problem is solved as a minimum cost flow.
+//
+// return the allocation, where allocation[i][j] holds the number of units
+// supplied by worker i to job j. ErrorInfeasible is returned if the
+// workers cannot meet the total demand.
+func SolveCapacitated(costMatrix [][]float64, capacity, demand []int) ([][]int, error) {
+	if err := checkCostMatrix(costMatrix, false); err != nil {
+		return nil, err
+	}
+	rows, cols := len(costMatrix), 0
+	if rows > 0 {
+		cols = len(costMatrix[0])
+	}
+	if len(capacity) != rows || len(demand) != cols {
+		return nil, ErrorDimensionMismatch
+	}
+	supply, required := 0, 0
+	for _, a := range capacity {
+		if a < 0 {
+			return nil, ErrorNegativeQuantity
+		}
+		supply += a
+	}
+	for _, b := range demand {
+		if b < 0 {
+			return nil, ErrorNegativeQuantity
+		}
+		required += b
+	}
+	if supply < required {
+		return nil, ErrorInfeasible
+	}
+
+	// Nodes are the source, the workers, the jobs and the sink, in
+	// that order.
+	source, sink := 0, rows+cols+1
+	g := newFlowNetwork(rows + cols + 2)
+	for i, a := range capacity {
+		g.addEdge(source, 1+i, float64(a), 0)
+	}
+	edges := make([][]int, rows)
+	for i := range costMatrix {
+		edges[i] = make([]int, cols)
+		for j, c := range costMatrix[i] {
+			edges[i][j] = g.addEdge(1+i, 1+rows+j, float64(demand[j]), c)
+		}
+	}
+	for j, b := range demand {
+		g.addEdge(1+rows+j, sink, float64(b), 0)
+	}
+	if flow, _ := g.minCostFlow(source, sink, float64(required)); flow < float64(required) {
+		return nil, ErrorInfeasible
+	}
+
+	allocation := make([][]int, rows)
+	for i := range edges {
+		allocation[i] = make([]int, cols)
+		for j, e := range edges[i] {
+			allocation[i][j] = int(g.flow(e))
+		}
+	}
+	return allocation, nil
+}
@@ -0,0 +1,116 @@
+package munkres_test
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/charles-haynes/munkres"
+)
+
+// return the cost of the allocation after checking that it respects the
+// capacities and meets the demands.
+func allocationCost(t *testing.T, c [][]float64, capacity, demand []int, allocation [][]int) float64 {
+	cost := 0.0
+	supplied := make([]int, len(demand))
+	for i := range allocation {
+		used := 0
+		for j, x := range allocation[i] {
+			if x < 0 {
+				t.Fatalf("negative allocation %d to (%d, %d)", x, i, j)
+			}
+			used += x
+			supplied[j] += x
+			cost += float64(x) * c[i][j]
+		}
+		if used > capacity[i] {
+			t.Fatalf("worker %d supplies %d > %d", i, used, capacity[i])
+		}
+	}
+	if !reflect.DeepEqual(supplied, demand) {
+		t.Fatalf("want supplied = %v got %v", demand, supplied)
+	}
+	return cost
+}
+
+// Replicating each worker and job once per unit turns the capacitated
+// problem into an assignment problem.
+func replicatedCost(t *testing.T, c [][]float64, capacity, demand []int) float64 {
+	var replicated [][]float64
+	for i, a := range capacity {
+		for k := 0; k < a; k++ {
+			var row []float64
+			for j, b := range demand {
+				for l := 0; l < b; l++ {
+					row = append(row, c[i][j])
+				}
+			}
+			replicated = append(replicated, row)
+		}
+	}
+	h, err := munkres.NewHungarianAlgorithm(replicated)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cost, err := munkres.ComputeCost(replicated, h.Execute())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cost
+}
+
+func TestSolveCapacitated(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 50; i++ {
+		rows, cols := 1+rng.Intn(4), 1+rng.Intn(4)
+		c := randomMatrix(rng, rows, cols)
+		capacity := make([]int, rows)
+		demand := make([]int, cols)
+		supply := 0
+		for w := range capacity {
+			capacity[w] = rng.Intn(4)
+			supply += capacity[w]
+		}
+		for j := range demand {
+			if supply > 0 {
+				demand[j] = rng.Intn(supply + 1)
+				supply -= demand[j]
+			}
+		}
+		allocation, err := munkres.SolveCapacitated(c, capacity, demand)
+		if err != nil {
+			t.Fatalf("%v %v %v: %s", c, capacity, demand, err)
+		}
+		cost := allocationCost(t, c, capacity, demand, allocation)
+		want := replicatedCost(t, c, capacity, demand)
+		if math.Abs(cost-want) > 1e-9 {
+			t.Errorf("%v %v %v: want cost = %f got %f",
+				c, capacity, demand, want, cost)
+		}
+	}
+}
+
+func TestSolveCapacitatedErrors(t *testing.T) {
+	c := [][]float64{{1, 2}, {3, 4}}
+	for _, d := range []struct {
+		name             string
+		capacity, demand []int
+		err              error
+	}{
+		{"short capacity", []int{1}, []int{1, 1}, munkres.ErrorDimensionMismatch},
+		{"long demand", []int{1, 1}, []int{1, 1, 1}, munkres.ErrorDimensionMismatch},
+		{"negative capacity", []int{-1, 3}, []int{1, 1}, munkres.ErrorNegativeQuantity},
+		{"negative demand", []int{1, 1}, []int{-1, 1}, munkres.ErrorNegativeQuantity},
+		{"excess demand", []int{1, 1}, []int{2, 1}, munkres.ErrorInfeasible},
+	} {
+		if _, err := munkres.SolveCapacitated(c, d.capacity, d.demand); err != d.err {
+			t.Errorf("%s: want err = %s got %s", d.name, d.err, err)
+		}
+	}
+	_, err := munkres.SolveCapacitated([][]float64{{1, math.NaN()}}, []int{1}, []int{1, 0})
+	if !errors.Is(err, munkres.ErrorNaNCost) {
+		t.Errorf("want err = %s got %s", munkres.ErrorNaNCost, err)
+	}
+}
@@ -0,0 +1,82 @@
+package munkres
+
+import (
+	"encoding/json"
+	"io"
+)
+
+// The JSON form of an optimality certificate written by Certificate.
+type certificate struct {
+	Workers       int            `json:"workers"`
+	Jobs          int            `json:"jobs"`
+	Cost          float64        `json:"cost"`
+	DualObjective float64        `json:"dualObjective"`
+	WorkerDuals   []float64      `json:"workerDuals"`
+	JobDuals      []float64      `json:"jobDuals"`
+	Pairs         []assignedPair `json:"pairs"`
+}
+
+// The complementary slackness check of one assigned pair.
+type assignedPair struct {
+	Worker      int     `json:"worker"`
+	Job         int     `json:"job"`
+	Cost        float64 `json:"cost"`
+	WorkerDual  float64 `json:"workerDual"`
+	JobDual     float64 `json:"jobDual"`
+	ReducedCost float64 `json:"reducedCost"`
+}
+
+// Certificate writes to w, as indented JSON, a proof of the optimality of
+// the assignment found by Execute which can be checked independently of
+// this package: the total cost; the dual prices of the workers and jobs,
+// as given by Duals, and their sum; and for each assigned pair its cost,
+// the prices of its worker and job, and its reduced cost, the cost less
+// the prices.
+//
+// To check the proof, confirm with the cost matrix that no cost is less
+// than the sum of the prices of its worker and job; that each reduced
+// cost of an assigned pair is zero, so the dual objective equals the
+// cost; and that the prices of the side with more members are never
+// positive, and are zero for those unassigned. The prices are then a
+// solution of the dual of the assignment problem whose value equals the
+// cost of the assignment, which must therefore be optimal.
+//
+// Certificate must be called after Execute, and returns
+// ErrorInvalidOption WithWorkerGroups, for which it is not available.
+func (h *HungarianAlgorithm) Certificate(w io.Writer) error {
+	if h.opts.workerGroups != nil {
+		return ErrorInvalidOption
+	}
+	workerDuals, jobDuals := h.Duals()
+	c := certificate{
+		Workers:     h.rows,
+		Jobs:        h.cols,
+		WorkerDuals: workerDuals,
+		JobDuals:    jobDuals,
+		Pairs:       []assignedPair{},
+	}
+	for _, d := range workerDuals {
+		c.DualObjective += d
+	}
+	for _, d := range jobDuals {
+		c.DualObjective += d
+	}
+	for worker, job := range h.result() {
+		if job == -1 {
+			continue
+		}
+		p := assignedPair{
+			Worker:     worker,
+			Job:        job,
+			Cost:       h.originalCost(worker, job),
+			WorkerDual: workerDuals[worker],
+			JobDual:    jobDuals[job],
+		}
+		p.ReducedCost = p.Cost - p.WorkerDual - p.JobDual
+		c.Cost += p.Cost
+		c.Pairs = append(c.Pairs, p)
+	}
+	e := json.NewEncoder(w)
+	e.SetIndent("", "  ")
+	return e.Encode(c)
+}
@@ -0,0 +1,55 @@
+package munkres_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/charles-haynes/munkres"
+)
+
+// Check a certificate written by Certificate as an auditor would, with
+// only the cost matrix.
+func TestCertificate(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 50; i++ {
+		rows, cols := 1+rng.Intn(6), 1+rng.Intn(6)
+		c := randomMatrix(rng, rows, cols)
+		h, _ := munkres.NewHungarianAlgorithm(c)
+		optimum, _ := munkres.ComputeCost(c, h.Execute())
+		var b bytes.Buffer
+		if err := h.Certificate(&b); err != nil {
+			t.Fatal(err)
+		}
+		var cert struct {
+			Cost          float64
+			DualObjective float64
+			WorkerDuals   []float64
+			JobDuals      []float64
+			Pairs         []struct {
+				Worker, Job int
+				ReducedCost float64
+			}
+		}
+		if err := json.Unmarshal(b.Bytes(), &cert); err != nil {
+			t.Fatal(err)
+		}
+		for w := range c {
+			for j := range c[w] {
+				if cert.WorkerDuals[w]+cert.JobDuals[j] > c[w][j]+1e-9 {
+					t.Errorf("%v: dual infeasible at (%d, %d)", c, w, j)
+				}
+			}
+		}
+		for _, p := range cert.Pairs {
+			if math.Abs(p.ReducedCost) > 1e-9 {
+				t.Errorf("%v: pair %v not tight", c, p)
+			}
+		}
+		if cert.Cost != optimum || math.Abs(cert.DualObjective-optimum) > 1e-9 {
+			t.Errorf("%v: want cost %f got %f and %f", c, optimum, cert.Cost, cert.DualObjective)
+		}
+	}
+}
@@ -0,0 +1,174 @@
+/*
+Command munkres-soak runs the solvers of package munkres on random
+instances for a given duration, checking every registered backend against
+a brute-force search of the optimum, as a release gate. Each discrepancy
+is reported and serialized as a JSON reproducer, holding the instance and
+the result, in the output directory.
+
+Usage:
+
+	munkres-soak [-duration d] [-seed n] [-dim n] [-max n] [-backends names] [-dir path]
+
+It exits with a non-zero status if any discrepancy is found.
+*/
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/charles-haynes/munkres"
+	"github.com/charles-haynes/munkres/generate"
+)
+
+// A reproducer of a discrepancy.
+type reproducer struct {
+	// Seed is the seed from which the instance was generated.
+	Seed    int64       `json:"seed"`
+	Backend string      `json:"backend"`
+	Costs   [][]float64 `json:"costs"`
+	// Assignment and Cost are the result of the backend, and Want the
+	// optimal cost.
+	Assignment []int   `json:"assignment"`
+	Cost       float64 `json:"cost"`
+	Want       float64 `json:"want"`
+	Error      string  `json:"error,omitempty"`
+}
+
+func main() {
+	if err := run(os.Args[1:], os.Stdout); err != nil {
+		fmt.Fprintln(os.Stderr, "munkres-soak:", err)
+		os.Exit(1)
+	}
+}
+
+func run(args []string, stdout io.Writer) error {
+	flags := flag.NewFlagSet("munkres-soak", flag.ContinueOnError)
+	duration := flags.Duration("duration", time.Minute, "how long to run")
+	seed := flags.Int64("seed", time.Now().UnixNano(), "seed of the first instance")
+	dim := flags.Int("dim", 7, "greatest `number` of workers or jobs of an instance")
+	max := flags.Int("max", 100, "costs are integers in [0, `max`)")
+	names := flags.String("backends", "", "comma-separated `names` of the backends to check; all if empty")
+	dir := flags.String("dir", ".", "`directory` in which to write reproducers")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if *dim < 1 || *max < 1 {
+		return fmt.Errorf("bad dimension %d or maximum cost %d", *dim, *max)
+	}
+	backends := munkres.Backends()
+	if *names != "" {
+		backends = strings.Split(*names, ",")
+	}
+	solvers := make([]munkres.Solver, len(backends))
+	for i, name := range backends {
+		solve, ok := munkres.LookupBackend(name)
+		if !ok {
+			return fmt.Errorf("unknown backend %q", name)
+		}
+		solvers[i] = solve
+	}
+	fmt.Fprintf(stdout, "seed %d\n", *seed)
+	deadline := time.Now().Add(*duration)
+	instances, failures := 0, 0
+	for s := *seed; time.Now().Before(deadline); s++ {
+		rng := rand.New(rand.NewSource(s))
+		c := generate.Uniform(rng, 1+rng.Intn(*dim), 1+rng.Intn(*dim), *max)
+		want := bruteForce(c)
+		for i, solve := range solvers {
+			r := check(solve, c, want)
+			if r == nil {
+				continue
+			}
+			failures++
+			r.Seed, r.Backend = s, backends[i]
+			path := filepath.Join(*dir, fmt.Sprintf("soak-%d-%s.json", s, r.Backend))
+			if err := writeReproducer(path, r); err != nil {
+				return err
+			}
+			fmt.Fprintf(stdout, "%s disagrees on the instance of seed %d: reproducer in %s\n", r.Backend, s, path)
+		}
+		instances++
+	}
+	fmt.Fprintf(stdout, "%d instances, %d discrepancies\n", instances, failures)
+	if failures > 0 {
+		return fmt.Errorf("%d discrepancies", failures)
+	}
+	return nil
+}
+
+// return a reproducer if solve fails on the cost matrix, or returns an
+// invalid or incomplete assignment, or one not costing want, or nil.
+func check(solve munkres.Solver, c [][]float64, want float64) *reproducer {
+	r := &reproducer{Costs: c, Want: want}
+	a, err := solve(c)
+	r.Assignment = a
+	if err != nil {
+		r.Error = err.Error()
+		return r
+	}
+	if r.Cost, err = munkres.ComputeCost(c, a); err != nil {
+		r.Error = err.Error()
+		return r
+	}
+	assigned := 0
+	for _, j := range a {
+		if j != -1 {
+			assigned++
+		}
+	}
+	if assigned != len(c) && assigned != len(c[0]) {
+		r.Error = "incomplete assignment"
+		return r
+	}
+	if math.Abs(r.Cost-want) > 1e-9*(1+math.Abs(want)) {
+		return r
+	}
+	return nil
+}
+
+// return the least cost of an assignment of as many workers as possible,
+// found by trying every one.
+func bruteForce(c [][]float64) float64 {
+	rows, cols := len(c), len(c[0])
+	cost := func(w, j int) float64 { return c[w][j] }
+	if rows > cols {
+		rows, cols = cols, rows
+		cost = func(j, w int) float64 { return c[w][j] }
+	}
+	used := make([]bool, cols)
+	var search func(w int) float64
+	search = func(w int) float64 {
+		if w == rows {
+			return 0
+		}
+		best := math.Inf(1)
+		for j := range used {
+			if !used[j] {
+				used[j] = true
+				best = math.Min(best, cost(w, j)+search(w+1))
+				used[j] = false
+			}
+		}
+		return best
+	}
+	return search(0)
+}
+
+// Write the reproducer as JSON to the file at path.
+func writeReproducer(path string, r *reproducer) error {
+	b, err := json.MarshalIndent(r, "", "\t")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, append(b, '\n'), 0644)
+}
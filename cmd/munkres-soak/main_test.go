@@ -0,0 +1,60 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/charles-haynes/munkres"
+)
+
+func TestRun(t *testing.T) {
+	var out bytes.Buffer
+	if err := run([]string{"-duration", "50ms", "-seed", "1", "-dir", t.TempDir()}, &out); err != nil {
+		t.Fatalf("%v\n%s", err, out.String())
+	}
+	if !strings.Contains(out.String(), " 0 discrepancies") {
+		t.Errorf("want no discrepancies got\n%s", out.String())
+	}
+}
+
+func TestRunDiscrepancy(t *testing.T) {
+	// A backend which assigns each worker the job of the same index.
+	munkres.RegisterBackend("soak-identity", func(c [][]float64) ([]int, error) {
+		a := make([]int, len(c))
+		for w := range a {
+			a[w] = -1
+			if w < len(c[0]) {
+				a[w] = w
+			}
+		}
+		return a, nil
+	})
+	dir := t.TempDir()
+	var out bytes.Buffer
+	err := run([]string{"-duration", "20ms", "-seed", "1", "-backends", "hungarian,soak-identity", "-dir", dir}, &out)
+	if err == nil || strings.Contains(out.String(), "hungarian disagrees") {
+		t.Fatalf("want discrepancies of soak-identity only got %v\n%s", err, out.String())
+	}
+	paths, _ := filepath.Glob(filepath.Join(dir, "soak-*-soak-identity.json"))
+	if len(paths) == 0 {
+		t.Fatalf("want reproducers got none")
+	}
+	b, err := os.ReadFile(paths[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	var r reproducer
+	if err := json.Unmarshal(b, &r); err != nil {
+		t.Fatal(err)
+	}
+	if r.Backend != "soak-identity" || len(r.Costs) == 0 || r.Cost <= r.Want {
+		t.Errorf("want a reproducer of a worse assignment got %+v", r)
+	}
+	if err := run([]string{"-backends", "none"}, &out); err == nil {
+		t.Errorf("want an error for an unknown backend")
+	}
+}
@@ -0,0 +1,92 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"runtime"
+
+	"github.com/charles-haynes/munkres"
+)
+
+// A problem read by batch.
+type problem struct {
+	Costs [][]float64 `json:"costs"`
+}
+
+// The result of a problem written by batch, holding either the solution
+// or the error solving it.
+type result struct {
+	Assignment []int   `json:"assignment,omitempty"`
+	Cost       float64 `json:"cost"`
+	Error      string  `json:"error,omitempty"`
+}
+
+// batch reads a stream of newline-delimited JSON problems, each an object
+// whose costs field holds a cost matrix, from stdin, solves them with the
+// named backend on concurrent workers, and writes a result for each as a
+// line of JSON to stdout, in the order of the problems. A problem which
+// cannot be solved gets a result with an error rather than stopping the
+// batch, but malformed JSON does stop it.
+func batch(args []string, stdin io.Reader, stdout io.Writer) error {
+	flags := flag.NewFlagSet("batch", flag.ContinueOnError)
+	name := flags.String("backend", "hungarian", "`name` of the backend to solve with")
+	workers := flags.Int("workers", runtime.NumCPU(), "`number` of problems to solve concurrently")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	solve, ok := munkres.LookupBackend(*name)
+	if !ok {
+		return fmt.Errorf("unknown backend %q", *name)
+	}
+	if *workers < 1 {
+		return fmt.Errorf("bad number of workers %d", *workers)
+	}
+	// Each problem gets a channel for its result, queued in input order,
+	// so that the writer waits for the results in turn while the workers
+	// solve them in any order. The queue bounds the results pending.
+	pending := make(chan chan result, *workers)
+	done := make(chan error, 1)
+	go func() {
+		encoder := json.NewEncoder(stdout)
+		var err error
+		for r := range pending {
+			if res := <-r; err == nil {
+				err = encoder.Encode(res)
+			}
+		}
+		done <- err
+	}()
+	tokens := make(chan struct{}, *workers)
+	decoder := json.NewDecoder(stdin)
+	var err error
+	for line := 1; ; line++ {
+		var p problem
+		if err = decoder.Decode(&p); err != nil {
+			if err == io.EOF {
+				err = nil
+			} else {
+				err = fmt.Errorf("problem %d: %v", line, err)
+			}
+			break
+		}
+		r := make(chan result, 1)
+		pending <- r
+		tokens <- struct{}{}
+		go func() {
+			defer func() { <-tokens }()
+			a, err := solve(p.Costs)
+			if err != nil {
+				r <- result{Error: err.Error()}
+				return
+			}
+			r <- result{Assignment: a, Cost: munkres.Assignment(a).Cost(p.Costs)}
+		}()
+	}
+	close(pending)
+	if writeErr := <-done; err == nil {
+		err = writeErr
+	}
+	return err
+}
@@ -0,0 +1,51 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestBatch(t *testing.T) {
+	var in bytes.Buffer
+	for n := 1; n <= 20; n++ {
+		// The cost of the diagonal is n, and of every other entry n+1.
+		c := make([][]float64, n)
+		for w := range c {
+			c[w] = make([]float64, n)
+			for j := range c[w] {
+				c[w][j] = float64(n + 1)
+			}
+			c[w][w] = float64(n)
+		}
+		b, _ := json.Marshal(problem{c})
+		fmt.Fprintf(&in, "%s\n", b)
+	}
+	in.WriteString(`{"costs": [[1, 2], [3]]}` + "\n")
+	var out bytes.Buffer
+	if err := run([]string{"batch", "-workers", "4"}, &in, &out); err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	if len(lines) != 21 {
+		t.Fatalf("want 21 results got %d", len(lines))
+	}
+	for i, line := range lines[:20] {
+		var r result
+		if err := json.Unmarshal([]byte(line), &r); err != nil {
+			t.Fatal(err)
+		}
+		if n := i + 1; len(r.Assignment) != n || r.Cost != float64(n*n) || r.Error != "" {
+			t.Errorf("want an assignment of %d costing %d got %s", n, n*n, line)
+		}
+	}
+	var r result
+	if err := json.Unmarshal([]byte(lines[20]), &r); err != nil || r.Error == "" {
+		t.Errorf("want an error got %s", lines[20])
+	}
+	if err := run([]string{"batch"}, strings.NewReader(`{"costs": [[1]]}{`), &out); err == nil {
+		t.Errorf("want an error for malformed JSON")
+	}
+}
@@ -0,0 +1,191 @@
+package main
+
+import (
+	"encoding/csv"
+	"flag"
+	"fmt"
+	"io"
+	"math"
+	"math/rand"
+	"os"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/charles-haynes/munkres"
+	"github.com/charles-haynes/munkres/generate"
+)
+
+// An instance to benchmark.
+type instance struct {
+	name       string
+	costMatrix [][]float64
+}
+
+// bench runs every registered backend, or those named, on generated
+// instances of the given sizes or on the CSV cost matrices supplied as
+// arguments, checks that they agree on the optimal cost, and prints a
+// table of their timings and allocations, or writes them as CSV.
+func bench(args []string, stdin io.Reader, stdout io.Writer) error {
+	flags := flag.NewFlagSet("bench", flag.ContinueOnError)
+	sizes := flags.String("sizes", "100,200,400", "comma-separated `dimensions` of the generated square instances")
+	seed := flags.Int64("seed", 1, "seed of the generated instances")
+	max := flags.Int("max", 1000, "costs of uniform instances are integers in [0, `max`)")
+	dist := flags.String("dist", "uniform", "`distribution` of the generated instances: "+distributionNames())
+	names := flags.String("backends", "", "comma-separated `names` of the backends to run; all if empty")
+	format := flags.String("format", "table", "output `format`: table or csv")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	backends := munkres.Backends()
+	if *names != "" {
+		backends = strings.Split(*names, ",")
+	}
+	solvers := make([]munkres.Solver, len(backends))
+	for i, name := range backends {
+		solve, ok := munkres.LookupBackend(name)
+		if !ok {
+			return fmt.Errorf("unknown backend %q", name)
+		}
+		solvers[i] = solve
+	}
+	if *format != "table" && *format != "csv" {
+		return fmt.Errorf("unknown output format %q", *format)
+	}
+	var instances []instance
+	if flags.NArg() > 0 {
+		for _, path := range flags.Args() {
+			c, err := readCSV(path)
+			if err != nil {
+				return err
+			}
+			instances = append(instances, instance{path, c})
+		}
+	} else {
+		generator, ok := distributions[*dist]
+		if !ok {
+			return fmt.Errorf("unknown distribution %q", *dist)
+		}
+		rng := rand.New(rand.NewSource(*seed))
+		for _, s := range strings.Split(*sizes, ",") {
+			n, err := strconv.Atoi(s)
+			if err != nil {
+				return fmt.Errorf("bad size %q", s)
+			}
+			instances = append(instances, instance{s, generator(rng, n, *max)})
+		}
+	}
+
+	tw := tabwriter.NewWriter(stdout, 0, 8, 2, ' ', tabwriter.AlignRight)
+	cw := csv.NewWriter(stdout)
+	if *format == "csv" {
+		cw.Write([]string{"instance", "backend", "seconds", "bytes", "cost", "status"})
+	} else {
+		fmt.Fprintln(tw, "instance\tbackend\ttime\tbytes\tcost\t")
+	}
+	mismatches := 0
+	for _, inst := range instances {
+		reference := math.NaN()
+		for i, solve := range solvers {
+			var before, after runtime.MemStats
+			runtime.GC()
+			runtime.ReadMemStats(&before)
+			start := time.Now()
+			a, err := solve(inst.costMatrix)
+			elapsed := time.Since(start)
+			runtime.ReadMemStats(&after)
+			if err != nil {
+				return fmt.Errorf("%s on %s: %v", backends[i], inst.name, err)
+			}
+			cost := munkres.Assignment(a).Cost(inst.costMatrix)
+			status := ""
+			if math.IsNaN(reference) {
+				reference = cost
+			} else if math.Abs(cost-reference) > 1e-9*(1+math.Abs(reference)) {
+				status = "MISMATCH"
+				mismatches++
+			}
+			bytes := after.TotalAlloc - before.TotalAlloc
+			if *format == "csv" {
+				cw.Write([]string{inst.name, backends[i],
+					strconv.FormatFloat(elapsed.Seconds(), 'g', -1, 64),
+					strconv.FormatUint(bytes, 10),
+					strconv.FormatFloat(cost, 'g', -1, 64), status})
+				continue
+			}
+			fmt.Fprintf(tw, "%s\t%s\t%v\t%d\t%g\t%s\n", inst.name, backends[i],
+				elapsed.Round(time.Microsecond), bytes, cost, status)
+		}
+	}
+	cw.Flush()
+	if err := cw.Error(); err != nil {
+		return err
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+	if mismatches > 0 {
+		return fmt.Errorf("%d results disagree on the optimal cost", mismatches)
+	}
+	return nil
+}
+
+// The distributions of generated square instances, by name.
+var distributions = map[string]func(rng *rand.Rand, n, max int) [][]float64{
+	"uniform": func(rng *rand.Rand, n, max int) [][]float64 {
+		return generate.Uniform(rng, n, n, max)
+	},
+	"machol-wien": func(rng *rand.Rand, n, max int) [][]float64 {
+		return generate.MacholWien(n)
+	},
+	"random-machol-wien": func(rng *rand.Rand, n, max int) [][]float64 {
+		return generate.RandomMacholWien(rng, n)
+	},
+	"geometric": func(rng *rand.Rand, n, max int) [][]float64 {
+		_, _, c := generate.Geometric(rng, n, n, 2, 0, 0)
+		return c
+	},
+	"tracking": func(rng *rand.Rand, n, max int) [][]float64 {
+		_, _, c := generate.Geometric(rng, n, n, 2, 0.01, 0.05)
+		return c
+	},
+	"constant": func(rng *rand.Rand, n, max int) [][]float64 {
+		return generate.Constant(n, n, 1)
+	},
+}
+
+// return the names of the distributions, in sorted order.
+func distributionNames() string {
+	var names []string
+	for name := range distributions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return strings.Join(names, ", ")
+}
+
+// return the cost matrix in the CSV file at path.
+func readCSV(path string) ([][]float64, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	records, err := csv.NewReader(f).ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	c := make([][]float64, len(records))
+	for w, record := range records {
+		c[w] = make([]float64, len(record))
+		for j, field := range record {
+			if c[w][j], err = strconv.ParseFloat(strings.TrimSpace(field), 64); err != nil {
+				return nil, fmt.Errorf("%s: row %d: %v", path, w, err)
+			}
+		}
+	}
+	return c, nil
+}
@@ -0,0 +1,67 @@
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestBench(t *testing.T) {
+	var out bytes.Buffer
+	if err := run([]string{"bench", "-sizes", "5,20"}, nil, &out); err != nil {
+		t.Fatal(err)
+	}
+	for _, dist := range []string{"machol-wien", "random-machol-wien", "geometric", "tracking", "constant"} {
+		if err := run([]string{"bench", "-sizes", "10", "-dist", dist}, nil, &bytes.Buffer{}); err != nil {
+			t.Errorf("%s: %v", dist, err)
+		}
+	}
+	for _, backend := range []string{"auction", "flow", "hungarian", "mixed", "rows", "sparse"} {
+		if strings.Count(out.String(), backend) != 2 {
+			t.Errorf("want two results for %s got\n%s", backend, out.String())
+		}
+	}
+}
+
+func TestBenchCSV(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "costs.csv")
+	if err := os.WriteFile(path, []byte("4,1,3\n2,0,5\n3,2,2\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	var out bytes.Buffer
+	if err := run([]string{"bench", "-backends", "hungarian,rows", path}, nil, &out); err != nil {
+		t.Fatal(err)
+	}
+	costs := 0
+	for _, line := range strings.Split(out.String(), "\n") {
+		if fields := strings.Fields(line); len(fields) == 5 && fields[4] == "5" {
+			costs++
+		}
+	}
+	if costs != 2 {
+		t.Errorf("want a cost of 5 from both backends got\n%s", out.String())
+	}
+	if err := run([]string{"bench", "-backends", "none"}, nil, &out); err == nil {
+		t.Errorf("want an error for an unknown backend")
+	}
+}
+
+func TestBenchFormatCSV(t *testing.T) {
+	var out bytes.Buffer
+	if err := run([]string{"bench", "-sizes", "5,10", "-backends", "hungarian,rows", "-format", "csv"}, nil, &out); err != nil {
+		t.Fatal(err)
+	}
+	records, err := csv.NewReader(&out).ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 5 || records[0][2] != "seconds" || records[1][0] != "5" || records[4][1] != "rows" {
+		t.Errorf("want a header and four results got %v", records)
+	}
+	if err := run([]string{"bench", "-format", "xml"}, nil, &out); err == nil {
+		t.Errorf("want an error for an unknown format")
+	}
+}
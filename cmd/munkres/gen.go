@@ -0,0 +1,53 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"math/rand"
+	"strconv"
+)
+
+// gen writes a square cost matrix generated as bench generates its
+// instances, so that load tests and bug reports can use reproducible
+// inputs. The matrix is written as CSV, as bench reads it, or as a line of
+// JSON holding a problem, as batch reads it.
+func gen(args []string, stdin io.Reader, stdout io.Writer) error {
+	flags := flag.NewFlagSet("gen", flag.ContinueOnError)
+	n := flags.Int("n", 100, "`dimension` of the matrix")
+	seed := flags.Int64("seed", 1, "seed of the matrix")
+	max := flags.Int("max", 1000, "costs of uniform matrices are integers in [0, `max`)")
+	dist := flags.String("dist", "uniform", "`distribution` of the matrix: "+distributionNames())
+	out := flags.String("out", "csv", "output `format`: csv or json")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	generator, ok := distributions[*dist]
+	if !ok {
+		return fmt.Errorf("unknown distribution %q", *dist)
+	}
+	if *n < 0 {
+		return fmt.Errorf("bad dimension %d", *n)
+	}
+	c := generator(rand.New(rand.NewSource(*seed)), *n, *max)
+	switch *out {
+	case "csv":
+		w := csv.NewWriter(stdout)
+		record := make([]string, *n)
+		for _, row := range c {
+			for j, cost := range row {
+				record[j] = strconv.FormatFloat(cost, 'g', -1, 64)
+			}
+			if err := w.Write(record); err != nil {
+				return err
+			}
+		}
+		w.Flush()
+		return w.Error()
+	case "json":
+		return json.NewEncoder(stdout).Encode(problem{c})
+	}
+	return fmt.Errorf("unknown output format %q", *out)
+}
@@ -0,0 +1,47 @@
+package main
+
+import (
+	"bytes"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestGen(t *testing.T) {
+	var a, b bytes.Buffer
+	for _, out := range []*bytes.Buffer{&a, &b} {
+		if err := run([]string{"gen", "--n", "6", "--dist", "geometric", "--seed", "42"}, nil, out); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if a.String() != b.String() {
+		t.Errorf("want the same matrix for the same seed got\n%s\n%s", a.String(), b.String())
+	}
+	path := filepath.Join(t.TempDir(), "costs.csv")
+	if err := os.WriteFile(path, a.Bytes(), 0644); err != nil {
+		t.Fatal(err)
+	}
+	c, err := readCSV(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := distributions["geometric"]
+	if !reflect.DeepEqual(c, want(rand.New(rand.NewSource(42)), 6, 1000)) {
+		t.Errorf("want the generated matrix got %v", c)
+	}
+	// The JSON is a problem for batch.
+	var problems, results bytes.Buffer
+	if err := run([]string{"gen", "-n", "4", "-out", "json"}, nil, &problems); err != nil {
+		t.Fatal(err)
+	}
+	if err := run([]string{"batch"}, &problems, &results); err != nil || results.Len() == 0 {
+		t.Errorf("want a result got %q, %v", results.String(), err)
+	}
+	for _, args := range [][]string{{"gen", "-out", "xml"}, {"gen", "-dist", "none"}, {"gen", "-n", "-1"}} {
+		if err := run(args, nil, &bytes.Buffer{}); err == nil {
+			t.Errorf("%v: want an error", args)
+		}
+	}
+}
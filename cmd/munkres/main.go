@@ -0,0 +1,54 @@
+/*
+Command munkres runs the solvers of package munkres from the command line.
+
+Usage:
+
+	munkres <command> [arguments]
+
+The commands are:
+
+	batch	solve a stream of problems as newline-delimited JSON
+	bench	compare the timing, memory and results of the backends
+	gen	generate a cost matrix as CSV or JSON
+	repl	edit a cost matrix interactively, re-solving after each edit
+	serve	solve problems over HTTP, with a playground UI
+	solve	solve a cost matrix read as CSV or JSON
+	viz	render a cost matrix and its solution as an HTML heatmap
+*/
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+)
+
+// The commands, each of which runs with its arguments, reading any input
+// from stdin and writing its output to stdout.
+var commands = map[string]func(args []string, stdin io.Reader, stdout io.Writer) error{
+	"bench": bench,
+	"batch": batch,
+	"gen":   gen,
+	"repl":  repl,
+	"serve": serve,
+	"solve": solve,
+	"viz":   viz,
+}
+
+func main() {
+	if err := run(os.Args[1:], os.Stdin, os.Stdout); err != nil {
+		fmt.Fprintln(os.Stderr, "munkres:", err)
+		os.Exit(1)
+	}
+}
+
+func run(args []string, stdin io.Reader, stdout io.Writer) error {
+	if len(args) == 0 {
+		return fmt.Errorf("usage: munkres <command> [arguments]")
+	}
+	command, ok := commands[args[0]]
+	if !ok {
+		return fmt.Errorf("unknown command %q", args[0])
+	}
+	return command(args[1:], stdin, stdout)
+}
@@ -0,0 +1,201 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/charles-haynes/munkres"
+)
+
+const replHelp = `commands:
+	set w j cost	set the cost of worker w doing job j
+	forbid w j	forbid worker w doing job j
+	allow w j	allow worker w doing job j again
+	pin w j		require worker w to do job j
+	unpin w		release the pin of worker w
+	show		print the matrix, marking the assignment
+	help		print this help
+	quit		exit`
+
+// The state of a repl session: the matrix as edited, its constraints and
+// the algorithm which is reoptimized after each edit.
+type session struct {
+	costs     [][]float64
+	forbidden [][]bool
+	// pinnedJob is the job each worker is pinned to, and pinnedWorker
+	// the worker each job is pinned to, or -1.
+	pinnedJob, pinnedWorker []int
+	h                       munkres.HungarianAlgorithm
+	assignment              []int
+	err                     error
+}
+
+// repl loads the cost matrix in the CSV file given as its argument and
+// reads commands from stdin which edit it, setting costs and forbidding
+// or pinning pairs, printing the assignment after each edit, which is
+// repaired incrementally rather than solved again. help lists the
+// commands.
+func repl(args []string, stdin io.Reader, stdout io.Writer) error {
+	if len(args) != 1 {
+		return fmt.Errorf("usage: munkres repl problem.csv")
+	}
+	c, err := readCSV(args[0])
+	if err != nil {
+		return err
+	}
+	jobs := 0
+	if len(c) > 0 {
+		jobs = len(c[0])
+	}
+	s := &session{
+		costs:        c,
+		forbidden:    make([][]bool, len(c)),
+		pinnedJob:    make([]int, len(c)),
+		pinnedWorker: make([]int, jobs),
+	}
+	for w := range c {
+		s.forbidden[w] = make([]bool, jobs)
+		s.pinnedJob[w] = -1
+	}
+	for j := range s.pinnedWorker {
+		s.pinnedWorker[j] = -1
+	}
+	if s.h, err = munkres.NewHungarianAlgorithm(c, munkres.WithRejection(s.rejected)); err != nil {
+		return err
+	}
+	s.assignment, s.err = s.h.Solve()
+	s.print(stdout)
+	in := bufio.NewScanner(stdin)
+	for fmt.Fprint(stdout, "> "); in.Scan(); fmt.Fprint(stdout, "> ") {
+		fields := strings.Fields(in.Text())
+		if len(fields) == 0 {
+			continue
+		}
+		switch fields[0] {
+		case "quit", "exit":
+			return nil
+		case "help":
+			fmt.Fprintln(stdout, replHelp)
+		case "show":
+			s.show(stdout)
+		default:
+			if err := s.edit(fields); err != nil {
+				fmt.Fprintln(stdout, "error:", err)
+				continue
+			}
+			s.assignment, s.err = s.h.Reoptimize(s.costs)
+			s.print(stdout)
+		}
+	}
+	fmt.Fprintln(stdout)
+	return in.Err()
+}
+
+// Report whether the pair of worker w and job j is forbidden or excluded
+// by a pin.
+func (s *session) rejected(w, j int) bool {
+	return s.forbidden[w][j] || s.pinnedJob[w] != -1 && s.pinnedJob[w] != j ||
+		s.pinnedWorker[j] != -1 && s.pinnedWorker[j] != w
+}
+
+// Apply the edit of a command other than quit, help and show, marking
+// the workers and jobs it changes as dirty.
+func (s *session) edit(fields []string) error {
+	var arity int
+	switch fields[0] {
+	case "set":
+		arity = 3
+	case "forbid", "allow", "pin":
+		arity = 2
+	case "unpin":
+		arity = 1
+	default:
+		return fmt.Errorf("unknown command %q; try help", fields[0])
+	}
+	if len(fields) != arity+1 {
+		return fmt.Errorf("%s takes %d arguments", fields[0], arity)
+	}
+	w, err := s.index(fields[1], len(s.costs), "worker")
+	if err != nil {
+		return err
+	}
+	if fields[0] == "unpin" {
+		if j := s.pinnedJob[w]; j != -1 {
+			s.pinnedJob[w], s.pinnedWorker[j] = -1, -1
+			return s.h.MarkDirty([]int{w}, []int{j})
+		}
+		return nil
+	}
+	j, err := s.index(fields[2], len(s.pinnedWorker), "job")
+	if err != nil {
+		return err
+	}
+	switch fields[0] {
+	case "set":
+		cost, err := strconv.ParseFloat(fields[3], 64)
+		if err != nil || math.IsNaN(cost) || math.IsInf(cost, 0) {
+			return fmt.Errorf("invalid cost %q", fields[3])
+		}
+		s.costs[w][j] = cost
+	case "forbid", "allow":
+		s.forbidden[w][j] = fields[0] == "forbid"
+	case "pin":
+		rows, cols := []int{w}, []int{j}
+		if k := s.pinnedJob[w]; k != -1 {
+			s.pinnedWorker[k] = -1
+			cols = append(cols, k)
+		}
+		if v := s.pinnedWorker[j]; v != -1 {
+			s.pinnedJob[v] = -1
+			rows = append(rows, v)
+		}
+		s.pinnedJob[w], s.pinnedWorker[j] = j, w
+		return s.h.MarkDirty(rows, cols)
+	}
+	return s.h.MarkDirty([]int{w}, []int{j})
+}
+
+// return the index parsed from field, if it is one of n workers or jobs.
+func (s *session) index(field string, n int, what string) (int, error) {
+	i, err := strconv.Atoi(field)
+	if err != nil || i < 0 || i >= n {
+		return 0, fmt.Errorf("no %s %q", what, field)
+	}
+	return i, nil
+}
+
+// Print the assignment and its cost, or why there is none.
+func (s *session) print(w io.Writer) {
+	if s.err != nil {
+		fmt.Fprintln(w, "no assignment:", s.err)
+		return
+	}
+	fmt.Fprintf(w, "assignment %v cost %v\n", s.assignment, munkres.Assignment(s.assignment).Cost(s.costs))
+}
+
+// Print the matrix, bracketing the assigned costs and marking forbidden
+// pairs with x and pinned ones with *.
+func (s *session) show(w io.Writer) {
+	for i, row := range s.costs {
+		fields := make([]string, len(row))
+		for j, cost := range row {
+			field := strconv.FormatFloat(cost, 'g', -1, 64)
+			switch {
+			case s.forbidden[i][j]:
+				field += "x"
+			case s.pinnedJob[i] == j:
+				field += "*"
+			}
+			if s.err == nil && s.assignment[i] == j {
+				field = "[" + field + "]"
+			}
+			fields[j] = field
+		}
+		fmt.Fprintln(w, strings.Join(fields, "\t"))
+	}
+	s.print(w)
+}
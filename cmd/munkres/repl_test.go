@@ -0,0 +1,56 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRepl(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "costs.csv")
+	if err := os.WriteFile(path, []byte("4,1,3\n2,0,5\n3,2,2\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	script := strings.Join([]string{
+		"set 0 1 9",
+		"forbid 1 0",
+		"pin 2 0",
+		"show",
+		"unpin 2",
+		"forbid 0 0",
+		"forbid 0 2",
+		"allow 0 2",
+		"bogus",
+		"set 5 0 1",
+		"quit",
+	}, "\n")
+	var out bytes.Buffer
+	if err := run([]string{"repl", path}, strings.NewReader(script), &out); err != nil {
+		t.Fatal(err)
+	}
+	want := []string{
+		"assignment [1 0 2] cost 5",
+		"> assignment [0 1 2] cost 6",
+		"> assignment [0 1 2] cost 6",
+		"> assignment [2 1 0] cost 6",
+		"> 4\t9\t[3]",
+		"2x\t[0]\t5",
+		"[3*]\t2\t2",
+		"assignment [2 1 0] cost 6",
+		"> assignment [2 1 0] cost 6",
+		"> assignment [2 1 0] cost 6",
+		"> assignment [1 2 0] cost 17",
+		"> assignment [2 1 0] cost 6",
+		`> error: unknown command "bogus"; try help`,
+		`> error: no worker "5"`,
+		"> ",
+	}
+	if got := strings.Split(out.String(), "\n"); strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("want\n%s\ngot\n%s", strings.Join(want, "\n"), out.String())
+	}
+	if err := run([]string{"repl"}, nil, &out); err == nil {
+		t.Errorf("want an error without a path")
+	}
+}
@@ -0,0 +1,231 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"io"
+	"math"
+	"net/http"
+	"runtime"
+
+	"github.com/charles-haynes/munkres"
+	"github.com/charles-haynes/munkres/server"
+)
+
+// serve runs the solver as an HTTP service, solving the problems POSTed
+// to /solve as package server does and, with -ui, serving a playground at
+// / in which a matrix can be pasted or uploaded and solved with options.
+// With -cache-ttl, identical problems POSTed within that time of each
+// other are solved once.
+func serve(args []string, stdin io.Reader, stdout io.Writer) error {
+	flags := flag.NewFlagSet("serve", flag.ContinueOnError)
+	addr := flags.String("addr", ":8080", "`address` to listen on")
+	workers := flags.Int("workers", runtime.NumCPU(), "`number` of problems to solve at once")
+	queue := flags.Int("queue", 64, "`number` of problems which may wait for a worker")
+	ui := flags.Bool("ui", false, "serve the playground")
+	cacheTTL := flags.Duration("cache-ttl", 0, "`duration` to cache solutions in memory for; none if zero")
+	maxBody := flags.Int64("max-body", server.DefaultMaxBodySize, "greatest `size` in bytes of a request")
+	maxDim := flags.Int("max-dim", server.DefaultMaxDimension, "greatest `dimension` of the costs of a request")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	config := server.Config{Workers: *workers, QueueSize: *queue, MaxBodySize: *maxBody, MaxDimension: *maxDim}
+	if *cacheTTL != 0 {
+		config.Cache, config.CacheTTL = server.NewMemoryCache(), *cacheTTL
+	}
+	srv, err := server.New(config)
+	if err != nil {
+		return err
+	}
+	defer srv.Close()
+	return http.ListenAndServe(*addr, newServeMux(srv, *ui))
+}
+
+// return the handler of serve.
+func newServeMux(srv *server.Server, ui bool) *http.ServeMux {
+	mux := http.NewServeMux()
+	mux.Handle("/solve", srv)
+	if ui {
+		mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/" {
+				http.NotFound(w, r)
+				return
+			}
+			w.Header().Set("Content-Type", "text/html; charset=utf-8")
+			io.WriteString(w, playgroundPage)
+		})
+		mux.HandleFunc("/playground", func(w http.ResponseWriter, r *http.Request) {
+			playground(srv, w, r)
+		})
+	}
+	return mux
+}
+
+// A problem solved by the playground with its options.
+type playgroundProblem struct {
+	Costs [][]float64 `json:"costs"`
+	// Maximize finds the assignments of greatest total cost.
+	Maximize bool `json:"maximize"`
+	// Gate, if set, forbids the pairs costing more than it, or less if
+	// maximizing.
+	Gate *float64 `json:"gate"`
+	// K is the number of best assignments to rank, at least one.
+	K int `json:"k"`
+}
+
+// solve ranks the K best assignments of the problem. Each assigns as many
+// workers as the gate allows, all of them if there are no more than
+// jobs.
+func (p playgroundProblem) solve() ([]server.Solution, error) {
+	if p.K < 1 {
+		p.K = 1
+	}
+	// RankHypotheses leaves pairs unassigned at no cost, so lower every
+	// allowed cost by more than the cost of any assignment can change by
+	// assigning one more pair, which ranks the assignments of the most
+	// pairs first.
+	least, greatest := math.Inf(1), math.Inf(-1)
+	for _, row := range p.Costs {
+		for _, c := range row {
+			least, greatest = math.Min(least, c), math.Max(greatest, c)
+		}
+	}
+	pairs := len(p.Costs)
+	if pairs > 0 && len(p.Costs[0]) < pairs {
+		pairs = len(p.Costs[0])
+	}
+	shift := 1 + float64(pairs+1)*(greatest-least+math.Abs(greatest)+math.Abs(least))
+	shifted := make([][]float64, len(p.Costs))
+	for w, row := range p.Costs {
+		shifted[w] = make([]float64, len(row))
+		for j, c := range row {
+			if p.Maximize {
+				c = -c
+			}
+			shifted[w][j] = c - shift
+			if p.Gate != nil && (!p.Maximize && p.Costs[w][j] > *p.Gate ||
+				p.Maximize && p.Costs[w][j] < *p.Gate) {
+				shifted[w][j] = math.Inf(1)
+			}
+		}
+	}
+	hypotheses, err := munkres.RankHypotheses(shifted, p.K)
+	if err != nil {
+		return nil, err
+	}
+	var solutions []server.Solution
+	for _, h := range hypotheses {
+		if len(h.UnassignedTracks) > len(hypotheses[0].UnassignedTracks) {
+			break
+		}
+		solutions = append(solutions, server.Solution{
+			Assignment: h.Assignment,
+			Cost:       h.Assignment.Cost(p.Costs),
+		})
+	}
+	return solutions, nil
+}
+
+// playground solves the playgroundProblem POSTed as JSON, responding with
+// its solutions as JSON. The problem is held to the limits of srv on the
+// requests to /solve.
+func playground(srv *server.Server, w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	var p playgroundProblem
+	if !srv.Decode(w, r, &p) {
+		return
+	}
+	if err := srv.CheckDimension(p.Costs); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	solutions, err := p.solve()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(solutions)
+}
+
+// The page of the playground, which posts the matrix and options to
+// /playground and shows the solutions.
+const playgroundPage = `<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>munkres playground</title>
+<style>
+body { font-family: sans-serif; max-width: 50em; margin: 2em auto; }
+textarea { width: 100%; height: 12em; font-family: monospace; }
+table { border-collapse: collapse; margin: 0.5em 0; }
+td { border: 1px solid #ccc; padding: 0.2em 0.5em; text-align: right; }
+td.selected { background: #9d9; font-weight: bold; }
+#error { color: #c00; }
+</style>
+</head>
+<body>
+<h1>munkres playground</h1>
+<p>Paste a cost matrix, one row per line with the costs separated by commas or spaces, or upload a CSV file.</p>
+<textarea id="matrix">4, 1, 3
+2, 0, 5
+3, 2, 2</textarea>
+<p>
+<input type="file" id="file" accept=".csv,.txt">
+<label><input type="checkbox" id="maximize"> maximize</label>
+<label>gate <input type="number" id="gate" step="any" placeholder="none"></label>
+<label>k-best <input type="number" id="k" min="1" value="1"></label>
+<button id="solve">Solve</button>
+</p>
+<div id="error"></div>
+<div id="solutions"></div>
+<script>
+function $(id) { return document.getElementById(id); }
+$("file").onchange = function() {
+	var reader = new FileReader();
+	reader.onload = function() { $("matrix").value = reader.result; };
+	reader.readAsText($("file").files[0]);
+};
+$("solve").onclick = function() {
+	var costs = $("matrix").value.trim().split(/\n/).map(function(line) {
+		return line.trim().split(/[\s,]+/).map(Number);
+	});
+	var problem = {costs: costs, maximize: $("maximize").checked, k: Number($("k").value) || 1};
+	if ($("gate").value !== "") {
+		problem.gate = Number($("gate").value);
+	}
+	$("error").textContent = "";
+	$("solutions").innerHTML = "";
+	fetch("/playground", {method: "POST", body: JSON.stringify(problem)}).then(function(r) {
+		if (!r.ok) {
+			return r.text().then(function(text) { throw new Error(text); });
+		}
+		return r.json();
+	}).then(function(solutions) {
+		(solutions || []).forEach(function(s, i) {
+			var h = document.createElement("h3");
+			h.textContent = "#" + (i + 1) + ": cost " + s.cost;
+			var table = document.createElement("table");
+			costs.forEach(function(row, w) {
+				var tr = table.insertRow();
+				row.forEach(function(c, j) {
+					var td = tr.insertCell();
+					td.textContent = c;
+					if (s.assignment[w] === j) {
+						td.className = "selected";
+					}
+				});
+			});
+			$("solutions").appendChild(h);
+			$("solutions").appendChild(table);
+		});
+	}).catch(function(e) { $("error").textContent = e.message; });
+};
+</script>
+</body>
+</html>
+`
@@ -0,0 +1,95 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/charles-haynes/munkres/server"
+)
+
+func TestServe(t *testing.T) {
+	srv, err := server.New(server.Config{Workers: 1, QueueSize: 1, MaxBodySize: 200, MaxDimension: 4})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer srv.Close()
+	ts := httptest.NewServer(newServeMux(srv, true))
+	defer ts.Close()
+	r, err := http.Get(ts.URL + "/")
+	if err != nil {
+		t.Fatal(err)
+	}
+	r.Body.Close()
+	if r.StatusCode != http.StatusOK || !strings.HasPrefix(r.Header.Get("Content-Type"), "text/html") {
+		t.Errorf("want the playground got %s", r.Status)
+	}
+	costs := `[[4, 1, 3], [2, 0, 5], [3, 2, 2]]`
+	for _, test := range []struct {
+		problem string
+		want    []server.Solution
+	}{
+		{`{"costs": ` + costs + `}`, []server.Solution{{Assignment: []int{1, 0, 2}, Cost: 5}}},
+		{`{"costs": ` + costs + `, "k": 3}`, []server.Solution{
+			{Assignment: []int{1, 0, 2}, Cost: 5},
+			{Assignment: []int{2, 1, 0}, Cost: 6},
+			{Assignment: []int{0, 1, 2}, Cost: 6},
+		}},
+		{`{"costs": ` + costs + `, "maximize": true}`, []server.Solution{{Assignment: []int{0, 2, 1}, Cost: 11}}},
+		// Gating out the costs over 1 leaves at most one pair.
+		{`{"costs": ` + costs + `, "gate": 1, "k": 5}`, []server.Solution{
+			{Assignment: []int{-1, 1, -1}, Cost: 0},
+			{Assignment: []int{1, -1, -1}, Cost: 1},
+		}},
+	} {
+		r, err := http.Post(ts.URL+"/playground", "application/json", strings.NewReader(test.problem))
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got []server.Solution
+		err = json.NewDecoder(r.Body).Decode(&got)
+		r.Body.Close()
+		if err != nil || !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%s: want %v got %v, %v", test.problem, test.want, got, err)
+		}
+	}
+	for _, test := range []struct {
+		problem string
+		status  int
+	}{
+		{`{"costs": [[1, 2], [3]]}`, http.StatusBadRequest},
+		{`{"costs": [[1, 2, 3, 4, 5]]}`, http.StatusBadRequest},
+		{`{"costs": [` + strings.Repeat("[1, 2], ", 100) + `[1, 2]]}`, http.StatusRequestEntityTooLarge},
+	} {
+		r, err = http.Post(ts.URL+"/playground", "application/json", strings.NewReader(test.problem))
+		if err != nil {
+			t.Fatal(err)
+		}
+		r.Body.Close()
+		if r.StatusCode != test.status {
+			t.Errorf("%.40s: want %d got %s", test.problem, test.status, r.Status)
+		}
+	}
+	r, err = http.Post(ts.URL+"/solve", "application/json", strings.NewReader(`{"costs": `+costs+`}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	r.Body.Close()
+	if r.StatusCode != http.StatusOK {
+		t.Errorf("want %d got %s", http.StatusOK, r.Status)
+	}
+	// Without the playground only /solve is served.
+	ts2 := httptest.NewServer(newServeMux(srv, false))
+	defer ts2.Close()
+	r, err = http.Get(ts2.URL + "/")
+	if err != nil {
+		t.Fatal(err)
+	}
+	r.Body.Close()
+	if r.StatusCode != http.StatusNotFound {
+		t.Errorf("want %d got %s", http.StatusNotFound, r.Status)
+	}
+}
@@ -0,0 +1,142 @@
+package main
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/charles-haynes/munkres"
+)
+
+// solve reads a cost matrix from the file given as its argument, or from
+// stdin, and writes its assignment of least total cost, or greatest with
+// -maximize, so that shell pipelines and other implementations can use
+// the solver. The matrix is read as CSV, as bench reads it, or as JSON,
+// either an array of rows or a problem as gen writes; by default the
+// format is told by the first character. The assignment is written as
+// the pairs of workers and jobs, one per line, as a line of JSON with its
+// total cost, as batch writes, or as CSV with the cost of each pair.
+func solve(args []string, stdin io.Reader, stdout io.Writer) error {
+	flags := flag.NewFlagSet("solve", flag.ContinueOnError)
+	in := flags.String("in", "auto", "input `format`: auto, csv or json")
+	out := flags.String("out", "pairs", "output `format`: pairs, json or csv")
+	maximize := flags.Bool("maximize", false, "find the assignment of greatest total cost")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	name := "stdin"
+	switch flags.NArg() {
+	case 0:
+	case 1:
+		name = flags.Arg(0)
+		f, err := os.Open(name)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		stdin = f
+	default:
+		return fmt.Errorf("usage: munkres solve [flags] [problem]")
+	}
+	c, err := readMatrix(bufio.NewReader(stdin), *in)
+	if err != nil {
+		return fmt.Errorf("%s: %v", name, err)
+	}
+	var opts []munkres.Option
+	if *maximize {
+		opts = append(opts, munkres.WithMaximize())
+	}
+	h, err := munkres.NewHungarianAlgorithm(c, opts...)
+	if err != nil {
+		return fmt.Errorf("%s: %v", name, err)
+	}
+	a, err := h.Solve()
+	if err != nil {
+		return fmt.Errorf("%s: %v", name, err)
+	}
+	switch *out {
+	case "pairs":
+		for w, j := range a {
+			if j != -1 {
+				fmt.Fprintln(stdout, w, j)
+			}
+		}
+		return nil
+	case "json":
+		return json.NewEncoder(stdout).Encode(result{Assignment: a, Cost: munkres.Assignment(a).Cost(c)})
+	case "csv":
+		cw := csv.NewWriter(stdout)
+		cw.Write([]string{"worker", "job", "cost"})
+		for w, j := range a {
+			if j != -1 {
+				cw.Write([]string{strconv.Itoa(w), strconv.Itoa(j), strconv.FormatFloat(c[w][j], 'g', -1, 64)})
+			}
+		}
+		cw.Flush()
+		return cw.Error()
+	}
+	return fmt.Errorf("unknown output format %q", *out)
+}
+
+// return the cost matrix read from r in the format, telling JSON from CSV
+// by its first character if the format is auto. Rows of differing lengths
+// are read as they are, for the solver to reject.
+func readMatrix(r *bufio.Reader, format string) ([][]float64, error) {
+	if format == "auto" {
+		format = "csv"
+		for {
+			b, err := r.ReadByte()
+			if err != nil {
+				break
+			}
+			if !strings.ContainsRune(" \t\r\n", rune(b)) {
+				if b == '[' || b == '{' {
+					format = "json"
+				}
+				r.UnreadByte()
+				break
+			}
+		}
+	}
+	switch format {
+	case "json":
+		var data json.RawMessage
+		if err := json.NewDecoder(r).Decode(&data); err != nil {
+			return nil, err
+		}
+		var c [][]float64
+		var err error
+		if data[0] == '{' {
+			var p problem
+			err = json.Unmarshal(data, &p)
+			c = p.Costs
+		} else {
+			err = json.Unmarshal(data, &c)
+		}
+		return c, err
+	case "csv":
+		cr := csv.NewReader(r)
+		cr.FieldsPerRecord = -1
+		records, err := cr.ReadAll()
+		if err != nil {
+			return nil, err
+		}
+		c := make([][]float64, len(records))
+		for w, record := range records {
+			c[w] = make([]float64, len(record))
+			for j, field := range record {
+				if c[w][j], err = strconv.ParseFloat(strings.TrimSpace(field), 64); err != nil {
+					return nil, fmt.Errorf("row %d: %v", w, err)
+				}
+			}
+		}
+		return c, nil
+	}
+	return nil, fmt.Errorf("unknown input format %q", format)
+}
@@ -0,0 +1,72 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestSolve(t *testing.T) {
+	const costs = "4,1,3\n2,0,5\n3,2,2\n"
+	for _, tc := range []struct {
+		args  []string
+		input string
+		want  string
+	}{
+		{nil, costs, "0 1\n1 0\n2 2\n"},
+		{[]string{"-maximize"}, costs, "0 0\n1 2\n2 1\n"},
+		{[]string{"-out", "csv"}, costs, "worker,job,cost\n0,1,1\n1,0,2\n2,2,2\n"},
+		{[]string{"-out", "json"}, costs, `{"assignment":[1,0,2],"cost":5}` + "\n"},
+		{nil, "[[4, 1, 3], [2, 9, 5]]", "0 1\n1 0\n"},
+		{[]string{"-in", "json"}, `{"costs": [[1, 2], [2, 1], [0, 9]]}`, "1 1\n2 0\n"},
+		{nil, "", ""},
+	} {
+		var out bytes.Buffer
+		if err := run(append([]string{"solve"}, tc.args...), strings.NewReader(tc.input), &out); err != nil {
+			t.Fatalf("%v %q: %v", tc.args, tc.input, err)
+		}
+		if out.String() != tc.want {
+			t.Errorf("%v %q: want %q got %q", tc.args, tc.input, tc.want, out.String())
+		}
+	}
+}
+
+func TestSolveFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "costs.csv")
+	if err := os.WriteFile(path, []byte("1,2\n2,1\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	var out bytes.Buffer
+	if err := run([]string{"solve", "-out", "json", path}, nil, &out); err != nil {
+		t.Fatal(err)
+	}
+	var r result
+	if err := json.Unmarshal(out.Bytes(), &r); err != nil || r.Cost != 2 {
+		t.Errorf("want cost 2 got %q, %v", out.String(), err)
+	}
+}
+
+func TestSolveErrors(t *testing.T) {
+	for _, tc := range []struct {
+		args  []string
+		input string
+		want  string
+	}{
+		{nil, "1,2\n3\n", "Irregular cost matrix"},
+		{nil, "1,NaN\n3,4\n", "NaN cost"},
+		{nil, "1,x\n", "row 0"},
+		{nil, "[[1, 2], [3]]", "Irregular cost matrix"},
+		{nil, "[[1, 2", "unexpected EOF"},
+		{[]string{"-out", "xml"}, "1\n", "unknown output format"},
+		{[]string{"-in", "xml"}, "1\n", "unknown input format"},
+		{[]string{"a", "b"}, "", "usage"},
+	} {
+		err := run(append([]string{"solve"}, tc.args...), strings.NewReader(tc.input), &bytes.Buffer{})
+		if err == nil || !strings.Contains(err.Error(), tc.want) {
+			t.Errorf("%v %q: want an error containing %q got %v", tc.args, tc.input, tc.want, err)
+		}
+	}
+}
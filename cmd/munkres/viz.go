@@ -0,0 +1,132 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"html/template"
+	"io"
+	"math"
+	"os"
+
+	"github.com/charles-haynes/munkres"
+)
+
+// A cell of the heatmap written by viz.
+type cell struct {
+	Cost     float64
+	Color    template.CSS
+	Selected bool
+	// Reduced is the reduced cost, the cost less the duals of the worker
+	// and the job, which is zero for every selected cell.
+	Reduced float64
+}
+
+// The data rendered by vizTemplate.
+type heatmap struct {
+	Title      string
+	Cost       float64
+	Rows       [][]cell
+	WorkerDual []float64
+	JobDual    []float64
+}
+
+var vizTemplate = template.Must(template.New("viz").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>{{.Title}}</title>
+<style>
+body { font-family: sans-serif; }
+table { border-collapse: collapse; }
+td, th { width: 1.2em; height: 1.2em; padding: 0; font-size: 9px; text-align: center; }
+td { border: 1px solid #fff; }
+td.selected { outline: 2px solid #000; outline-offset: -2px; }
+td:hover { outline: 2px solid #06f; outline-offset: -2px; }
+</style>
+</head>
+<body>
+<h1>{{.Title}}</h1>
+<p>Minimum total cost {{.Cost}}. Selected cells are outlined; hover over a cell for its cost and duals.</p>
+<table>
+<tr><th></th>{{range $j, $v := .JobDual}}<th title="job {{$j}}, dual {{$v}}">{{$j}}</th>{{end}}</tr>
+{{- $jobs := .JobDual}}{{$workers := .WorkerDual}}
+{{range $w, $row := .Rows}}<tr><th title="worker {{$w}}, dual {{index $workers $w}}">{{$w}}</th>
+{{- range $j, $c := $row}}<td{{if $c.Selected}} class="selected"{{end}} style="{{$c.Color}}" title="worker {{$w}}, job {{$j}}: cost {{$c.Cost}}, duals {{index $workers $w}} + {{index $jobs $j}}, reduced cost {{$c.Reduced}}"></td>{{end}}</tr>
+{{end}}</table>
+</body>
+</html>
+`))
+
+// viz renders the cost matrix in the CSV file given as its argument as an
+// HTML heatmap, from green for the least cost to red for the greatest,
+// outlining the cells of an optimal assignment, with the cost and the
+// duals of each cell shown on hovering over it, to debug medium-sized
+// instances visually.
+func viz(args []string, stdin io.Reader, stdout io.Writer) error {
+	flags := flag.NewFlagSet("viz", flag.ContinueOnError)
+	out := flags.String("o", "", "`path` of the HTML file to write; stdout if empty")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	// Allow the flags to follow the path, as in viz problem.csv -o out.html.
+	if flags.NArg() > 0 {
+		path := flags.Arg(0)
+		if err := flags.Parse(flags.Args()[1:]); err != nil {
+			return err
+		}
+		args = append([]string{path}, flags.Args()...)
+	}
+	if len(args) != 1 {
+		return fmt.Errorf("usage: munkres viz problem.csv [-o out.html]")
+	}
+	c, err := readCSV(args[0])
+	if err != nil {
+		return err
+	}
+	h, err := munkres.NewHungarianAlgorithm(c)
+	if err != nil {
+		return err
+	}
+	a, err := h.Solve()
+	if err != nil {
+		return err
+	}
+	workerDuals, jobDuals := h.Duals()
+	least, greatest := math.Inf(1), math.Inf(-1)
+	for _, row := range c {
+		for _, cost := range row {
+			least, greatest = math.Min(least, cost), math.Max(greatest, cost)
+		}
+	}
+	data := heatmap{Title: args[0], Cost: munkres.Assignment(a).Cost(c), Rows: make([][]cell, len(c))}
+	if len(c) > 0 {
+		data.WorkerDual, data.JobDual = workerDuals[:len(c)], jobDuals[:len(c[0])]
+	}
+	for w, row := range c {
+		data.Rows[w] = make([]cell, len(row))
+		for j, cost := range row {
+			t := 0.0
+			if greatest > least {
+				t = (cost - least) / (greatest - least)
+			}
+			data.Rows[w][j] = cell{
+				Cost:     cost,
+				Color:    template.CSS(fmt.Sprintf("background-color: hsl(%.0f, 80%%, 60%%)", 120*(1-t))),
+				Selected: a[w] == j,
+				Reduced:  cost - workerDuals[w] - jobDuals[j],
+			}
+		}
+	}
+	if *out == "" {
+		return vizTemplate.Execute(stdout, data)
+	}
+	f, err := os.Create(*out)
+	if err != nil {
+		return err
+	}
+	if err := vizTemplate.Execute(f, data); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
@@ -0,0 +1,37 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestViz(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "costs.csv")
+	if err := os.WriteFile(path, []byte("4,1,3\n2,0,5\n3,2,2\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	html := filepath.Join(dir, "out.html")
+	if err := run([]string{"viz", path, "-o", html}, nil, &bytes.Buffer{}); err != nil {
+		t.Fatal(err)
+	}
+	b, err := os.ReadFile(html)
+	if err != nil {
+		t.Fatal(err)
+	}
+	page := string(b)
+	if strings.Count(page, `class="selected"`) != 3 || !strings.Contains(page, "Minimum total cost 5.") ||
+		!strings.Contains(page, "worker 0, job 1: cost 1,") {
+		t.Errorf("want the heatmap of an assignment costing 5 got\n%s", page)
+	}
+	var out bytes.Buffer
+	if err := run([]string{"viz", path}, nil, &out); err != nil || out.String() != page {
+		t.Errorf("want the same page on stdout got %v", err)
+	}
+	if err := run([]string{"viz"}, nil, &out); err == nil {
+		t.Errorf("want an error without a path")
+	}
+}
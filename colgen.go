@@ -0,0 +1,69 @@
+package munkres
+
+// The amount by which a reduced cost must be negative for a generated job
+// to be considered improving, to allow for rounding in the prices.
+const pricingTolerance = 1e-9
+
+// A Pricer generates new jobs for SolveColumnGeneration. It is given the
+// price of each worker in the optimal assignment over the jobs generated
+// so far, as returned by Duals, and returns the columns of any new jobs,
+// where column[w] holds the cost of worker w doing the job. A new job can
+// only improve the assignment if its reduced cost, the least of
+// column[w] - workerDuals[w] over all workers w, is negative. Every
+// returned column is a distinct job, so a Pricer must not return a job it
+// has already generated.
+type Pricer func(workerDuals []float64) [][]float64
+
+// SolveColumnGeneration solves an assignment problem whose set of jobs is
+// too large to enumerate, such as routes or patterns, by column
+// generation. Starting with the jobs of costMatrix, it repeatedly solves
+// the assignment problem over the jobs generated so far and passes the
+// resulting worker prices to price, adding the jobs it returns, until no
+// returned job has a negative reduced cost. The assignment is then optimal
+// over every job which price could generate.
+//
+// costMatrix must have at least as many jobs as workers, so that every
+// worker is assigned; ErrorDimensionMismatch is returned otherwise.
+//
+// return the cost matrix extended with the columns of the generated jobs,
+// in the order they were generated, and the optimal assignment over it.
+func SolveColumnGeneration(costMatrix [][]float64, price Pricer) ([][]float64, []int, error) {
+	if err := checkCostMatrix(costMatrix, false); err != nil {
+		return nil, nil, err
+	}
+	if len(costMatrix) > 0 && len(costMatrix[0]) < len(costMatrix) {
+		return nil, nil, ErrorDimensionMismatch
+	}
+	master := make([][]float64, len(costMatrix))
+	for w := range costMatrix {
+		master[w] = append([]float64(nil), costMatrix[w]...)
+	}
+	for {
+		h, err := NewHungarianAlgorithm(master)
+		if err != nil {
+			return nil, nil, err
+		}
+		res := h.Execute()
+		workerDuals, _ := h.Duals()
+		improving := false
+		for _, column := range price(workerDuals) {
+			if len(column) != len(master) {
+				return nil, nil, ErrorDimensionMismatch
+			}
+			if err := checkCostMatrix([][]float64{column}, false); err != nil {
+				return nil, nil, err
+			}
+			for w, c := range column {
+				if c-workerDuals[w] < -pricingTolerance {
+					improving = true
+				}
+			}
+			for w, c := range column {
+				master[w] = append(master[w], c)
+			}
+		}
+		if !improving {
+			return master, res, nil
+		}
+	}
+}
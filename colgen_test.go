@@ -0,0 +1,64 @@
+package munkres_test
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/charles-haynes/munkres"
+)
+
+func TestSolveColumnGeneration(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 50; i++ {
+		rows, hidden := 1+rng.Intn(5), rng.Intn(8)
+		initial := randomMatrix(rng, rows, rows)
+		extra := randomMatrix(rng, hidden, rows)
+		// The pricer generates the hidden job of least reduced cost
+		// which has not already been generated.
+		generated := map[int]bool{}
+		price := func(workerDuals []float64) [][]float64 {
+			best, k := 0.0, -1
+			for i, col := range extra {
+				for w, c := range col {
+					if !generated[i] && c-workerDuals[w] < best {
+						best, k = c-workerDuals[w], i
+					}
+				}
+			}
+			if k == -1 {
+				return nil
+			}
+			generated[k] = true
+			return [][]float64{extra[k]}
+		}
+		master, res, err := munkres.SolveColumnGeneration(initial, price)
+		if err != nil {
+			t.Fatal(err)
+		}
+		cost, err := munkres.ComputeCost(master, res)
+		if err != nil {
+			t.Fatal(err)
+		}
+		full := make([][]float64, rows)
+		for w := range full {
+			full[w] = append([]float64(nil), initial[w]...)
+			for _, col := range extra {
+				full[w] = append(full[w], col[w])
+			}
+		}
+		h, _ := munkres.NewHungarianAlgorithm(full)
+		want, _ := munkres.ComputeCost(full, h.Execute())
+		if math.Abs(cost-want) > 1e-9 {
+			t.Errorf("%v %v: want cost = %f got %f", initial, extra, want, cost)
+		}
+	}
+}
+
+func TestSolveColumnGenerationTooFewJobs(t *testing.T) {
+	_, _, err := munkres.SolveColumnGeneration([][]float64{{1}, {2}},
+		func([]float64) [][]float64 { return nil })
+	if err != munkres.ErrorDimensionMismatch {
+		t.Errorf("want err = %s got %s", munkres.ErrorDimensionMismatch, err)
+	}
+}
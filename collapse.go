@@ -0,0 +1,136 @@
+package munkres
+
+import (
+	"encoding/binary"
+	"hash/fnv"
+	"math"
+)
+
+// Duplicates finds the identical rows, such as interchangeable workers,
+// and the identical columns of a cost matrix. costMatrix is subject to
+// the same restrictions as for NewHungarianAlgorithm.
+//
+// return the class of each row and of each column, where rows or columns
+// of the same class are identical, numbered from 0 in order of their
+// first rows or columns.
+func Duplicates(costMatrix [][]float64) (rowClass, colClass []int, err error) {
+	if err := checkCostMatrix(costMatrix, false); err != nil {
+		return nil, nil, err
+	}
+	rows, cols := len(costMatrix), 0
+	if rows > 0 {
+		cols = len(costMatrix[0])
+	}
+	rowClass = classify(rows, cols, func(w, j int) float64 { return costMatrix[w][j] })
+	colClass = classify(cols, rows, func(j, w int) float64 { return costMatrix[w][j] })
+	return rowClass, colClass, nil
+}
+
+// return the class of each of n vectors of length m, where at(i, k) is
+// entry k of vector i, such that vectors of the same class are identical.
+func classify(n, m int, at func(i, k int) float64) []int {
+	class := make([]int, n)
+	// The first vector of each class, by the hash of its entries.
+	firsts := map[uint64][]int{}
+	classes := 0
+	var b [8]byte
+	for i := range class {
+		f := fnv.New64a()
+		for k := 0; k < m; k++ {
+			binary.LittleEndian.PutUint64(b[:], math.Float64bits(at(i, k)))
+			f.Write(b[:])
+		}
+		h := f.Sum64()
+		class[i] = -1
+		for _, first := range firsts[h] {
+			if identical(m, func(k int) bool { return at(i, k) == at(first, k) }) {
+				class[i] = class[first]
+				break
+			}
+		}
+		if class[i] == -1 {
+			class[i] = classes
+			classes++
+			firsts[h] = append(firsts[h], i)
+		}
+	}
+	return class
+}
+
+// return whether equal(k) is true for every k in [0, m).
+func identical(m int, equal func(k int) bool) bool {
+	for k := 0; k < m; k++ {
+		if !equal(k) {
+			return false
+		}
+	}
+	return true
+}
+
+// SolveCollapsed solves the assignment problem for a cost matrix with
+// many identical rows or columns, such as interchangeable workers, by
+// collapsing each class of them found by Duplicates into one row or
+// column with a multiplicity. The collapsed problem is solved as a
+// minimum cost flow, in which as many units flow through each row or
+// column as its multiplicity, and the flow then shared out among the
+// rows and columns of each class in order. When there are few classes
+// this is far faster than solving the full problem.
+//
+// costMatrix is subject to the same restrictions as for
+// NewHungarianAlgorithm.
+//
+// return the assignment as for Execute.
+func SolveCollapsed(costMatrix [][]float64) ([]int, error) {
+	rowClass, colClass, err := Duplicates(costMatrix)
+	if err != nil {
+		return nil, err
+	}
+	rows, cols := len(rowClass), len(colClass)
+	members := func(class []int) [][]int {
+		var m [][]int
+		for i, c := range class {
+			if c == len(m) {
+				m = append(m, nil)
+			}
+			m[c] = append(m[c], i)
+		}
+		return m
+	}
+	rowMembers, colMembers := members(rowClass), members(colClass)
+	// Nodes are the source, the row classes, the column classes and the
+	// sink, in that order.
+	r, c := len(rowMembers), len(colMembers)
+	source, sink := 0, r+c+1
+	g := newFlowNetwork(r + c + 2)
+	for a, m := range rowMembers {
+		g.addEdge(source, 1+a, float64(len(m)), 0)
+	}
+	edges := make([][]int, r)
+	for a, m := range rowMembers {
+		edges[a] = make([]int, c)
+		for b, n := range colMembers {
+			edges[a][b] = g.addEdge(1+a, 1+r+b, float64(len(n)), costMatrix[m[0]][n[0]])
+		}
+	}
+	for b, n := range colMembers {
+		g.addEdge(1+r+b, sink, float64(len(n)), 0)
+	}
+	assigned := rows
+	if cols < assigned {
+		assigned = cols
+	}
+	g.minCostFlow(source, sink, float64(assigned))
+	result := unassigned(rows)
+	// The next row and column of each class to be assigned.
+	nextRow, nextCol := make([]int, r), make([]int, c)
+	for a := range edges {
+		for b, e := range edges[a] {
+			for units := int(g.flow(e)); units > 0; units-- {
+				result[rowMembers[a][nextRow[a]]] = colMembers[b][nextCol[b]]
+				nextRow[a]++
+				nextCol[b]++
+			}
+		}
+	}
+	return result, nil
+}
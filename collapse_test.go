@@ -0,0 +1,67 @@
+package munkres_test
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/charles-haynes/munkres"
+)
+
+func TestDuplicates(t *testing.T) {
+	c := [][]float64{
+		{1, 2, 1},
+		{3, 4, 3},
+		{1, 2, 1},
+		{1, 2, 1},
+	}
+	rowClass, colClass, err := munkres.Duplicates(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []int{0, 1, 0, 0}; !reflect.DeepEqual(rowClass, want) {
+		t.Errorf("want rows %v got %v", want, rowClass)
+	}
+	if want := []int{0, 1, 0}; !reflect.DeepEqual(colClass, want) {
+		t.Errorf("want columns %v got %v", want, colClass)
+	}
+	if _, _, err := munkres.Duplicates([][]float64{{math.NaN()}}); !errors.Is(err, munkres.ErrorNaNCost) {
+		t.Errorf("want %v got %v", munkres.ErrorNaNCost, err)
+	}
+}
+
+func TestSolveCollapsed(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 100; i++ {
+		// A matrix with a few types of worker and of job.
+		types := randomMatrix(rng, 1+rng.Intn(3), 1+rng.Intn(3))
+		c := make([][]float64, 1+rng.Intn(10))
+		cols := 1 + rng.Intn(10)
+		jobType := make([]int, cols)
+		for j := range jobType {
+			jobType[j] = rng.Intn(len(types[0]))
+		}
+		for w := range c {
+			kind := types[rng.Intn(len(types))]
+			c[w] = make([]float64, cols)
+			for j := range c[w] {
+				c[w][j] = kind[jobType[j]]
+			}
+		}
+		h, _ := munkres.NewHungarianAlgorithm(c)
+		optimal := h.Execute()
+		want, _ := munkres.ComputeCost(c, optimal)
+		a, err := munkres.SolveCollapsed(c)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, err := munkres.ComputeCost(c, a); err != nil || math.Abs(got-want) > 1e-9 {
+			t.Errorf("%v: want cost %f got %f (%v)", c, want, got, err)
+		}
+		if count(a) != count(optimal) {
+			t.Errorf("%v: want %d assigned got %v", c, count(optimal), a)
+		}
+	}
+}
@@ -0,0 +1,75 @@
+/*
+Package munkres mirrors the API of github.com/clyphub/munkres, solving
+with package github.com/charles-haynes/munkres, so that a project using
+it can switch by changing only its import path. Its square matrices of
+int64 costs are solved exactly in integer arithmetic.
+
+Unlike the original, the Row and Col of a RowCol are exported, so that
+callers can read the assignment without reflection.
+*/
+package munkres
+
+import (
+	"fmt"
+
+	"github.com/charles-haynes/munkres"
+)
+
+// A Matrix is a square matrix of costs, held row by row in A.
+type Matrix struct {
+	n int
+	A []int64
+}
+
+// NewMatrix creates an n by n matrix of zero costs.
+func NewMatrix(n int) *Matrix {
+	return &Matrix{n: n, A: make([]int64, n*n)}
+}
+
+// Print the matrix to standard output, a row per line.
+func (m *Matrix) Print() {
+	for i := 0; i < m.n; i++ {
+		for j := 0; j < m.n; j++ {
+			fmt.Print(m.A[i*m.n+j], " ")
+		}
+		fmt.Println()
+	}
+}
+
+// A RowCol is an assigned pair of a row and a column of a Matrix.
+type RowCol struct {
+	Row, Col int
+}
+
+// ComputeMunkresMin returns the assignment of the rows to the columns of
+// least total cost, as a pair for each row in order. It panics if the
+// costs are so large that their sums could overflow.
+func ComputeMunkresMin(m *Matrix) []RowCol {
+	return compute(m, 1)
+}
+
+// ComputeMunkresMax returns the assignment of the rows to the columns of
+// greatest total cost, as ComputeMunkresMin does.
+func ComputeMunkresMax(m *Matrix) []RowCol {
+	return compute(m, -1)
+}
+
+// return the assignment minimizing the total of the costs times sign.
+func compute(m *Matrix, sign int64) []RowCol {
+	costMatrix := make([][]int64, m.n)
+	for i := range costMatrix {
+		costMatrix[i] = make([]int64, m.n)
+		for j := range costMatrix[i] {
+			costMatrix[i][j] = sign * m.A[i*m.n+j]
+		}
+	}
+	a, _, err := munkres.SolveFixedPoint(costMatrix, 0)
+	if err != nil {
+		panic(err)
+	}
+	pairs := make([]RowCol, len(a))
+	for i, j := range a {
+		pairs[i] = RowCol{i, j}
+	}
+	return pairs
+}
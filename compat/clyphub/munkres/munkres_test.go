@@ -0,0 +1,32 @@
+package munkres_test
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/charles-haynes/munkres/compat/clyphub/munkres"
+)
+
+func TestComputeMunkres(t *testing.T) {
+	m := munkres.NewMatrix(3)
+	m.A = []int64{4, 1, 3, 2, 0, 5, 3, 2, 2}
+	want := []munkres.RowCol{{0, 1}, {1, 0}, {2, 2}}
+	if got := munkres.ComputeMunkresMin(m); !reflect.DeepEqual(got, want) {
+		t.Errorf("want %v got %v", want, got)
+	}
+	want = []munkres.RowCol{{0, 0}, {1, 2}, {2, 1}}
+	if got := munkres.ComputeMunkresMax(m); !reflect.DeepEqual(got, want) {
+		t.Errorf("want %v got %v", want, got)
+	}
+	if got := munkres.ComputeMunkresMin(munkres.NewMatrix(0)); len(got) != 0 {
+		t.Errorf("want no pairs got %v", got)
+	}
+	defer func() {
+		if recover() == nil {
+			t.Errorf("want a panic for an overflowing cost")
+		}
+	}()
+	m.A[0] = math.MaxInt64
+	munkres.ComputeMunkresMin(m)
+}
@@ -0,0 +1,20 @@
+/*
+Package hungarian mirrors the API of github.com/oddg/hungarian, solving
+with package github.com/charles-haynes/munkres, so that a project using
+it can switch by changing only its import path.
+*/
+package hungarian
+
+import "github.com/charles-haynes/munkres"
+
+// Solve returns the assignment of least total cost of the rows of the
+// cost matrix to its columns, giving the column of each row, or -1 if the
+// row is unassigned because there are fewer columns than rows. It panics
+// if the matrix is irregular or holds a NaN or infinite cost.
+func Solve(costs [][]float64) []int {
+	h, err := munkres.NewHungarianAlgorithm(costs)
+	if err != nil {
+		panic(err)
+	}
+	return h.Execute()
+}
@@ -0,0 +1,22 @@
+package hungarian_test
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/charles-haynes/munkres/compat/oddg/hungarian"
+)
+
+func TestSolve(t *testing.T) {
+	costs := [][]float64{{11, 6, 12}, {12, 4, 6}, {8, 12, 11}}
+	if got, want := hungarian.Solve(costs), []int{1, 2, 0}; !reflect.DeepEqual(got, want) {
+		t.Errorf("want %v got %v", want, got)
+	}
+	defer func() {
+		if recover() == nil {
+			t.Errorf("want a panic for a NaN cost")
+		}
+	}()
+	hungarian.Solve([][]float64{{math.NaN()}})
+}
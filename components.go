@@ -0,0 +1,105 @@
+package munkres
+
+import "fmt"
+
+// A ComponentError reports the connected components of a sparse problem
+// in which no assignment of as many workers as the component has workers
+// or jobs, whichever is fewer, uses only its edges.
+type ComponentError struct {
+	// Components are the infeasible components, in increasing order of
+	// their first rows.
+	Components []Block
+}
+
+func (e *ComponentError) Error() string {
+	c := e.Components[0]
+	if len(e.Components) == 1 {
+		return fmt.Sprintf("%v: component of rows %v and columns %v", ErrorInfeasible, c.Rows, c.Cols)
+	}
+	return fmt.Sprintf("%v: %d components, the first of rows %v and columns %v",
+		ErrorInfeasible, len(e.Components), c.Rows, c.Cols)
+}
+
+// Unwrap returns ErrorInfeasible.
+func (e *ComponentError) Unwrap() error {
+	return ErrorInfeasible
+}
+
+// SolveComponents solves the assignment problem for rows workers and cols
+// jobs with the edges produced by edges, as for NewFromEdges, by finding
+// the connected components of the graph of the edges and solving each
+// independently as a minimum cost flow, in time and memory proportional
+// to the number of edges rather than to the size of the cost matrix. Each
+// component assigns as many workers as it has workers or jobs, whichever
+// is fewer, at minimum cost; workers and jobs with no edges are left
+// unassigned.
+//
+// Each edge is validated as for NewFromEdges.
+//
+// return the assignment as for Execute. If some components admit no
+// assignment, the assignment of the others is returned with a
+// *ComponentError listing them.
+func SolveComponents(rows, cols int, edges EdgeFunc) ([]int, error) {
+	if rows < 0 || cols < 0 {
+		return nil, ErrorDimensionMismatch
+	}
+	var all []sparseEdge
+	seen := map[[2]int]bool{}
+	err := edges(func(w, j int, cost float64) error {
+		if w < 0 || w >= rows || j < 0 || j >= cols {
+			return ErrorDimensionMismatch
+		}
+		if err := checkCost(w, j, cost, false); err != nil {
+			return err
+		}
+		if seen[[2]int{w, j}] {
+			return ErrorDuplicateEdge
+		}
+		seen[[2]int{w, j}] = true
+		all = append(all, sparseEdge{w, j, cost})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	blocks := components(rows, cols, func(link func(w, j int)) {
+		for _, e := range all {
+			link(e.w, e.j)
+		}
+	})
+	// Number the workers and jobs within their components, and share the
+	// edges out among them.
+	component := make([]int, rows)
+	index := make([]int, rows+cols)
+	for b, block := range blocks {
+		for i, w := range block.Rows {
+			component[w], index[w] = b, i
+		}
+		for k, j := range block.Cols {
+			index[rows+j] = k
+		}
+	}
+	local := make([][]sparseEdge, len(blocks))
+	for _, e := range all {
+		b := component[e.w]
+		local[b] = append(local[b], sparseEdge{index[e.w], index[rows+e.j], e.cost})
+	}
+	result := unassigned(rows)
+	var infeasible []Block
+	for b, block := range blocks {
+		a, _, _, err := solveSparse(len(block.Rows), len(block.Cols), local[b])
+		if err != nil {
+			infeasible = append(infeasible, block)
+			continue
+		}
+		for i, k := range a {
+			if k != -1 {
+				result[block.Rows[i]] = block.Cols[k]
+			}
+		}
+	}
+	if infeasible != nil {
+		return result, &ComponentError{Components: infeasible}
+	}
+	return result, nil
+}
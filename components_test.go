@@ -0,0 +1,95 @@
+package munkres_test
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/charles-haynes/munkres"
+)
+
+// return an EdgeFunc producing the finite entries of c.
+func finiteEdges(c [][]float64) munkres.EdgeFunc {
+	return func(add func(w, j int, cost float64) error) error {
+		for w := range c {
+			for j, cost := range c[w] {
+				if math.IsInf(cost, 1) {
+					continue
+				}
+				if err := add(w, j, cost); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}
+}
+
+func TestSolveComponents(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 200; i++ {
+		c := randomMatrix(rng, 1+rng.Intn(6), 1+rng.Intn(6))
+		for w := range c {
+			for j := range c[w] {
+				if rng.Intn(3) > 0 {
+					c[w][j] = math.Inf(1)
+				}
+			}
+		}
+		want, ok := bruteForceCost(c, func(w, j int) bool { return !math.IsInf(c[w][j], 1) })
+		if !ok {
+			continue
+		}
+		a, err := munkres.SolveComponents(len(c), len(c[0]), finiteEdges(c))
+		if err != nil {
+			t.Fatalf("%v: %v", c, err)
+		}
+		if got, err := munkres.ComputeCost(c, a); err != nil || math.Abs(got-want) > 1e-9 {
+			t.Errorf("%v: want cost %f got %f (%v)", c, want, got, err)
+		}
+	}
+}
+
+func TestSolveComponentsInfeasible(t *testing.T) {
+	inf := math.Inf(1)
+	// Rows 0 and 1 compete for column 0 alone, while row 3 has column 3
+	// to itself.
+	c := [][]float64{
+		{1, inf, inf, inf},
+		{2, inf, inf, inf},
+		{3, 1, 2, inf},
+		{inf, inf, inf, 4},
+	}
+	a, err := munkres.SolveComponents(4, 4, finiteEdges(c))
+	var componentError *munkres.ComponentError
+	if !errors.As(err, &componentError) || !errors.Is(err, munkres.ErrorInfeasible) {
+		t.Fatalf("want a *ComponentError got %v", err)
+	}
+	want := []munkres.Block{{Rows: []int{0, 1, 2}, Cols: []int{0, 1, 2}}}
+	if !reflect.DeepEqual(componentError.Components, want) {
+		t.Errorf("want %v got %v", want, componentError.Components)
+	}
+	if want := []int{-1, -1, -1, 3}; !reflect.DeepEqual(a, want) {
+		t.Errorf("want %v got %v", want, a)
+	}
+}
+
+func TestSolveComponentsInvalid(t *testing.T) {
+	for _, test := range []struct {
+		edges munkres.EdgeFunc
+		err   error
+	}{
+		{finiteEdges([][]float64{{1, 2}, {3, 4}, {5, 6}}), munkres.ErrorDimensionMismatch},
+		{finiteEdges([][]float64{{math.NaN()}}), munkres.ErrorNaNCost},
+		{func(add func(w, j int, cost float64) error) error {
+			add(0, 0, 1)
+			return add(0, 0, 2)
+		}, munkres.ErrorDuplicateEdge},
+	} {
+		if _, err := munkres.SolveComponents(2, 2, test.edges); !errors.Is(err, test.err) {
+			t.Errorf("want %v got %v", test.err, err)
+		}
+	}
+}
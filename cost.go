@@ -0,0 +1,143 @@
+package munkres
+
+import "math"
+
+// A Cost is a value of a domain type which SolveCosts can use as a cost
+// directly, such as a quantity with units or with saturating arithmetic.
+// The methods are only ever called with arguments of the same type as the
+// receiver, and must form an ordered group: Add and Sub must be inverses,
+// Zero must be the identity of Add, and Less must be a total order
+// preserved by Add.
+type Cost interface {
+	Add(other Cost) Cost
+	Sub(other Cost) Cost
+	Less(other Cost) bool
+	Zero() Cost
+}
+
+// SolveCosts solves the assignment problem for a cost matrix of a domain
+// type, by the shortest augmenting path form of the Hungarian algorithm
+// with every sum computed by the type's own methods. Each step calls an
+// interface method, so it is slower than Execute; the float64,
+// fixed-point and duration solvers remain the fast paths.
+//
+// The cost matrix must be rectangular, and its costs must not be nil, or
+// a *CostError wrapping ErrorNilCost is returned.
+//
+// return the assignment, which assigns as many workers as Execute would,
+// and its total cost, which is nil if the matrix has no costs.
+func SolveCosts(costMatrix [][]Cost) (Assignment, Cost, error) {
+	rows, cols := len(costMatrix), 0
+	if rows > 0 {
+		cols = len(costMatrix[0])
+	}
+	for w, row := range costMatrix {
+		if len(row) != cols {
+			return nil, nil, &ShapeError{Row: w, Cols: len(row), Want: cols}
+		}
+		for j, c := range row {
+			if c == nil {
+				return nil, nil, &CostError{w, j, math.NaN(), ErrorNilCost}
+			}
+		}
+	}
+	a := Assignment(unassigned(rows))
+	if rows == 0 || cols == 0 {
+		return a, nil, nil
+	}
+	zero := costMatrix[0][0].Zero()
+	if rows <= cols {
+		jobByWorker := augmentRowsCost(rows, cols, zero,
+			func(w int) []Cost { return costMatrix[w] })
+		copy(a, jobByWorker)
+	} else {
+		column := make([]Cost, rows)
+		workerByJob := augmentRowsCost(cols, rows, zero, func(j int) []Cost {
+			for w := range column {
+				column[w] = costMatrix[w][j]
+			}
+			return column
+		})
+		for j, w := range workerByJob {
+			a[w] = j
+		}
+	}
+	total := zero
+	for w, j := range a {
+		if j != -1 {
+			total = total.Add(costMatrix[w][j])
+		}
+	}
+	return a, total, nil
+}
+
+// Match each of rows workers to one of n >= rows jobs at minimum total
+// cost, as augmentRows does, but with the costs' own arithmetic, where
+// zero is their zero. row(w) returns the n costs of worker w, and need
+// only be valid until the next call. A nil slack stands for +Inf.
+//
+// return the job of each worker.
+func augmentRowsCost(rows, n int, zero Cost, row func(w int) []Cost) []int {
+	// Workers and jobs are numbered from 1, with job 0 standing for the
+	// worker being matched in the current phase.
+	labelW := make([]Cost, rows+1)
+	labelJ := make([]Cost, n+1)
+	for w := range labelW {
+		labelW[w] = zero
+	}
+	for j := range labelJ {
+		labelJ[j] = zero
+	}
+	workerByJob := make([]int, n+1)
+	parentJob := make([]int, n+1)
+	minSlack := make([]Cost, n+1)
+	committed := make([]bool, n+1)
+	for w := 1; w <= rows; w++ {
+		workerByJob[0] = w
+		j0 := 0
+		for j := range minSlack {
+			minSlack[j] = nil
+			committed[j] = false
+		}
+		for {
+			committed[j0] = true
+			w0 := workerByJob[j0]
+			costs := row(w0 - 1)
+			var delta Cost
+			j1 := 0
+			for j := 1; j <= n; j++ {
+				if committed[j] {
+					continue
+				}
+				slack := costs[j-1].Sub(labelW[w0]).Sub(labelJ[j])
+				if minSlack[j] == nil || slack.Less(minSlack[j]) {
+					minSlack[j] = slack
+					parentJob[j] = j0
+				}
+				if delta == nil || minSlack[j].Less(delta) {
+					delta, j1 = minSlack[j], j
+				}
+			}
+			for j := 0; j <= n; j++ {
+				if committed[j] {
+					labelW[workerByJob[j]] = labelW[workerByJob[j]].Add(delta)
+					labelJ[j] = labelJ[j].Sub(delta)
+				} else {
+					minSlack[j] = minSlack[j].Sub(delta)
+				}
+			}
+			j0 = j1
+			if workerByJob[j0] == 0 {
+				break
+			}
+		}
+		augmentPath(workerByJob, parentJob, j0, 0)
+	}
+	jobByWorker := make([]int, rows)
+	for j := 1; j <= n; j++ {
+		if w := workerByJob[j]; w != 0 {
+			jobByWorker[w-1] = j - 1
+		}
+	}
+	return jobByWorker
+}
@@ -0,0 +1,62 @@
+package munkres_test
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/charles-haynes/munkres"
+)
+
+// A distance in metres.
+type metres int64
+
+func (m metres) Add(o munkres.Cost) munkres.Cost { return m + o.(metres) }
+func (m metres) Sub(o munkres.Cost) munkres.Cost { return m - o.(metres) }
+func (m metres) Less(o munkres.Cost) bool        { return m < o.(metres) }
+func (m metres) Zero() munkres.Cost              { return metres(0) }
+
+func TestSolveCosts(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 100; i++ {
+		c := randomMatrix(rng, 1+rng.Intn(8), 1+rng.Intn(8))
+		m := make([][]munkres.Cost, len(c))
+		for w := range c {
+			m[w] = make([]munkres.Cost, len(c[w]))
+			for j := range c[w] {
+				m[w][j] = metres(c[w][j])
+			}
+		}
+		a, total, err := munkres.SolveCosts(m)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want, _ := bruteForceCost(c, func(w, j int) bool { return true })
+		cost, err := munkres.ComputeCost(c, a)
+		h, _ := munkres.NewHungarianAlgorithm(c)
+		if err != nil || cost != want || total != metres(want) ||
+			count(a) != count(h.Execute()) {
+			t.Errorf("%v: want %f got %v total %v, %v", c, want, a, total, err)
+		}
+	}
+}
+
+func TestSolveCostsEmpty(t *testing.T) {
+	a, total, err := munkres.SolveCosts([][]munkres.Cost{{}, {}})
+	if err != nil || len(a) != 2 || a[0] != -1 || total != nil {
+		t.Errorf("want [-1 -1] <nil> got %v %v, %v", a, total, err)
+	}
+}
+
+func TestSolveCostsErrors(t *testing.T) {
+	var e *munkres.CostError
+	_, _, err := munkres.SolveCosts([][]munkres.Cost{{metres(1), nil}})
+	if !errors.As(err, &e) || e.Col != 1 || !math.IsNaN(e.Value) || !errors.Is(err, munkres.ErrorNilCost) {
+		t.Errorf("want nil cost at column 1 got %v", err)
+	}
+	_, _, err = munkres.SolveCosts([][]munkres.Cost{{metres(1)}, {}})
+	if !errors.Is(err, munkres.ErrorIrregularCostMatrix) {
+		t.Errorf("want %v got %v", munkres.ErrorIrregularCostMatrix, err)
+	}
+}
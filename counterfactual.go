@@ -0,0 +1,103 @@
+package munkres
+
+import "math"
+
+// BestWithout returns the minimum cost assignment in which worker w is
+// not given job j, for counterfactual analysis. It must be called after
+// Execute, and is found by repairing the optimal assignment with a single
+// phase of the algorithm, in time O(n^2), rather than by solving afresh.
+// The state of the algorithm is left unchanged.
+//
+// return the assignment as for Execute, which is the optimal one if it
+// does not give j to w; or ErrorInfeasible if there is no such
+// assignment; or ErrorDimensionMismatch if there is no worker w or job j.
+// BestWithout is not available WithWorkerGroups, for which it returns
+// ErrorInvalidOption.
+func (h *HungarianAlgorithm) BestWithout(w, j int) ([]int, error) {
+	if w < 0 || w >= h.rows || j < 0 || j >= h.cols {
+		return nil, ErrorDimensionMismatch
+	}
+	if h.opts.workerGroups != nil {
+		return nil, ErrorInvalidOption
+	}
+	if h.matchJobByWorker[w] != j {
+		return append([]int(nil), h.result()...), nil
+	}
+	var saved matchingState
+	h.saveState(&saved)
+	defer h.restoreState(&saved)
+	if _, delta := h.repairWithout(w, j); math.IsInf(delta, 1) {
+		return nil, ErrorInfeasible
+	}
+	return append([]int(nil), h.result()...), nil
+}
+
+// CostIfForced returns the minimum total cost of an assignment which
+// gives job j to worker w, such as the price of a planner's manual
+// override. It must be called after Execute, and is found with a single
+// augmentation from the optimal assignment, in time O(n^2). The state of
+// the algorithm is left unchanged.
+//
+// return +Inf if there is no such assignment, or NaN if there is no
+// worker w or job j, if Execute has not found an optimal assignment, or
+// WithWorkerGroups, for which it is not available.
+func (h *HungarianAlgorithm) CostIfForced(w, j int) float64 {
+	if w < 0 || w >= h.rows || j < 0 || j >= h.cols || h.opts.workerGroups != nil {
+		return math.NaN()
+	}
+	// Only a complete matching of the padded matrix is optimal.
+	if h.fetchUnmatchedWorker() != h.dim {
+		return math.NaN()
+	}
+	if h.matchJobByWorker[w] == j {
+		return h.assignedCost()
+	}
+	if math.IsInf(h.costs[w*h.dim+j], 1) {
+		return math.Inf(1)
+	}
+	var saved matchingState
+	h.saveState(&saved)
+	defer h.restoreState(&saved)
+	row := append([]float64(nil), h.row(w)...)
+	defer copy(h.row(w), row)
+
+	// Leave w only job j, with a label making it tight, and match them;
+	// then find the best new job for the worker which had j.
+	for k := 0; k < h.dim; k++ {
+		if k != j {
+			h.costs[w*h.dim+k] = math.Inf(1)
+		}
+	}
+	h.labelByWorker[w] = h.costs[w*h.dim+j] - h.labelByJob[j]
+	other := h.matchWorkerByJob[j]
+	h.matchWorkerByJob[h.matchJobByWorker[w]] = -1
+	h.matchJobByWorker[other] = -1
+	h.match(w, j)
+	h.initializePhase(other)
+	if !h.executePhase() {
+		return math.Inf(1)
+	}
+	return h.assignedCost()
+}
+
+// return the cost of assigning worker w to job j in the original cost
+// matrix, undoing the reductions and scaling applied while solving.
+func (h *HungarianAlgorithm) originalCost(w, j int) float64 {
+	c := h.costs[w*h.dim+j] + h.reductionByWorker[w] + h.reductionByJob[j]
+	if h.scaleByWorker != nil {
+		c += h.scaleByWorker[w] + h.scaleByJob[j]
+	}
+	return c
+}
+
+// return the total original cost of the current matching of the
+// original workers to the original jobs.
+func (h *HungarianAlgorithm) assignedCost() float64 {
+	total := 0.0
+	for w := 0; w < h.rows; w++ {
+		if j := h.matchJobByWorker[w]; j != -1 && j < h.cols {
+			total += h.originalCost(w, j)
+		}
+	}
+	return total
+}
@@ -0,0 +1,109 @@
+package munkres_test
+
+import (
+	"math"
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/charles-haynes/munkres"
+)
+
+func TestBestWithout(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 100; i++ {
+		rows, cols := 1+rng.Intn(6), 1+rng.Intn(6)
+		c := randomMatrix(rng, rows, cols)
+		h, _ := munkres.NewHungarianAlgorithm(c)
+		h.Execute()
+		w, j := rng.Intn(rows), rng.Intn(cols)
+		got, err := h.BestWithout(w, j)
+		want, feasible := bruteForceCost(c, func(ww, jj int) bool { return ww != w || jj != j })
+		if !feasible {
+			if err != munkres.ErrorInfeasible {
+				t.Errorf("%v without (%d, %d): want err = %s got %v", c, w, j, munkres.ErrorInfeasible, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got[w] == j {
+			t.Errorf("%v without (%d, %d): got %v", c, w, j, got)
+		}
+		if cost, _ := munkres.ComputeCost(c, got); cost != want {
+			t.Errorf("%v without (%d, %d): want cost = %f got %f", c, w, j, want, cost)
+		}
+		// The optimal state is restored, so repeating gives the same.
+		if again, _ := h.BestWithout(w, j); !reflect.DeepEqual(again, got) {
+			t.Errorf("%v without (%d, %d): got %v then %v", c, w, j, got, again)
+		}
+	}
+}
+
+func TestBestWithoutInvalid(t *testing.T) {
+	h, _ := munkres.NewHungarianAlgorithm([][]float64{{1, 2}, {3, 4}})
+	h.Execute()
+	if _, err := h.BestWithout(2, 0); err != munkres.ErrorDimensionMismatch {
+		t.Errorf("want err = %s got %v", munkres.ErrorDimensionMismatch, err)
+	}
+	got, err := h.BestWithout(0, 1)
+	if want := []int{0, 1}; err != nil || !reflect.DeepEqual(got, want) {
+		t.Errorf("want %v got %v (%v)", want, got, err)
+	}
+}
+
+func TestCostIfForced(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 100; i++ {
+		rows, cols := 1+rng.Intn(6), 1+rng.Intn(6)
+		c := randomMatrix(rng, rows, cols)
+		h, _ := munkres.NewHungarianAlgorithm(c)
+		h.Execute()
+		w, j := rng.Intn(rows), rng.Intn(cols)
+		want := forcedCost(c, w, j)
+		if got := h.CostIfForced(w, j); got != want {
+			t.Errorf("%v forcing (%d, %d): want cost = %f got %f", c, w, j, want, got)
+		}
+		if again := h.CostIfForced(w, j); again != want {
+			t.Errorf("%v forcing (%d, %d) again: want cost = %f got %f", c, w, j, want, again)
+		}
+	}
+}
+
+func TestCostIfForcedInvalid(t *testing.T) {
+	h, _ := munkres.NewHungarianAlgorithm([][]float64{{1, 4}, {2, 5}, {3, 6}})
+	if got := h.CostIfForced(0, 1); !math.IsNaN(got) {
+		t.Errorf("before Execute: want NaN got %f", got)
+	}
+	h.Execute()
+	// Job 2 would be the padding of the square matrix.
+	for _, pair := range [][2]int{{-1, 0}, {3, 0}, {0, -1}, {0, 2}} {
+		if got := h.CostIfForced(pair[0], pair[1]); !math.IsNaN(got) {
+			t.Errorf("%v: want NaN got %f", pair, got)
+		}
+	}
+	if got := h.CostIfForced(0, 1); got != 6 {
+		t.Errorf("want cost = 6 got %f", got)
+	}
+}
+
+// return the least cost of an assignment giving job j to worker w, as the
+// cost of that pair plus that of the problem without them.
+func forcedCost(c [][]float64, w, j int) float64 {
+	var rest [][]float64
+	for ww := range c {
+		if ww == w {
+			continue
+		}
+		var row []float64
+		for jj := range c[ww] {
+			if jj != j {
+				row = append(row, c[ww][jj])
+			}
+		}
+		rest = append(rest, row)
+	}
+	cost, _ := bruteForceCost(rest, func(int, int) bool { return true })
+	return c[w][j] + cost
+}
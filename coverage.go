@@ -0,0 +1,148 @@
+package munkres
+
+import "fmt"
+
+// A CoverageError is returned by SolveCoverage when the coverage required
+// of some job classes cannot be met. It is a certificate of infeasibility:
+// the named classes together require more assigned jobs than can possibly
+// be assigned to them.
+type CoverageError struct {
+	// Classes are the job classes whose requirements conflict.
+	Classes []int
+	// Required is the total coverage required by the classes.
+	Required int
+	// Available is the greatest number of jobs of those classes which
+	// any assignment can assign.
+	Available int
+}
+
+func (e *CoverageError) Error() string {
+	return fmt.Sprintf("Infeasible coverage: classes %v require %d jobs but at most %d can be assigned",
+		e.Classes, e.Required, e.Available)
+}
+
+// Is reports that a CoverageError is an ErrorInfeasible.
+func (e *CoverageError) Is(target error) bool {
+	return target == ErrorInfeasible
+}
+
+// SolveCoverage finds a minimum cost assignment, of as many workers as
+// possible as in the unrestricted problem, in which at least minimum[c]
+// jobs of each class c are assigned, such as when every zone must get at
+// least two couriers. classOf[j] is the class of job j, or -1 if the job
+// belongs to no class.
+//
+// The problem is solved as a minimum cost flow, with the coverage
+// requirements as lower bounds on the flow through each class.
+//
+// return the assignment as for Execute, or a *CoverageError if the
+// requirements cannot be met.
+func SolveCoverage(costMatrix [][]float64, classOf []int, minimum []int) ([]int, error) {
+	if err := checkCostMatrix(costMatrix, false); err != nil {
+		return nil, err
+	}
+	rows, cols := len(costMatrix), 0
+	if rows > 0 {
+		cols = len(costMatrix[0])
+	}
+	if len(classOf) != cols {
+		return nil, ErrorDimensionMismatch
+	}
+	size := make([]int, len(minimum))
+	for _, c := range classOf {
+		if c >= len(minimum) {
+			return nil, ErrorDimensionMismatch
+		}
+		if c >= 0 {
+			size[c]++
+		}
+	}
+	assigned := rows
+	if cols < assigned {
+		assigned = cols
+	}
+	required := 0
+	var classes []int
+	for c, k := range minimum {
+		if k < 0 {
+			return nil, ErrorNegativeQuantity
+		}
+		if k > size[c] {
+			return nil, &CoverageError{
+				Classes: []int{c}, Required: k, Available: size[c]}
+		}
+		if k > 0 {
+			classes = append(classes, c)
+			required += k
+		}
+	}
+	if required > assigned {
+		return nil, &CoverageError{
+			Classes: classes, Required: required, Available: assigned}
+	}
+
+	// Nodes are the source, the workers, the jobs, the classes, the
+	// sink and a final sink, in that order. Substituting the lower
+	// bound on the flow from each class to the sink leaves each class
+	// with a demand for its minimum coverage, met by an edge to the
+	// final sink, and reduces the demand of the sink by the same.
+	worker := func(w int) int { return 1 + w }
+	job := func(j int) int { return 1 + rows + j }
+	class := func(c int) int { return 1 + rows + cols + c }
+	source := 0
+	sink := 1 + rows + cols + len(minimum)
+	final := sink + 1
+	g := newFlowNetwork(final + 1)
+	edges := make([][]int, rows)
+	for w := range costMatrix {
+		g.addEdge(source, worker(w), 1, 0)
+		edges[w] = make([]int, cols)
+		for j, cost := range costMatrix[w] {
+			edges[w][j] = g.addEdge(worker(w), job(j), 1, cost)
+		}
+	}
+	for j, c := range classOf {
+		if c >= 0 {
+			g.addEdge(job(j), class(c), 1, 0)
+		} else {
+			g.addEdge(job(j), sink, 1, 0)
+		}
+	}
+	for c, k := range minimum {
+		g.addEdge(class(c), final, float64(k), 0)
+		g.addEdge(class(c), sink, float64(size[c]), 0)
+	}
+	g.addEdge(sink, final, float64(assigned-required), 0)
+	if flow, _ := g.minCostFlow(source, final, float64(assigned)); flow < float64(assigned) {
+		// The classes beyond the minimum cut left by the maximum flow
+		// are those whose requirements it could not meet, short by as
+		// much as the flow.
+		cut := g.reachable(source)
+		e := &CoverageError{}
+		for c, k := range minimum {
+			if k > 0 && !cut[class(c)] {
+				e.Classes = append(e.Classes, c)
+				e.Required += k
+			}
+		}
+		if e.Classes == nil {
+			e.Classes, e.Required = classes, required
+		}
+		e.Available = e.Required - (assigned - int(flow))
+		if e.Available < 0 {
+			e.Available = 0
+		}
+		return nil, e
+	}
+
+	var result []int
+	for w := range edges {
+		result = append(result, -1)
+		for j, e := range edges[w] {
+			if g.flow(e) > 0 {
+				result[w] = j
+			}
+		}
+	}
+	return result, nil
+}
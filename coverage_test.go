@@ -0,0 +1,104 @@
+package munkres_test
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/charles-haynes/munkres"
+)
+
+// return the minimum cost of an assignment of as many workers as possible
+// meeting the coverage requirements, and false if there is none.
+func bruteForceCoverage(c [][]float64, classOf, minimum []int) (float64, bool) {
+	best, found := math.Inf(1), false
+	assigned := len(c)
+	if len(classOf) < assigned {
+		assigned = len(classOf)
+	}
+	used := map[int]bool{}
+	var visit func(w, count int, cost float64)
+	visit = func(w, count int, cost float64) {
+		if w == len(c) {
+			if count < assigned {
+				return
+			}
+			covered := make([]int, len(minimum))
+			for j := range used {
+				if used[j] && classOf[j] >= 0 {
+					covered[classOf[j]]++
+				}
+			}
+			for k := range minimum {
+				if covered[k] < minimum[k] {
+					return
+				}
+			}
+			if cost < best {
+				best = cost
+			}
+			found = true
+			return
+		}
+		visit(w+1, count, cost)
+		for j := range c[w] {
+			if !used[j] {
+				used[j] = true
+				visit(w+1, count+1, cost+c[w][j])
+				used[j] = false
+			}
+		}
+	}
+	visit(0, 0, 0.0)
+	return best, found
+}
+
+func TestSolveCoverage(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 100; i++ {
+		rows, cols := 1+rng.Intn(4), 1+rng.Intn(5)
+		c := randomMatrix(rng, rows, cols)
+		classOf := make([]int, cols)
+		for j := range classOf {
+			classOf[j] = rng.Intn(3) - 1
+		}
+		minimum := []int{rng.Intn(3), rng.Intn(2)}
+		res, err := munkres.SolveCoverage(c, classOf, minimum)
+		want, ok := bruteForceCoverage(c, classOf, minimum)
+		if !ok {
+			var ce *munkres.CoverageError
+			if !errors.As(err, &ce) || !errors.Is(err, munkres.ErrorInfeasible) {
+				t.Errorf("%v %v %v: want coverage error got %v",
+					c, classOf, minimum, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%v %v %v: %s", c, classOf, minimum, err)
+		}
+		cost, err := munkres.ComputeCost(c, res)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if math.Abs(cost-want) > 1e-9 {
+			t.Errorf("%v %v %v: want cost = %f got %f",
+				c, classOf, minimum, want, cost)
+		}
+	}
+}
+
+func TestCoverageCertificate(t *testing.T) {
+	c := [][]float64{{1, 2, 3}, {4, 5, 6}}
+	_, err := munkres.SolveCoverage(c, []int{0, 1, 1}, []int{1, 2})
+	want := &munkres.CoverageError{Classes: []int{0, 1}, Required: 3, Available: 2}
+	if !reflect.DeepEqual(err, want) {
+		t.Errorf("want err = %v got %v", want, err)
+	}
+	_, err = munkres.SolveCoverage(c, []int{0, 1, -1}, []int{0, 2})
+	want = &munkres.CoverageError{Classes: []int{1}, Required: 2, Available: 1}
+	if !reflect.DeepEqual(err, want) {
+		t.Errorf("want err = %v got %v", want, err)
+	}
+}
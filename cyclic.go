@@ -0,0 +1,70 @@
+package munkres
+
+import "math"
+
+// SolveCyclic matches two cyclic sequences of n elements, such as the
+// points of two closed contours, where costMatrix[i][j] holds the cost of
+// matching element i of the first with element j of the second, finding
+// the cyclic shift by which to align them as well as the matching. For
+// each shift s, element i may only be matched with elements within width
+// of i+s around the cycle, and the best matching within that band is
+// found as a sparse problem; the best over all shifts is returned. With a
+// width of 0 the matching is a rotation of the sequence, so each shift
+// costs only O(n); a wider band allows local stretching of the alignment
+// at a cost of time O(n^2 width log n) per shift.
+//
+// costMatrix must be square, or ErrorDimensionMismatch is returned, and is
+// otherwise subject to the same restrictions as for NewHungarianAlgorithm.
+// width must not be negative.
+//
+// return the matching, the least shift giving it, and its cost.
+func SolveCyclic(costMatrix [][]float64, width int) (Assignment, int, float64, error) {
+	if err := checkCostMatrix(costMatrix, false); err != nil {
+		return nil, 0, 0, err
+	}
+	n := len(costMatrix)
+	if n > 0 && len(costMatrix[0]) != n {
+		return nil, 0, 0, ErrorDimensionMismatch
+	}
+	if width < 0 {
+		return nil, 0, 0, ErrorInvalidOption
+	}
+	if 2*width+1 > n {
+		// The band covers the whole cycle.
+		width = n / 2
+	}
+	var best Assignment
+	bestShift, bestCost := 0, math.Inf(1)
+	edges := make([]sparseEdge, 0, n*(2*width+1))
+	for s := 0; s < n; s++ {
+		a := make([]int, n)
+		if width == 0 {
+			for i := range a {
+				a[i] = (i + s) % n
+			}
+		} else {
+			edges = edges[:0]
+			for i := 0; i < n; i++ {
+				for d := -width; d <= width; d++ {
+					j := ((i+s+d)%n + n) % n
+					if d > -width && j == ((i+s-width)%n+n)%n {
+						// The band wrapped around onto itself.
+						break
+					}
+					edges = append(edges, sparseEdge{i, j, costMatrix[i][j]})
+				}
+			}
+			var err error
+			if a, _, _, err = solveSparse(n, n, edges); err != nil {
+				return nil, 0, 0, err
+			}
+		}
+		if cost := Assignment(a).Cost(costMatrix); cost < bestCost {
+			best, bestShift, bestCost = a, s, cost
+		}
+	}
+	if n == 0 {
+		return Assignment{}, 0, 0, nil
+	}
+	return best, bestShift, bestCost, nil
+}
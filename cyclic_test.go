@@ -0,0 +1,46 @@
+package munkres_test
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/charles-haynes/munkres"
+)
+
+func TestSolveCyclic(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 100; i++ {
+		n, width := 1+rng.Intn(7), rng.Intn(4)
+		c := randomMatrix(rng, n, n)
+		want, wantShift := math.Inf(1), 0
+		for s := 0; s < n; s++ {
+			cost, _ := bruteForceCost(c, func(w, j int) bool {
+				d := ((j-w-s)%n + n) % n
+				return d <= width || n-d <= width
+			})
+			if cost < want {
+				want, wantShift = cost, s
+			}
+		}
+		a, shift, cost, err := munkres.SolveCyclic(c, width)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if math.Abs(cost-want) > 1e-9 || math.Abs(a.Cost(c)-cost) > 1e-9 {
+			t.Errorf("%v width %d: want cost %f got %f from %v", c, width, want, cost, a)
+		}
+		if shift != wantShift && width == 0 {
+			t.Errorf("%v: want shift %d got %d", c, wantShift, shift)
+		}
+	}
+}
+
+func TestSolveCyclicInvalid(t *testing.T) {
+	if _, _, _, err := munkres.SolveCyclic([][]float64{{1, 2}}, 0); err != munkres.ErrorDimensionMismatch {
+		t.Errorf("want %v got %v", munkres.ErrorDimensionMismatch, err)
+	}
+	if _, _, _, err := munkres.SolveCyclic([][]float64{{1}}, -1); err != munkres.ErrorInvalidOption {
+		t.Errorf("want %v got %v", munkres.ErrorInvalidOption, err)
+	}
+}
@@ -0,0 +1,88 @@
+package munkres
+
+import (
+	"fmt"
+	"math"
+)
+
+// The tolerance, relative to the magnitude of the terms, within which
+// WithDebugChecks accepts a reduced cost as non-negative or as zero.
+const debugTolerance = 1e-9
+
+// An InvariantError is returned when WithDebugChecks finds the state of
+// the algorithm inconsistent. errors.Is reports it as ErrorInconsistent.
+// The algorithm is left in the state which failed, so that it can be
+// examined with DumpState.
+type InvariantError struct {
+	// Phase is the number of phases completed when the check failed,
+	// 0 for the check of the initial solution.
+	Phase int
+	// Worker and Job are the position of the offending entry in the
+	// padded square matrix, or -1 if there is none.
+	Worker, Job int
+	// Reason describes the broken invariant.
+	Reason string
+}
+
+func (e *InvariantError) Error() string {
+	return fmt.Sprintf("%v after phase %d at worker %d, job %d: %s", ErrorInconsistent, e.Phase, e.Worker, e.Job, e.Reason)
+}
+
+// Unwrap returns ErrorInconsistent.
+func (e *InvariantError) Unwrap() error {
+	return ErrorInconsistent
+}
+
+// WithDebugChecks makes Execute verify, after the initial solution and
+// after each phase, that the labels are dual feasible, that the matching
+// is consistent, and that every matched pair has zero slack, stopping with
+// an *InvariantError describing the first violation found. The checks
+// take O(n^2) time per phase, so they are for diagnosing reports of wrong
+// results, not for production. They use the phases of the padded square
+// algorithm even for matrices which would otherwise match the smaller
+// side directly.
+func WithDebugChecks() Option {
+	return func(o *options) {
+		o.debugChecks = true
+	}
+}
+
+// return an *InvariantError for the first invariant of the algorithm
+// found broken after phase, or nil if there is none.
+func (h *HungarianAlgorithm) checkInvariants(phase int) error {
+	fail := func(w, j int, format string, args ...interface{}) error {
+		return &InvariantError{Phase: phase, Worker: w, Job: j, Reason: fmt.Sprintf(format, args...)}
+	}
+	for j := 0; j < h.dim; j++ {
+		if math.IsNaN(h.labelByJob[j]) {
+			return fail(-1, j, "job label is NaN")
+		}
+		if w := h.matchWorkerByJob[j]; w != -1 && h.matchJobByWorker[w] != j {
+			return fail(w, j, "job is matched to a worker matched to job %d", h.matchJobByWorker[w])
+		}
+	}
+	for w := 0; w < h.dim; w++ {
+		label := h.labelByWorker[w]
+		if math.IsNaN(label) {
+			return fail(w, -1, "worker label is NaN")
+		}
+		matched := h.matchJobByWorker[w]
+		if matched != -1 && h.matchWorkerByJob[matched] != w {
+			return fail(w, matched, "worker is matched to a job matched to worker %d", h.matchWorkerByJob[matched])
+		}
+		for j, c := range h.row(w) {
+			slack := c - label - h.labelByJob[j]
+			tolerance := debugTolerance * math.Max(1, math.Max(math.Abs(c), math.Abs(label)+math.Abs(h.labelByJob[j])))
+			if math.IsNaN(slack) {
+				return fail(w, j, "slack is NaN")
+			}
+			if slack < -tolerance {
+				return fail(w, j, "slack %v is negative", slack)
+			}
+			if j == matched && slack > tolerance {
+				return fail(w, j, "slack %v of a matched pair is not zero", slack)
+			}
+		}
+	}
+	return nil
+}
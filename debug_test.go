@@ -0,0 +1,110 @@
+package munkres_test
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/charles-haynes/munkres"
+	"github.com/charles-haynes/munkres/generate"
+)
+
+func TestWithDebugChecks(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for _, shape := range [][2]int{{6, 6}, {3, 8}, {8, 3}} {
+		c := make([][]float64, shape[0])
+		for w := range c {
+			c[w] = make([]float64, shape[1])
+			for j := range c[w] {
+				c[w][j] = float64(rng.Intn(10))
+			}
+		}
+		h, err := munkres.NewHungarianAlgorithm(c)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := h.Execute()
+		h, err = munkres.NewHungarianAlgorithm(c, munkres.WithDebugChecks())
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := h.Solve()
+		if err != nil {
+			t.Fatalf("%v: %v", shape, err)
+		}
+		if munkres.Assignment(got).Cost(c) != munkres.Assignment(want).Cost(c) {
+			t.Errorf("%v: want %v got %v", shape, want, got)
+		}
+	}
+}
+
+func TestWithDebugChecksViolation(t *testing.T) {
+	c := generate.Uniform(rand.New(rand.NewSource(1)), 20, 20, 1000)
+	h, err := munkres.NewHungarianAlgorithm(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := h.ExecuteContext(&countdownContext{context.Background(), 3}); err != context.Canceled {
+		t.Fatalf("want err = %s got %v", context.Canceled, err)
+	}
+	// The reductions and labels of c are not feasible for a matrix of
+	// lower costs, so resuming with them breaks dual feasibility.
+	lower := make([][]float64, len(c))
+	for w := range c {
+		lower[w] = make([]float64, len(c[w]))
+		for j := range c[w] {
+			lower[w][j] = c[w][j] - 1000
+		}
+	}
+	resumed, err := munkres.NewHungarianAlgorithm(lower, munkres.WithDebugChecks())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := resumed.Resume(h.ResumeToken()); err != nil {
+		t.Fatal(err)
+	}
+	_, err = resumed.Solve()
+	var invariant *munkres.InvariantError
+	if !errors.As(err, &invariant) || !strings.Contains(invariant.Reason, "negative") {
+		t.Fatalf("want an InvariantError for a negative slack got %v", err)
+	}
+	if !errors.Is(err, munkres.ErrorInconsistent) {
+		t.Errorf("want err = %s got %v", munkres.ErrorInconsistent, err)
+	}
+	if want := h.Snapshot().Phases + 1; invariant.Phase != want {
+		t.Errorf("want the check after phase %d to fail got %d", want, invariant.Phase)
+	}
+}
+
+// Solving again, whether the first solve succeeded or failed, is not an
+// inconsistency.
+func TestWithDebugChecksSolveTwice(t *testing.T) {
+	rng := rand.New(rand.NewSource(4))
+	for i := 0; i < 20; i++ {
+		c := randomMatrix(rng, 1+rng.Intn(6), 1+rng.Intn(6))
+		h, _ := munkres.NewHungarianAlgorithm(c, munkres.WithDebugChecks())
+		first, err := h.Solve()
+		if err != nil {
+			t.Fatal(err)
+		}
+		first = append([]int(nil), first...)
+		second, err := h.Solve()
+		if err != nil {
+			t.Fatalf("%v: second solve: %v", c, err)
+		}
+		if !reflect.DeepEqual(first, second) {
+			t.Fatalf("%v: first %v second %v", c, first, second)
+		}
+	}
+	inf := munkres.Forbidden
+	h, _ := munkres.NewHungarianAlgorithm([][]float64{{1, inf, 3}, {2, inf, 1}, {5, inf, 4}},
+		munkres.WithForbidden(), munkres.WithDebugChecks())
+	for i := 0; i < 2; i++ {
+		if _, err := h.Solve(); err != munkres.ErrorInfeasible {
+			t.Errorf("solve %d: want ErrorInfeasible got %v", i, err)
+		}
+	}
+}
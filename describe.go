@@ -0,0 +1,110 @@
+package munkres
+
+import (
+	"math"
+	"sort"
+)
+
+// A Description summarizes a cost matrix, as returned by Describe.
+type Description struct {
+	// The least, greatest and mean finite cost of each row and column,
+	// which are +Inf, -Inf and NaN for a row or column with none.
+	RowMin, RowMax, RowMean []float64
+	ColMin, ColMax, ColMean []float64
+	// The least and greatest finite cost in the matrix.
+	Min, Max float64
+	// Forbidden is the fraction of the entries which are +Inf.
+	Forbidden float64
+	// NearMax is the fraction of the finite entries within 1% of the
+	// range of Max, such as the placeholder costs of pairs which are
+	// never worth assigning. A large fraction suggests that
+	// sparsification, or forbidding those pairs, will pay.
+	NearMax float64
+	// Ties is the fraction of the finite entries equal to another entry
+	// of the same row, and TiedRowMinima the fraction of the rows whose
+	// least cost is shared by more than one job. Degenerate matrices,
+	// with many ties, have many optimal assignments, and slow the
+	// bidding of SolveAuction between the tied jobs.
+	Ties, TiedRowMinima float64
+}
+
+// The fraction of the range of the costs within which an entry is near
+// the greatest cost.
+const nearMaxFraction = 0.01
+
+// Describe computes summary statistics of a cost matrix, to help choose
+// between solver options, backends and sparsification from the data
+// rather than guesswork. The matrix must be rectangular, and may contain
+// +Inf entries, which are counted as forbidden but otherwise ignored.
+func Describe(costMatrix [][]float64) (Description, error) {
+	if err := checkCostMatrix(costMatrix, true); err != nil {
+		return Description{}, err
+	}
+	rows, cols := len(costMatrix), 0
+	if rows > 0 {
+		cols = len(costMatrix[0])
+	}
+	d := Description{
+		RowMin: make([]float64, rows), RowMax: make([]float64, rows), RowMean: make([]float64, rows),
+		ColMin: make([]float64, cols), ColMax: make([]float64, cols), ColMean: make([]float64, cols),
+		Min: math.Inf(1), Max: math.Inf(-1),
+	}
+	colCount := make([]int, cols)
+	for j := range d.ColMin {
+		d.ColMin[j], d.ColMax[j] = math.Inf(1), math.Inf(-1)
+	}
+	finite, forbidden, ties, tiedMinima := 0, 0, 0, 0
+	sorted := make([]float64, 0, cols)
+	for w, row := range costMatrix {
+		d.RowMin[w], d.RowMax[w] = math.Inf(1), math.Inf(-1)
+		sorted = sorted[:0]
+		for j, c := range row {
+			if math.IsInf(c, 1) {
+				forbidden++
+				continue
+			}
+			sorted = append(sorted, c)
+			d.RowMin[w] = math.Min(d.RowMin[w], c)
+			d.RowMax[w] = math.Max(d.RowMax[w], c)
+			d.RowMean[w] += c
+			d.ColMin[j] = math.Min(d.ColMin[j], c)
+			d.ColMax[j] = math.Max(d.ColMax[j], c)
+			d.ColMean[j] += c
+			colCount[j]++
+		}
+		d.RowMean[w] /= float64(len(sorted))
+		finite += len(sorted)
+		d.Min = math.Min(d.Min, d.RowMin[w])
+		d.Max = math.Max(d.Max, d.RowMax[w])
+		sort.Float64s(sorted)
+		for i, c := range sorted {
+			if i > 0 && sorted[i-1] == c || i+1 < len(sorted) && sorted[i+1] == c {
+				ties++
+			}
+		}
+		if len(sorted) > 1 && sorted[0] == sorted[1] {
+			tiedMinima++
+		}
+	}
+	for j := range d.ColMean {
+		d.ColMean[j] /= float64(colCount[j])
+	}
+	if finite > 0 {
+		threshold := d.Max - nearMaxFraction*(d.Max-d.Min)
+		nearMax := 0
+		for _, row := range costMatrix {
+			for _, c := range row {
+				if c >= threshold && !math.IsInf(c, 1) {
+					nearMax++
+				}
+			}
+		}
+		d.NearMax = float64(nearMax) / float64(finite)
+		d.Ties = float64(ties) / float64(finite)
+	}
+	if rows > 0 && cols > 0 {
+		d.Forbidden = float64(forbidden) / float64(rows*cols)
+		d.TiedRowMinima = float64(tiedMinima) / float64(rows)
+	}
+	return d, nil
+}
@@ -0,0 +1,52 @@
+package munkres_test
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/charles-haynes/munkres"
+)
+
+func TestDescribe(t *testing.T) {
+	inf := math.Inf(1)
+	c := [][]float64{
+		{1, 1, 100},
+		{2, inf, 100},
+		{3, 5, 4},
+	}
+	d, err := munkres.Describe(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := munkres.Description{
+		RowMin:  []float64{1, 2, 3},
+		RowMax:  []float64{100, 100, 5},
+		RowMean: []float64{34, 51, 4},
+		ColMin:  []float64{1, 1, 4},
+		ColMax:  []float64{3, 5, 100},
+		ColMean: []float64{2, 3, 68},
+		Min:     1, Max: 100,
+		Forbidden:     1.0 / 9,
+		NearMax:       2.0 / 8,
+		Ties:          2.0 / 8,
+		TiedRowMinima: 1.0 / 3,
+	}
+	if !reflect.DeepEqual(d, want) {
+		t.Errorf("want %+v got %+v", want, d)
+	}
+}
+
+func TestDescribeEmpty(t *testing.T) {
+	d, err := munkres.Describe(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(d.RowMin) != 0 || d.NearMax != 0 || d.Forbidden != 0 {
+		t.Errorf("want an empty description got %+v", d)
+	}
+	if _, err := munkres.Describe([][]float64{{math.NaN()}}); !errors.Is(err, munkres.ErrorNaNCost) {
+		t.Errorf("want err = %s got %v", munkres.ErrorNaNCost, err)
+	}
+}
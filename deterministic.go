@@ -0,0 +1,18 @@
+package munkres
+
+// WithDeterministic guarantees that Execute returns the same assignment
+// for the same cost matrix and options on every architecture, such as
+// amd64 and arm64, and whatever the parallelism. Solving compares, adds
+// and subtracts costs and labels, which IEEE 754 defines exactly, in an
+// order fixed by the matrix; in this mode it is also run serially, so
+// that no future parallel or vectorized form can reassociate those sums
+// and break ties differently. WithSinkhornScaling is not allowed, since
+// it uses math.Exp and math.Log, whose last bit may differ between
+// architectures, and multiplies, which the compiler may fuse into
+// multiply-adds on some architectures but not others; ErrorInvalidOption
+// is returned if it is set.
+func WithDeterministic() Option {
+	return func(o *options) {
+		o.deterministic = true
+	}
+}
@@ -0,0 +1,68 @@
+package munkres_test
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/charles-haynes/munkres"
+)
+
+// Assignments recorded on amd64, which every architecture and parallelism
+// must reproduce, including the tie-breaking of the matrices with ties.
+func TestWithDeterministicGolden(t *testing.T) {
+	for _, tc := range []struct {
+		seed       int64
+		rows, cols int
+		ties       bool
+		want       []int
+	}{
+		{1, 10, 10, false, []int{6, 3, 2, 7, 4, 5, 8, 0, 1, 9}},
+		{2, 8, 12, false, []int{10, 6, 7, 0, 3, 9, 4, 2}},
+		{3, 12, 8, false, []int{6, -1, 3, -1, 7, -1, 5, -1, 2, 0, 1, 4}},
+		{4, 10, 10, true, []int{8, 4, 9, 0, 5, 2, 6, 3, 1, 7}},
+		{5, 9, 14, true, []int{3, 5, 8, 0, 7, 12, 4, 11, 13}},
+	} {
+		rng := rand.New(rand.NewSource(tc.seed))
+		c := randomMatrix(rng, tc.rows, tc.cols)
+		if !tc.ties {
+			for w := range c {
+				for j := range c[w] {
+					c[w][j] = rng.Float64()
+				}
+			}
+		}
+		for _, goroutines := range []int{1, 2, 4, 8} {
+			h, _ := munkres.NewHungarianAlgorithm(c, munkres.WithDeterministic(),
+				munkres.WithParallelism(goroutines))
+			if got := h.Execute(); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("seed %d with %d goroutines: want %v got %v",
+					tc.seed, goroutines, tc.want, got)
+			}
+		}
+	}
+}
+
+// Every decision of the solve, not just the assignment, is independent of
+// the parallelism.
+func TestWithDeterministicReplay(t *testing.T) {
+	c := randomMatrix(rand.New(rand.NewSource(1)), 100, 100)
+	r := munkres.NewRecorder(c)
+	h, _ := munkres.NewHungarianAlgorithm(c, munkres.WithDeterministic(),
+		munkres.WithSubscriber(r))
+	h.Execute()
+	for _, goroutines := range []int{2, 8} {
+		if err := munkres.Replay(c, r.Trace(), munkres.WithDeterministic(),
+			munkres.WithParallelism(goroutines)); err != nil {
+			t.Errorf("%d goroutines: %v", goroutines, err)
+		}
+	}
+}
+
+func TestWithDeterministicSinkhorn(t *testing.T) {
+	_, err := munkres.NewHungarianAlgorithm([][]float64{{1}},
+		munkres.WithDeterministic(), munkres.WithSinkhornScaling(10, 1e-3))
+	if err != munkres.ErrorInvalidOption {
+		t.Errorf("want %v got %v", munkres.ErrorInvalidOption, err)
+	}
+}
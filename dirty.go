@@ -0,0 +1,156 @@
+package munkres
+
+import "math"
+
+// MarkDirty marks the workers and jobs whose costs have changed since the
+// problem was solved, so that Reoptimize reads their new costs. The marks
+// accumulate until Reoptimize.
+//
+// return ErrorDimensionMismatch if a worker or job is not in the cost
+// matrix.
+func (h *HungarianAlgorithm) MarkDirty(rows, cols []int) error {
+	for _, w := range rows {
+		if w < 0 || w >= h.rows {
+			return ErrorDimensionMismatch
+		}
+	}
+	for _, j := range cols {
+		if j < 0 || j >= h.cols {
+			return ErrorDimensionMismatch
+		}
+	}
+	if h.dirtyWorkers == nil {
+		h.dirtyWorkers = make([]bool, h.rows)
+		h.dirtyJobs = make([]bool, h.cols)
+	}
+	for _, w := range rows {
+		h.dirtyWorkers[w] = true
+	}
+	for _, j := range cols {
+		h.dirtyJobs[j] = true
+	}
+	return nil
+}
+
+// Reoptimize repairs the solution after the costs of the workers and jobs
+// marked by MarkDirty have changed, reading their new costs from
+// costMatrix, which must have the dimensions of the matrix solved and
+// whose other costs must be unchanged. The labels of the marked workers
+// and jobs are lowered until every cost is feasible again, the pairs
+// which are no longer tight are unassigned, and only their workers are
+// augmented again, so a change confined to a few workers and jobs takes a
+// few phases rather than a full solve. The assigned pairs of the marked
+// workers and jobs are also unassigned if they are now rejected
+// WithRejection. If the problem has not been solved, or its solve failed,
+// it is solved in full from costMatrix.
+//
+// return the repaired assignment as for Solve, or ErrorDimensionMismatch
+// if costMatrix does not fit, the errors of checking the marked costs as
+// for NewHungarianAlgorithm, or ErrorInvalidOption WithWorkerGroups,
+// WithSinkhornScaling, WithRankTransform, WithSanitize or
+// WithMonotoneCosts, which transform the costs as a whole.
+func (h *HungarianAlgorithm) Reoptimize(costMatrix [][]float64) ([]int, error) {
+	if !h.reoptimizable() {
+		return nil, ErrorInvalidOption
+	}
+	if len(costMatrix) != h.rows {
+		return nil, ErrorDimensionMismatch
+	}
+	solved := h.solved()
+	for w, row := range costMatrix {
+		if len(row) != h.cols {
+			return nil, ErrorDimensionMismatch
+		}
+		if !solved || h.dirtyWorkers != nil && h.dirtyWorkers[w] {
+			if err := checkRow(w, row, h.opts.forbidden); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		for j, c := range row {
+			if h.dirtyJobs != nil && h.dirtyJobs[j] {
+				if err := checkCost(w, j, c, h.opts.forbidden); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+	if !solved {
+		h.reload(costMatrix)
+		h.dirtyWorkers, h.dirtyJobs = nil, nil
+		return h.execute()
+	}
+	if h.dirtyWorkers == nil {
+		return h.resolve()
+	}
+	// The costs are held reduced, less the amounts recorded.
+	for w, row := range costMatrix {
+		for j, c := range row {
+			if h.dirtyWorkers[w] || h.dirtyJobs[j] {
+				h.costs[w*h.dim+j] = c - h.reductionByWorker[w] - h.reductionByJob[j]
+			}
+		}
+	}
+	return h.resolve()
+}
+
+// Restore a feasible labeling and a matching of tight pairs after the
+// costs of the dirty workers and jobs have changed, by setting the label
+// of each dirty worker, then of each dirty job, to the greatest which is
+// feasible, and unmatching the pairs of those which are no longer tight
+// or are rejected.
+func (h *HungarianAlgorithm) repair() {
+	for w, dirty := range h.dirtyWorkers {
+		if !dirty {
+			continue
+		}
+		label := math.Inf(1)
+		row := h.row(w)
+		for j, c := range row {
+			label = math.Min(label, c-h.labelByJob[j])
+		}
+		if math.IsInf(label, 1) {
+			label = 0
+		}
+		h.labelByWorker[w] = label
+	}
+	for j, dirty := range h.dirtyJobs {
+		if !dirty {
+			continue
+		}
+		label := math.Inf(1)
+		for w := 0; w < h.dim; w++ {
+			label = math.Min(label, h.costs[w*h.dim+j]-h.labelByWorker[w])
+		}
+		if math.IsInf(label, 1) {
+			label = 0
+		}
+		h.labelByJob[j] = label
+	}
+	for w, j := range h.matchJobByWorker {
+		if j == -1 || (w >= h.rows || !h.dirtyWorkers[w]) && (j >= h.cols || !h.dirtyJobs[j]) {
+			continue
+		}
+		if h.rejected(w, j) || h.costs[w*h.dim+j]-h.labelByWorker[w]-h.labelByJob[j] != 0 {
+			h.matchJobByWorker[w] = -1
+			h.matchWorkerByJob[j] = -1
+		}
+	}
+}
+
+// Reload the costs from costMatrix and forget the labels and matching, so
+// that the next solve starts afresh.
+func (h *HungarianAlgorithm) reload(costMatrix [][]float64) {
+	for i := range h.costs {
+		h.costs[i] = 0
+	}
+	for w, row := range costMatrix {
+		copy(h.row(w), row)
+	}
+	for i := 0; i < h.dim; i++ {
+		h.labelByWorker[i], h.labelByJob[i] = 0, 0
+		h.reductionByWorker[i], h.reductionByJob[i] = 0, 0
+		h.matchJobByWorker[i], h.matchWorkerByJob[i] = -1, -1
+	}
+	h.interrupted = false
+}
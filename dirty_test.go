@@ -0,0 +1,133 @@
+package munkres_test
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/charles-haynes/munkres"
+)
+
+func TestReoptimize(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for _, opts := range [][]munkres.Option{nil, {munkres.WithIntegerCosts()}, {munkres.WithScipyTieOrder()}} {
+		for i := 0; i < 50; i++ {
+			rows, cols := 1+rng.Intn(8), 1+rng.Intn(8)
+			c := randomMatrix(rng, rows, cols)
+			h, _ := munkres.NewHungarianAlgorithm(c, opts...)
+			h.Execute()
+			for round := 0; round < 3; round++ {
+				var dirtyRows, dirtyCols []int
+				for k := rng.Intn(3); k > 0; k-- {
+					w := rng.Intn(rows)
+					dirtyRows = append(dirtyRows, w)
+					for j := range c[w] {
+						c[w][j] = math.Floor(rng.Float64() * 100)
+					}
+				}
+				for k := rng.Intn(3); k > 0; k-- {
+					j := rng.Intn(cols)
+					dirtyCols = append(dirtyCols, j)
+					for w := range c {
+						c[w][j] = math.Floor(rng.Float64() * 100)
+					}
+				}
+				if err := h.MarkDirty(dirtyRows, dirtyCols); err != nil {
+					t.Fatal(err)
+				}
+				a, err := h.Reoptimize(c)
+				if err != nil {
+					t.Fatal(err)
+				}
+				want, _ := bruteForceCost(c, func(w, j int) bool { return true })
+				assigned := rows
+				if cols < rows {
+					assigned = cols
+				}
+				if got := munkres.Assignment(a).Cost(c); math.Abs(got-want) > 1e-9 || count(a) != assigned {
+					t.Fatalf("%v: want cost %f got %v costing %f", c, want, a, got)
+				}
+				if got := h.TotalOriginalCost(a); math.Abs(got-want) > 1e-9 {
+					t.Fatalf("want original cost %f got %f", want, got)
+				}
+			}
+		}
+	}
+}
+
+func TestReoptimizePhases(t *testing.T) {
+	rng := rand.New(rand.NewSource(2))
+	c := randomMatrix(rng, 60, 60)
+	h, _ := munkres.NewHungarianAlgorithm(c)
+	h.Execute()
+	before := h.Snapshot()
+	for j := range c[3] {
+		c[3][j] = rng.Float64()
+	}
+	h.MarkDirty([]int{3}, nil)
+	h.Reoptimize(c)
+	// Only the changed worker is augmented again.
+	if after := h.Snapshot(); after.Phases != before.Phases+1 || after.Matched != 60 {
+		t.Errorf("want one more phase got %+v then %+v", before, after)
+	}
+}
+
+func TestReoptimizeRejection(t *testing.T) {
+	rng := rand.New(rand.NewSource(3))
+	for i := 0; i < 50; i++ {
+		n := 1 + rng.Intn(6)
+		c := randomMatrix(rng, n, n)
+		forbidden := make([][]bool, n)
+		for w := range forbidden {
+			forbidden[w] = make([]bool, n)
+		}
+		h, _ := munkres.NewHungarianAlgorithm(c, munkres.WithRejection(func(w, j int) bool { return forbidden[w][j] }))
+		h.Execute()
+		for round := 0; round < 5; round++ {
+			w, j := rng.Intn(n), rng.Intn(n)
+			forbidden[w][j] = !forbidden[w][j]
+			if err := h.MarkDirty([]int{w}, []int{j}); err != nil {
+				t.Fatal(err)
+			}
+			a, err := h.Reoptimize(c)
+			want, ok := bruteForceCost(c, func(w, j int) bool { return !forbidden[w][j] })
+			if !ok {
+				if err != munkres.ErrorInfeasible {
+					t.Fatalf("%v %v: want ErrorInfeasible got %v", c, forbidden, err)
+				}
+				continue
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := munkres.Assignment(a).Cost(c); math.Abs(got-want) > 1e-9 || count(a) != n {
+				t.Fatalf("%v %v: want cost %f got %v costing %f", c, forbidden, want, a, got)
+			}
+		}
+	}
+}
+
+func TestReoptimizeErrors(t *testing.T) {
+	c := [][]float64{{4, 1, 3}, {2, 0, 5}, {3, 2, 2}}
+	h, _ := munkres.NewHungarianAlgorithm(c)
+	// Reoptimizing before solving solves in full.
+	if a, err := h.Reoptimize(c); err != nil || munkres.Assignment(a).Cost(c) != 5 {
+		t.Errorf("want a solution costing 5 got %v, %v", a, err)
+	}
+	if err := h.MarkDirty([]int{3}, nil); err != munkres.ErrorDimensionMismatch {
+		t.Errorf("want %v got %v", munkres.ErrorDimensionMismatch, err)
+	}
+	if _, err := h.Reoptimize(c[:2]); err != munkres.ErrorDimensionMismatch {
+		t.Errorf("want %v got %v", munkres.ErrorDimensionMismatch, err)
+	}
+	h.MarkDirty(nil, []int{1})
+	c[2][1] = math.NaN()
+	if _, err := h.Reoptimize(c); !errors.Is(err, munkres.ErrorNaNCost) {
+		t.Errorf("want %v got %v", munkres.ErrorNaNCost, err)
+	}
+	h, _ = munkres.NewHungarianAlgorithm([][]float64{{1}}, munkres.WithRankTransform())
+	if _, err := h.Reoptimize([][]float64{{1}}); err != munkres.ErrorInvalidOption {
+		t.Errorf("want %v got %v", munkres.ErrorInvalidOption, err)
+	}
+}
@@ -0,0 +1,64 @@
+package munkres
+
+// Duals returns an optimal solution to the dual of the assignment problem
+// solved by Execute: a price for each worker and each job such that
+// workerDuals[i] + jobDuals[j] never exceeds the cost of assigning worker
+// i to job j, with equality for every assigned pair, and such that the sum
+// of all the prices equals the minimum total cost.
+//
+// When there are at least as many jobs as workers the job prices are never
+// positive, and are zero for unassigned jobs; otherwise the worker prices
+// are never positive, and are zero for unassigned workers. Duals must be
+// called after Execute.
+func (h *HungarianAlgorithm) Duals() (workerDuals, jobDuals []float64) {
+	if h.dim == 0 {
+		return nil, nil
+	}
+	workerDuals = make([]float64, h.dim)
+	jobDuals = make([]float64, h.dim)
+	for w := range workerDuals {
+		workerDuals[w] = h.labelByWorker[w] + h.reductionByWorker[w]
+	}
+	for j := range jobDuals {
+		jobDuals[j] = h.labelByJob[j] + h.reductionByJob[j]
+	}
+	if h.scaleByWorker != nil {
+		for w := range workerDuals {
+			workerDuals[w] += h.scaleByWorker[w]
+		}
+		for j := range jobDuals {
+			jobDuals[j] += h.scaleByJob[j]
+		}
+	}
+	// Every padding worker can do every job at no cost, so all of the
+	// unassigned jobs share the greatest job price; shifting prices
+	// from jobs to workers by that amount makes those prices zero.
+	// Similarly for padding jobs and unassigned workers.
+	if h.rows <= h.cols {
+		shiftDuals(workerDuals, jobDuals, maxValue(jobDuals))
+	} else {
+		shiftDuals(jobDuals, workerDuals, maxValue(workerDuals))
+	}
+	return workerDuals[:h.rows], jobDuals[:h.cols]
+}
+
+// Add delta to each of to and subtract it from each of from.
+func shiftDuals(to, from []float64, delta float64) {
+	for i := range to {
+		to[i] += delta
+	}
+	for i := range from {
+		from[i] -= delta
+	}
+}
+
+// return the greatest element of a non-empty slice.
+func maxValue(values []float64) float64 {
+	m := values[0]
+	for _, v := range values[1:] {
+		if v > m {
+			m = v
+		}
+	}
+	return m
+}
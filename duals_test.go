@@ -0,0 +1,103 @@
+package munkres_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"math"
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/charles-haynes/munkres"
+)
+
+func TestDuals(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 100; i++ {
+		rows, cols := 1+rng.Intn(6), 1+rng.Intn(6)
+		c := randomMatrix(rng, rows, cols)
+		h, err := munkres.NewHungarianAlgorithm(c)
+		if err != nil {
+			t.Fatal(err)
+		}
+		res := h.Execute()
+		cost, _ := munkres.ComputeCost(c, res)
+		u, v := h.Duals()
+		if len(u) != rows || len(v) != cols {
+			t.Fatalf("%v: want %d and %d duals got %d and %d",
+				c, rows, cols, len(u), len(v))
+		}
+		total := 0.0
+		for w := range u {
+			total += u[w]
+			for j := range v {
+				if u[w]+v[j] > c[w][j]+1e-9 {
+					t.Errorf("%v: dual infeasible at (%d, %d)", c, w, j)
+				}
+			}
+			if res[w] != -1 && math.Abs(u[w]+v[res[w]]-c[w][res[w]]) > 1e-9 {
+				t.Errorf("%v: assigned pair (%d, %d) not tight", c, w, res[w])
+			}
+		}
+		for j := range v {
+			total += v[j]
+		}
+		if math.Abs(total-cost) > 1e-9 {
+			t.Errorf("%v: want dual objective %f got %f", c, cost, total)
+		}
+		prices := v
+		if rows > cols {
+			prices = u
+		}
+		for _, p := range prices {
+			if p > 1e-9 {
+				t.Errorf("%v: positive price in %v", c, prices)
+			}
+		}
+	}
+}
+
+// A second Execute of a solved instance leaves its duals and original
+// costs, and everything derived from them, as the first left them.
+func TestExecuteTwice(t *testing.T) {
+	rng := rand.New(rand.NewSource(2))
+	for i := 0; i < 50; i++ {
+		rows, cols := 1+rng.Intn(6), 1+rng.Intn(6)
+		c := randomMatrix(rng, rows, cols)
+		var opts []munkres.Option
+		if i%2 == 1 {
+			opts = append(opts, munkres.WithSinkhornScaling(3, 1e-3))
+		}
+		h, _ := munkres.NewHungarianAlgorithm(c, opts...)
+		first, err := h.ExecuteResult()
+		if err != nil {
+			t.Fatal(err)
+		}
+		u, v := h.Duals()
+		second, err := h.ExecuteResult()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(first, second) {
+			t.Fatalf("%v: first result %+v, second %+v", c, first, second)
+		}
+		if u2, v2 := h.Duals(); !reflect.DeepEqual(u, u2) || !reflect.DeepEqual(v, v2) {
+			t.Fatalf("%v: duals changed from %v, %v to %v, %v", c, u, v, u2, v2)
+		}
+		cost, _ := munkres.ComputeCost(c, second.JobByWorker)
+		if got := h.TotalOriginalCost(second.JobByWorker); math.Abs(got-cost) > 1e-9 {
+			t.Fatalf("%v: want original cost %f got %f", c, cost, got)
+		}
+		var b bytes.Buffer
+		if err := h.Certificate(&b); err != nil {
+			t.Fatal(err)
+		}
+		var cert struct{ Cost, DualObjective float64 }
+		if err := json.Unmarshal(b.Bytes(), &cert); err != nil {
+			t.Fatal(err)
+		}
+		if math.Abs(cert.Cost-cost) > 1e-9 || math.Abs(cert.DualObjective-cost) > 1e-9 {
+			t.Fatalf("%v: want cost and dual objective %f got %+v", c, cost, cert)
+		}
+	}
+}
@@ -0,0 +1,97 @@
+package munkres
+
+import (
+	"encoding/json"
+	"io"
+	"math"
+	"strconv"
+	"sync/atomic"
+)
+
+// The JSON form of the internal state written by DumpState.
+type stateDump struct {
+	Rows                       int           `json:"rows"`
+	Cols                       int           `json:"cols"`
+	Dim                        int           `json:"dim"`
+	Phases                     int64         `json:"phases"`
+	Interrupted                bool          `json:"interrupted"`
+	CostMatrix                 [][]dumpFloat `json:"costMatrix"`
+	ReductionByWorker          []dumpFloat   `json:"reductionByWorker"`
+	ReductionByJob             []dumpFloat   `json:"reductionByJob"`
+	ScaleByWorker              []dumpFloat   `json:"scaleByWorker,omitempty"`
+	ScaleByJob                 []dumpFloat   `json:"scaleByJob,omitempty"`
+	LabelByWorker              []dumpFloat   `json:"labelByWorker"`
+	LabelByJob                 []dumpFloat   `json:"labelByJob"`
+	MatchJobByWorker           []int         `json:"matchJobByWorker"`
+	MatchWorkerByJob           []int         `json:"matchWorkerByJob"`
+	MinSlackValueByJob         []dumpFloat   `json:"minSlackValueByJob"`
+	MinSlackWorkerByJob        []int         `json:"minSlackWorkerByJob"`
+	CommittedWorkers           []bool        `json:"committedWorkers"`
+	ParentWorkerByCommittedJob []int         `json:"parentWorkerByCommittedJob"`
+}
+
+// A float64 written as a JSON number, or as the string "+Inf", "-Inf" or
+// "NaN", which JSON numbers cannot represent.
+type dumpFloat float64
+
+func (f dumpFloat) MarshalJSON() ([]byte, error) {
+	x := float64(f)
+	if math.IsInf(x, 0) || math.IsNaN(x) {
+		return []byte(strconv.Quote(strconv.FormatFloat(x, 'g', -1, 64))), nil
+	}
+	return []byte(strconv.FormatFloat(x, 'g', -1, 64)), nil
+}
+
+// return xs as dumpFloats.
+func dumpFloats(xs []float64) []dumpFloat {
+	if xs == nil {
+		return nil
+	}
+	d := make([]dumpFloat, len(xs))
+	for i, x := range xs {
+		d[i] = dumpFloat(x)
+	}
+	return d
+}
+
+// DumpState writes to w, as indented JSON, the internal state of the
+// algorithm: the cost matrix of the padded square problem as reduced so
+// far, the reductions and any scaling which were subtracted from it, the
+// labels, the matching, and the slack arrays and committed sets of the
+// current or last phase. Infinite and NaN values are written as the
+// strings "+Inf", "-Inf" and "NaN".
+//
+// It may be called at any point, such as after Solve fails with an
+// *InvariantError, to attach a reproducible record of a wrong result to a
+// bug report. The original cost matrix is the reduced one plus the
+// reductions and scaling of its row and column.
+func (h *HungarianAlgorithm) DumpState(w io.Writer) error {
+	d := stateDump{
+		Rows:                       h.rows,
+		Cols:                       h.cols,
+		Dim:                        h.dim,
+		Interrupted:                h.interrupted,
+		CostMatrix:                 make([][]dumpFloat, h.dim),
+		ReductionByWorker:          dumpFloats(h.reductionByWorker),
+		ReductionByJob:             dumpFloats(h.reductionByJob),
+		ScaleByWorker:              dumpFloats(h.scaleByWorker),
+		ScaleByJob:                 dumpFloats(h.scaleByJob),
+		LabelByWorker:              dumpFloats(h.labelByWorker),
+		LabelByJob:                 dumpFloats(h.labelByJob),
+		MatchJobByWorker:           h.matchJobByWorker,
+		MatchWorkerByJob:           h.matchWorkerByJob,
+		MinSlackValueByJob:         dumpFloats(h.minSlackValueByJob),
+		MinSlackWorkerByJob:        h.minSlackWorkerByJob,
+		CommittedWorkers:           h.committedWorkers,
+		ParentWorkerByCommittedJob: h.parentWorkerByCommittedJob,
+	}
+	if h.progress != nil {
+		d.Phases = atomic.LoadInt64(&h.progress.phases)
+	}
+	for w := range d.CostMatrix {
+		d.CostMatrix[w] = dumpFloats(h.row(w))
+	}
+	e := json.NewEncoder(w)
+	e.SetIndent("", "  ")
+	return e.Encode(d)
+}
@@ -0,0 +1,63 @@
+package munkres_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/charles-haynes/munkres"
+)
+
+func TestDumpState(t *testing.T) {
+	// Worker 1 may not do job 0, so the dump holds a forbidden entry.
+	h, err := munkres.NewFromEdges(2, 2, edgeList([]edge{{0, 0, 1}, {0, 1, 3}, {1, 1, 2}}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	h.Execute()
+	var b bytes.Buffer
+	if err := h.DumpState(&b); err != nil {
+		t.Fatal(err)
+	}
+	var dump struct {
+		Rows, Cols, Dim   int
+		CostMatrix        [][]interface{}
+		ReductionByWorker []float64
+		ReductionByJob    []float64
+		LabelByWorker     []float64
+		LabelByJob        []float64
+		MatchJobByWorker  []int
+		MatchWorkerByJob  []int
+	}
+	if err := json.Unmarshal(b.Bytes(), &dump); err != nil {
+		t.Fatal(err)
+	}
+	if dump.Rows != 2 || dump.Cols != 2 || dump.Dim != 2 {
+		t.Errorf("want a 2x2 dump got %d x %d of %d", dump.Rows, dump.Cols, dump.Dim)
+	}
+	if want := []int{0, 1}; !reflect.DeepEqual(dump.MatchJobByWorker, want) || !reflect.DeepEqual(dump.MatchWorkerByJob, want) {
+		t.Errorf("want matching %v got %v and %v", want, dump.MatchJobByWorker, dump.MatchWorkerByJob)
+	}
+	if got := dump.CostMatrix[1][0]; got != "+Inf" {
+		t.Errorf("want a forbidden entry written as +Inf got %v", got)
+	}
+	// The original costs are the reduced ones plus the reductions.
+	if got := dump.CostMatrix[0][1].(float64) + dump.ReductionByWorker[0] + dump.ReductionByJob[1]; got != 3 {
+		t.Errorf("want the original cost 3 got %v", got)
+	}
+}
+
+func TestDumpStateBeforeExecute(t *testing.T) {
+	h, err := munkres.NewHungarianAlgorithm([][]float64{{1, 2}, {3, 4}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var b bytes.Buffer
+	if err := h.DumpState(&b); err != nil {
+		t.Fatal(err)
+	}
+	if !json.Valid(b.Bytes()) {
+		t.Errorf("want valid JSON got %s", b.String())
+	}
+}
@@ -0,0 +1,33 @@
+package munkres
+
+import "time"
+
+// SolveDurations solves the assignment problem for costs which are
+// durations, such as travel times, exactly in int64 arithmetic on their
+// nanoseconds, as SolveFixedPoint does, so that no duration is rounded.
+// The durations are subject to the same limit as the costs of
+// SolveFixedPoint.
+//
+// return the assignment, which assigns as many workers as Execute would,
+// the total of its durations, and the longest of them, which is zero if
+// no worker is assigned.
+func SolveDurations(costMatrix [][]time.Duration) (Assignment, time.Duration, time.Duration, error) {
+	c := make([][]int64, len(costMatrix))
+	for w, row := range costMatrix {
+		c[w] = make([]int64, len(row))
+		for j, d := range row {
+			c[w][j] = int64(d)
+		}
+	}
+	a, total, err := SolveFixedPoint(c, 0)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	longest, found := time.Duration(0), false
+	for w, j := range a {
+		if j != -1 && (!found || costMatrix[w][j] > longest) {
+			longest, found = costMatrix[w][j], true
+		}
+	}
+	return a, time.Duration(total.Units), longest, nil
+}
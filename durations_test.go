@@ -0,0 +1,56 @@
+package munkres_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/charles-haynes/munkres"
+)
+
+func TestSolveDurations(t *testing.T) {
+	c := [][]time.Duration{
+		{10 * time.Minute, 25 * time.Minute, time.Hour},
+		{15 * time.Minute, 20 * time.Minute, 30 * time.Minute},
+	}
+	a, total, longest, err := munkres.SolveDurations(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a[0] != 0 || a[1] != 1 || total != 30*time.Minute || longest != 20*time.Minute {
+		t.Errorf("want [0 1] 30m0s 20m0s got %v %v %v", a, total, longest)
+	}
+}
+
+func TestSolveDurationsExact(t *testing.T) {
+	// The durations are beyond the integers float64 holds exactly, so
+	// only exact arithmetic sees that a single nanosecond decides.
+	long := 10000 * time.Hour
+	c := [][]time.Duration{{long, long + time.Nanosecond}, {long - 2*time.Nanosecond, long}}
+	a, total, longest, _ := munkres.SolveDurations(c)
+	if a[0] != 1 || a[1] != 0 || total != 2*long-time.Nanosecond ||
+		longest != long+time.Nanosecond {
+		t.Errorf("want [1 0] %v %v got %v %v %v", 2*long-time.Nanosecond,
+			long+time.Nanosecond, a, total, longest)
+	}
+	c[1][0] = long
+	a, total, _, _ = munkres.SolveDurations(c)
+	if a[0] != 0 || a[1] != 1 || total != 2*long {
+		t.Errorf("want [0 1] %v got %v %v", 2*long, a, total)
+	}
+}
+
+func TestSolveDurationsEmpty(t *testing.T) {
+	a, total, longest, err := munkres.SolveDurations([][]time.Duration{{}, {}})
+	if err != nil || len(a) != 2 || a[0] != -1 || total != 0 || longest != 0 {
+		t.Errorf("want [-1 -1] 0s 0s got %v %v %v, %v", a, total, longest, err)
+	}
+}
+
+func TestSolveDurationsOverflow(t *testing.T) {
+	_, _, _, err := munkres.SolveDurations([][]time.Duration{{math.MaxInt64}})
+	if !errors.Is(err, munkres.ErrorCostOverflow) {
+		t.Errorf("want %v got %v", munkres.ErrorCostOverflow, err)
+	}
+}
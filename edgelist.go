@@ -0,0 +1,267 @@
+package munkres
+
+import (
+	"math"
+	"sort"
+)
+
+// An Edge is a pair of worker W and job J which may be assigned, at Cost.
+type Edge struct {
+	W, J int
+	Cost float64
+}
+
+// A SparseAssignment is an assignment problem given by the list of the
+// pairs which may be assigned, for large problems in which each worker
+// may do only a few jobs, whose dense cost matrix would not fit in
+// memory. It takes memory and, for each phase, time in proportion to the
+// edges searched rather than to the size of the cost matrix.
+type SparseAssignment struct {
+	// The problem is solved with the workers and jobs swapped if there
+	// are more workers than jobs, so that every worker is matched.
+	transposed bool
+	// The edges of worker w, in the problem as solved, are to the jobs
+	// to[start[w]:start[w+1]] at the costs cost[start[w]:start[w+1]].
+	start []int
+	to    []int
+	cost  []float64
+	// The prices of the jobs, the job of each worker and its cost, as
+	// solved.
+	labelByJob  []float64
+	jobByWorker []int
+	matchCost   []float64
+}
+
+// NewSparseAssignment creates the assignment problem of workers to jobs
+// in which only the pairs given by edges may be assigned.
+//
+// return ErrorDimensionMismatch if an edge is not of a worker and a job
+// of the problem, the errors of NewHungarianAlgorithm for a cost, or
+// ErrorDuplicateEdge if a pair is given twice.
+func NewSparseAssignment(workers, jobs int, edges []Edge) (*SparseAssignment, error) {
+	if workers < 0 || jobs < 0 {
+		return nil, ErrorDimensionMismatch
+	}
+	for _, e := range edges {
+		if e.W < 0 || e.W >= workers || e.J < 0 || e.J >= jobs {
+			return nil, ErrorDimensionMismatch
+		}
+		if err := checkCost(e.W, e.J, e.Cost, false); err != nil {
+			return nil, err
+		}
+	}
+	s := &SparseAssignment{transposed: workers > jobs}
+	rows, cols := workers, jobs
+	if s.transposed {
+		rows, cols = jobs, workers
+	}
+	s.start = make([]int, rows+1)
+	for _, e := range edges {
+		s.start[s.row(e)+1]++
+	}
+	for w := 0; w < rows; w++ {
+		s.start[w+1] += s.start[w]
+	}
+	next := append([]int{}, s.start[:rows]...)
+	s.to = make([]int, len(edges))
+	s.cost = make([]float64, len(edges))
+	for _, e := range edges {
+		w := s.row(e)
+		s.to[next[w]], s.cost[next[w]] = s.col(e), e.Cost
+		next[w]++
+	}
+	for w := 0; w < rows; w++ {
+		sort.Sort(edgesByJob{s, s.start[w], s.start[w+1]})
+		for k := s.start[w] + 1; k < s.start[w+1]; k++ {
+			if s.to[k] == s.to[k-1] {
+				return nil, ErrorDuplicateEdge
+			}
+		}
+	}
+	s.labelByJob = make([]float64, cols)
+	return s, nil
+}
+
+// return the row and column of edge e in the problem as solved.
+func (s *SparseAssignment) row(e Edge) int {
+	if s.transposed {
+		return e.J
+	}
+	return e.W
+}
+
+func (s *SparseAssignment) col(e Edge) int {
+	if s.transposed {
+		return e.W
+	}
+	return e.J
+}
+
+// Sorts the edges of a row by their jobs.
+type edgesByJob struct {
+	s      *SparseAssignment
+	lo, hi int
+}
+
+func (e edgesByJob) Len() int           { return e.hi - e.lo }
+func (e edgesByJob) Less(i, j int) bool { return e.s.to[e.lo+i] < e.s.to[e.lo+j] }
+func (e edgesByJob) Swap(i, j int) {
+	i, j = e.lo+i, e.lo+j
+	e.s.to[i], e.s.to[j] = e.s.to[j], e.s.to[i]
+	e.s.cost[i], e.s.cost[j] = e.s.cost[j], e.s.cost[i]
+}
+
+// Solve finds the minimum cost assignment of as many workers as there
+// are workers or jobs, whichever is fewer, using only the edges, by the
+// shortest augmenting path form of the Hungarian algorithm: each phase
+// searches from an unassigned worker by Dijkstra's algorithm with a heap
+// over the edges of the workers it reaches, and stops at the first
+// unassigned job, so that a phase which finds a free job near its worker
+// touches only a few edges.
+//
+// return the assignment as for Execute, or ErrorInfeasible if the edges
+// admit no such assignment.
+func (s *SparseAssignment) Solve() (Assignment, error) {
+	rows, cols := len(s.start)-1, len(s.labelByJob)
+	for j := range s.labelByJob {
+		s.labelByJob[j] = 0
+	}
+	jobByWorker := unassigned(rows)
+	workerByJob := unassigned(cols)
+	matchCost := make([]float64, rows)
+	// The cost of the edge by which each job was reached.
+	edgeCost := make([]float64, cols)
+	dist := make([]float64, cols)
+	parent := make([]int, cols)
+	scanned := make([]bool, cols)
+	for j := range dist {
+		dist[j] = math.Inf(1)
+	}
+	// The jobs reached by a phase, to be reset after it, and those of
+	// them scanned.
+	var reached, done []int
+	var queue jobQueue
+	for root := 0; root < rows; root++ {
+		queue = queue[:0]
+		relax := func(w int, base float64) {
+			for k := s.start[w]; k < s.start[w+1]; k++ {
+				j := s.to[k]
+				if scanned[j] {
+					continue
+				}
+				d := base + s.cost[k] - s.labelByJob[j]
+				if d < dist[j] {
+					if math.IsInf(dist[j], 1) {
+						reached = append(reached, j)
+					}
+					dist[j], parent[j], edgeCost[j] = d, w, s.cost[k]
+					queue.push(j, d)
+				}
+			}
+		}
+		relax(root, 0)
+		sink, last := -1, 0.0
+		done = done[:0]
+		for len(queue) > 0 {
+			j, d := queue.pop()
+			if scanned[j] || d > dist[j] {
+				continue
+			}
+			scanned[j] = true
+			done = append(done, j)
+			if workerByJob[j] == -1 {
+				sink, last = j, d
+				break
+			}
+			// The reduced cost of the matched pair is zero, so the
+			// distance to its worker is that to its job.
+			w := workerByJob[j]
+			relax(w, d-matchCost[w]+s.labelByJob[j])
+		}
+		if sink == -1 {
+			return nil, ErrorInfeasible
+		}
+		// Lower the prices of the jobs scanned by how much nearer
+		// they are than the free job, which keeps every reduced cost
+		// non-negative and makes the path found tight.
+		for _, j := range done {
+			s.labelByJob[j] -= last - dist[j]
+		}
+		for j := sink; j != -1; {
+			w := parent[j]
+			workerByJob[j], matchCost[w] = w, edgeCost[j]
+			jobByWorker[w], j = j, jobByWorker[w]
+		}
+		for _, j := range reached {
+			dist[j], scanned[j] = math.Inf(1), false
+		}
+		reached = reached[:0]
+	}
+	s.jobByWorker, s.matchCost = jobByWorker, matchCost
+	result := jobByWorker
+	if s.transposed {
+		result = workerByJob
+	}
+	return append(Assignment{}, result...), nil
+}
+
+// Duals returns prices for the workers and jobs of the assignment found by
+// Solve, such that the prices of the worker and job of an edge sum to at
+// most its cost, and to exactly its cost for an assigned pair, and the
+// price of each unassigned worker or job is zero. They prove the
+// assignment optimal among those using only the edges.
+func (s *SparseAssignment) Duals() (workerDuals, jobDuals []float64) {
+	rows := len(s.start) - 1
+	rowDuals := make([]float64, rows)
+	colDuals := append([]float64{}, s.labelByJob...)
+	for w, j := range s.jobByWorker {
+		if j != -1 {
+			rowDuals[w] = s.matchCost[w] - colDuals[j]
+		}
+	}
+	if s.transposed {
+		return colDuals, rowDuals
+	}
+	return rowDuals, colDuals
+}
+
+// A binary heap of jobs by distance, holding stale entries which are
+// skipped when popped.
+type jobQueue []nodeDistance
+
+func (q *jobQueue) push(j int, d float64) {
+	*q = append(*q, nodeDistance{node: j, dist: d})
+	h := *q
+	for i := len(h) - 1; i > 0; {
+		p := (i - 1) / 2
+		if h[p].dist <= h[i].dist {
+			break
+		}
+		h[p], h[i] = h[i], h[p]
+		i = p
+	}
+}
+
+func (q *jobQueue) pop() (int, float64) {
+	h := *q
+	top := h[0]
+	n := len(h) - 1
+	h[0] = h[n]
+	h = h[:n]
+	for i := 0; ; {
+		least := i
+		if l := 2*i + 1; l < n && h[l].dist < h[least].dist {
+			least = l
+		}
+		if r := 2*i + 2; r < n && h[r].dist < h[least].dist {
+			least = r
+		}
+		if least == i {
+			break
+		}
+		h[i], h[least] = h[least], h[i]
+		i = least
+	}
+	*q = h
+	return top.node, top.dist
+}
@@ -0,0 +1,121 @@
+package munkres_test
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/charles-haynes/munkres"
+)
+
+func TestSparseAssignment(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 300; i++ {
+		rows, cols := rng.Intn(7), rng.Intn(7)
+		c := randomMatrix(rng, rows, cols)
+		var edges []munkres.Edge
+		allowed := make([][]bool, rows)
+		for w := range c {
+			allowed[w] = make([]bool, cols)
+			for j := range c[w] {
+				if rng.Intn(3) > 0 {
+					c[w][j] -= 50
+					allowed[w][j] = true
+					edges = append(edges, munkres.Edge{W: w, J: j, Cost: c[w][j]})
+				}
+			}
+		}
+		rng.Shuffle(len(edges), func(a, b int) { edges[a], edges[b] = edges[b], edges[a] })
+		s, err := munkres.NewSparseAssignment(rows, cols, edges)
+		if err != nil {
+			t.Fatal(err)
+		}
+		a, err := s.Solve()
+		want, ok := bruteForceCost(c, func(w, j int) bool { return allowed[w][j] })
+		if !ok {
+			if err != munkres.ErrorInfeasible {
+				t.Fatalf("%v %v: want ErrorInfeasible got %v", c, allowed, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%v %v: %v", c, allowed, err)
+		}
+		assigned := rows
+		if cols < rows {
+			assigned = cols
+		}
+		if got := a.Cost(c); math.Abs(got-want) > 1e-9 || count(a) != assigned || len(a) != rows {
+			t.Fatalf("%v %v: want cost %v got %v costing %v", c, allowed, want, a, got)
+		}
+		workerDuals, jobDuals := s.Duals()
+		total := 0.0
+		for _, d := range workerDuals {
+			total += d
+		}
+		for _, d := range jobDuals {
+			total += d
+		}
+		for _, e := range edges {
+			if r := e.Cost - workerDuals[e.W] - jobDuals[e.J]; r < -1e-9 || a[e.W] == e.J && math.Abs(r) > 1e-9 {
+				t.Fatalf("%v: want duals feasible and tight got reduced cost %v for %+v", c, r, e)
+			}
+		}
+		if math.Abs(total-want) > 1e-9 {
+			t.Fatalf("%v: want duals summing to %v got %v", c, want, total)
+		}
+	}
+}
+
+func TestSparseAssignmentLarge(t *testing.T) {
+	// Each of n workers may do its own job and a few random others.
+	rng := rand.New(rand.NewSource(2))
+	const n, degree = 20000, 10
+	var edges []munkres.Edge
+	for w := 0; w < n; w++ {
+		edges = append(edges, munkres.Edge{W: w, J: w, Cost: 100})
+		for k := 1; k < degree; k++ {
+			if j := rng.Intn(n); j != w {
+				edges = append(edges, munkres.Edge{W: w, J: j, Cost: float64(rng.Intn(100))})
+			}
+		}
+	}
+	s, err := munkres.NewSparseAssignment(n, n, dedupe(edges))
+	if err != nil {
+		t.Fatal(err)
+	}
+	a, err := s.Solve()
+	if err != nil || count(a) != n {
+		t.Fatalf("want %d assigned got %d (%v)", n, count(a), err)
+	}
+}
+
+// return the edges without repeated pairs, keeping the first of each.
+func dedupe(edges []munkres.Edge) []munkres.Edge {
+	seen := map[[2]int]bool{}
+	var unique []munkres.Edge
+	for _, e := range edges {
+		if !seen[[2]int{e.W, e.J}] {
+			seen[[2]int{e.W, e.J}] = true
+			unique = append(unique, e)
+		}
+	}
+	return unique
+}
+
+func TestSparseAssignmentErrors(t *testing.T) {
+	for _, test := range []struct {
+		edges []munkres.Edge
+		want  error
+	}{
+		{[]munkres.Edge{{W: 2, J: 0, Cost: 1}}, munkres.ErrorDimensionMismatch},
+		{[]munkres.Edge{{W: 0, J: 0, Cost: 1}, {W: 0, J: 0, Cost: 2}}, munkres.ErrorDuplicateEdge},
+	} {
+		if _, err := munkres.NewSparseAssignment(2, 2, test.edges); err != test.want {
+			t.Errorf("%v: want %v got %v", test.edges, test.want, err)
+		}
+	}
+	if _, err := munkres.NewSparseAssignment(1, 1, []munkres.Edge{{W: 0, J: 0, Cost: math.NaN()}}); err == nil {
+		t.Errorf("want an error for a NaN cost")
+	}
+}
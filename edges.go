@@ -0,0 +1,69 @@
+package munkres
+
+import "math"
+
+// An EdgeFunc produces the edges of an assignment problem by calling add
+// once for each pair of a worker and a job which may be assigned, with the
+// cost of assigning them. If add returns an error, the EdgeFunc must stop
+// and return it.
+type EdgeFunc func(add func(w, j int, cost float64) error) error
+
+// NewFromEdges constructs an instance of the algorithm for rows workers
+// and cols jobs from the edges produced by edges, such as rows read from a
+// database cursor, without the caller ever holding the cost matrix. Only
+// the pairs given an edge may be assigned; if they leave no assignment of
+// as many workers as there are workers or jobs, whichever is fewer,
+// Execute returns nil.
+//
+// Each edge is validated as it is added: the worker and job must be in
+// range, the cost must be a non-infinite number and no pair may be given
+// more than once. The options apply as for NewHungarianAlgorithm, so that
+// WithMaximize makes the costs of the edges profits; the pairs without an
+// edge are forbidden either way.
+func NewFromEdges(rows, cols int, edges EdgeFunc, opts ...Option) (HungarianAlgorithm, error) {
+	if rows < 0 || cols < 0 {
+		return HungarianAlgorithm{}, ErrorDimensionMismatch
+	}
+	o := newOptions(append(opts, allowForbidden()))
+	if err := o.check(rows, cols); err != nil {
+		return HungarianAlgorithm{}, err
+	}
+	if o.sanitize != nil && !o.sanitize.valid() {
+		return HungarianAlgorithm{}, ErrorInvalidOption
+	}
+	// The pairs without an edge are forbidden, whatever the options, so
+	// each edge is negated and sanitized as it is added, rather than the
+	// whole matrix afterwards.
+	costs := make([]float64, rows*cols)
+	for i := range costs {
+		costs[i] = math.Inf(1)
+	}
+	added := make([]bool, rows*cols)
+	err := edges(func(w, j int, cost float64) error {
+		if w < 0 || w >= rows || j < 0 || j >= cols {
+			return ErrorDimensionMismatch
+		}
+		if o.maximize {
+			cost = -cost
+		}
+		if o.sanitize != nil {
+			cost = o.sanitize.replace(cost)
+		}
+		if err := checkCost(w, j, cost, o.sanitize != nil && o.sanitize.Forbid); err != nil {
+			return err
+		}
+		if added[w*cols+j] {
+			return ErrorDuplicateEdge
+		}
+		costs[w*cols+j], added[w*cols+j] = cost, true
+		return nil
+	})
+	if err != nil {
+		return HungarianAlgorithm{}, err
+	}
+	costMatrix := make([][]float64, rows)
+	for w := range costMatrix {
+		costMatrix[w] = costs[w*cols : (w+1)*cols]
+	}
+	return newHungarianAlgorithmFrom(costMatrix, o)
+}
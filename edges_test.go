@@ -0,0 +1,90 @@
+package munkres_test
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/charles-haynes/munkres"
+)
+
+type edge struct {
+	w, j int
+	cost float64
+}
+
+// return an EdgeFunc producing the given edges.
+func edgeList(edges []edge) munkres.EdgeFunc {
+	return func(add func(w, j int, cost float64) error) error {
+		for _, e := range edges {
+			if err := add(e.w, e.j, e.cost); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+func TestNewFromEdges(t *testing.T) {
+	h, err := munkres.NewFromEdges(3, 3, edgeList([]edge{
+		{0, 0, 4}, {0, 1, 1.5}, {0, 2, 4},
+		{1, 0, 4}, {1, 1, 4.5}, {1, 2, 6},
+		{2, 0, 3}, {2, 1, 2.25}, {2, 2, 3},
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res := h.Execute(); !reflect.DeepEqual(res, []int{1, 0, 2}) {
+		t.Errorf("want res = %v got %v", []int{1, 0, 2}, res)
+	}
+
+	// Missing edges are forbidden.
+	h, err = munkres.NewFromEdges(2, 3, edgeList([]edge{
+		{0, 0, 1}, {0, 2, 5}, {1, 0, 1},
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res := h.Execute(); !reflect.DeepEqual(res, []int{2, 0}) {
+		t.Errorf("want res = %v got %v", []int{2, 0}, res)
+	}
+
+	// Missing edges stay forbidden when the costs are profits.
+	h, err = munkres.NewFromEdges(2, 3, edgeList([]edge{
+		{0, 0, 1}, {0, 1, 5}, {1, 0, 4}, {1, 1, 2},
+	}), munkres.WithMaximize())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res := h.Execute(); !reflect.DeepEqual(res, []int{1, 0}) {
+		t.Errorf("want res = %v got %v", []int{1, 0}, res)
+	}
+
+	h, err = munkres.NewFromEdges(2, 2, edgeList([]edge{{0, 0, 1}, {1, 0, 1}}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res := h.Execute(); res != nil {
+		t.Errorf("want no assignment got %v", res)
+	}
+}
+
+func TestNewFromEdgesErrors(t *testing.T) {
+	for _, d := range []struct {
+		name  string
+		edges []edge
+		err   error
+	}{
+		{"worker out of range", []edge{{2, 0, 1}}, munkres.ErrorDimensionMismatch},
+		{"job out of range", []edge{{0, -1, 1}}, munkres.ErrorDimensionMismatch},
+		{"infinite cost", []edge{{0, 0, math.Inf(1)}}, munkres.ErrorInfiniteCost},
+		{"NaN cost", []edge{{0, 0, math.NaN()}}, munkres.ErrorNaNCost},
+		{"duplicate", []edge{{0, 0, 1}, {0, 0, 2}}, munkres.ErrorDuplicateEdge},
+	} {
+		_, err := munkres.NewFromEdges(2, 2, edgeList(d.edges))
+		if !errors.Is(err, d.err) {
+			t.Errorf("%s: want err = %s got %v", d.name, d.err, err)
+		}
+	}
+}
@@ -0,0 +1,30 @@
+package munkres
+
+import "math"
+
+// EMD returns the earth mover's distance between the histograms histA and
+// histB, where groundCost[i][j] is the distance between bin i of histA and
+// bin j of histB. As defined by Rubner et al, the distance is the cost of
+// the optimal transport plan from histA to histB, as by SolveTransport,
+// divided by the mass it moves, so that histograms of unequal total mass
+// may be compared.
+//
+// return the distance and the transport plan, or NaN and nil if the
+// arguments are invalid for SolveTransport. The distance between empty
+// histograms is 0.
+func EMD(histA, histB []float64, groundCost [][]float64) (float64, [][]float64) {
+	plan, cost, err := SolveTransport(groundCost, histA, histB)
+	if err != nil {
+		return math.NaN(), nil
+	}
+	moved := 0.0
+	for i := range plan {
+		for _, m := range plan[i] {
+			moved += m
+		}
+	}
+	if moved == 0 {
+		return 0, plan
+	}
+	return cost / moved, plan
+}
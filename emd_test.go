@@ -0,0 +1,51 @@
+package munkres_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/charles-haynes/munkres"
+)
+
+// the ground distance between the bins of a one dimensional histogram.
+func lineDistances(n int) [][]float64 {
+	d := make([][]float64, n)
+	for i := range d {
+		d[i] = make([]float64, n)
+		for j := range d[i] {
+			d[i][j] = math.Abs(float64(i - j))
+		}
+	}
+	return d
+}
+
+func TestEMD(t *testing.T) {
+	a := []float64{0.5, 0.5, 0, 0}
+	b := []float64{0, 0, 0.5, 0.5}
+	d, plan := munkres.EMD(a, b, lineDistances(4))
+	if math.Abs(d-2) > 1e-12 {
+		t.Errorf("want distance = 2 got %f", d)
+	}
+	if len(plan) != 4 || len(plan[0]) != 4 {
+		t.Errorf("want a 4x4 plan got %v", plan)
+	}
+	if d, _ := munkres.EMD(a, a, lineDistances(4)); d != 0 {
+		t.Errorf("want distance = 0 got %f", d)
+	}
+}
+
+func TestEMDUnequalMass(t *testing.T) {
+	// Only the lighter histogram's mass is moved, and the distance is
+	// the average over it.
+	d, _ := munkres.EMD([]float64{1, 0, 0}, []float64{0, 1, 3}, lineDistances(3))
+	if math.Abs(d-1) > 1e-12 {
+		t.Errorf("want distance = 1 got %f", d)
+	}
+}
+
+func TestEMDInvalid(t *testing.T) {
+	d, plan := munkres.EMD([]float64{1}, []float64{1, 1}, lineDistances(2))
+	if !math.IsNaN(d) || plan != nil {
+		t.Errorf("want NaN, nil got %f, %v", d, plan)
+	}
+}
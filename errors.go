@@ -0,0 +1,60 @@
+package munkres
+
+import (
+	"fmt"
+	"math"
+)
+
+// A CostError is returned for an entry of a cost matrix which is not
+// allowed, giving its position and value. errors.Is reports it as its
+// reason, such as ErrorNaNCost.
+type CostError struct {
+	// Row and Col are the position of the entry, from 0. Row is 0 for
+	// an entry of a vector, such as the supplies of SolveTransport, or
+	// the index of the vector among several, as for SolveOnLine.
+	Row, Col int
+	// Value is the entry, or NaN for an entry of SolveCosts.
+	Value float64
+	// Err is the reason: ErrorInfiniteCost, ErrorNaNCost,
+	// ErrorNegativeCost, ErrorCostOverflow or ErrorNilCost.
+	Err error
+}
+
+func (e *CostError) Error() string {
+	return fmt.Sprintf("%v at row %d, column %d: %v", e.Err, e.Row, e.Col, e.Value)
+}
+
+// Unwrap returns the reason for a CostError.
+func (e *CostError) Unwrap() error {
+	return e.Err
+}
+
+// A ShapeError is returned for a row of a cost matrix whose length
+// differs from that of the first row. errors.Is reports it as
+// ErrorIrregularCostMatrix.
+type ShapeError struct {
+	// Row is the index of the row, from 0.
+	Row int
+	// Cols is the length of the row, and Want that of the first row.
+	Cols, Want int
+}
+
+func (e *ShapeError) Error() string {
+	return fmt.Sprintf("%v: row %d has %d columns, want %d", ErrorIrregularCostMatrix, e.Row, e.Cols, e.Want)
+}
+
+// Unwrap returns ErrorIrregularCostMatrix.
+func (e *ShapeError) Unwrap() error {
+	return ErrorIrregularCostMatrix
+}
+
+// Check a cost, which is at row w and column j, as for checkRow.
+func checkCost(w, j int, c float64, forbidden bool) error {
+	if math.IsInf(c, -1) || math.IsInf(c, 1) && !forbidden {
+		return &CostError{Row: w, Col: j, Value: c, Err: ErrorInfiniteCost}
+	}
+	if math.IsNaN(c) {
+		return &CostError{Row: w, Col: j, Value: c, Err: ErrorNaNCost}
+	}
+	return nil
+}
@@ -0,0 +1,43 @@
+package munkres_test
+
+import (
+	"errors"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/charles-haynes/munkres"
+)
+
+func TestCostError(t *testing.T) {
+	_, err := munkres.NewHungarianAlgorithm([][]float64{{1, 2}, {3, math.Inf(-1)}})
+	var costError *munkres.CostError
+	if !errors.As(err, &costError) || costError.Row != 1 || costError.Col != 1 || !math.IsInf(costError.Value, -1) {
+		t.Fatalf("want a CostError at (1, 1) got %v", err)
+	}
+	if !errors.Is(err, munkres.ErrorInfiniteCost) {
+		t.Errorf("want err = %s got %v", munkres.ErrorInfiniteCost, err)
+	}
+	if want := "Infinite cost at row 1, column 1: -Inf"; err.Error() != want {
+		t.Errorf("want %q got %q", want, err.Error())
+	}
+	// A CostError from a reader is wrapped in a ParseError.
+	_, err = munkres.NewFromReader(strings.NewReader("1,2\nNaN,4\n"), munkres.FormatCSV)
+	if !errors.As(err, &costError) || costError.Row != 1 || costError.Col != 0 || !errors.Is(err, munkres.ErrorNaNCost) {
+		t.Errorf("want a NaN CostError at (1, 0) got %v", err)
+	}
+}
+
+func TestShapeError(t *testing.T) {
+	_, err := munkres.NewHungarianAlgorithm([][]float64{{1, 2}, {3, 4}, {5}})
+	var shapeError *munkres.ShapeError
+	if !errors.As(err, &shapeError) || shapeError.Row != 2 || shapeError.Cols != 1 || shapeError.Want != 2 {
+		t.Fatalf("want a ShapeError for row 2 got %v", err)
+	}
+	if !errors.Is(err, munkres.ErrorIrregularCostMatrix) {
+		t.Errorf("want err = %s got %v", munkres.ErrorIrregularCostMatrix, err)
+	}
+	if want := "Irregular cost matrix: row 2 has 1 columns, want 2"; err.Error() != want {
+		t.Errorf("want %q got %q", want, err.Error())
+	}
+}
@@ -0,0 +1,42 @@
+package munkres
+
+// A Subscriber receives events as Execute runs, such as to animate the
+// algorithm or to stream a partial matching to a user interface without
+// polling. Its methods are called on the goroutine running Execute, which
+// waits for them to return. Only the pairs of the original workers and
+// jobs are reported; matching a worker to a padding job leaves it
+// unassigned, and is reported only as the breaking of its previous match.
+type Subscriber interface {
+	// MatchMade reports that worker w has been matched to job j.
+	MatchMade(w, j int)
+	// MatchBroken reports that worker w is no longer matched to job j,
+	// either because it is about to be rematched or because a phase has
+	// given j to another worker.
+	MatchBroken(w, j int)
+	// LabelsUpdated reports that the labels of the workers in the
+	// current phase's tree have been raised by delta and those of its
+	// jobs lowered by delta, making more pairs tight.
+	LabelsUpdated(delta float64)
+}
+
+// WithSubscriber sends events to s as Execute runs. The events describe
+// every change to the matching while solving, but not the matchings
+// explored to compute backups. The faster solvers for integer costs and
+// for far from square matrices only match at the end, so they are not
+// used, and WithWorkerGroups is not supported.
+func WithSubscriber(s Subscriber) Option {
+	return func(o *options) {
+		o.subscriber = s
+	}
+}
+
+// Report the breaking of worker w's match, if any, and the making of its
+// match to job j.
+func (h *HungarianAlgorithm) publishMatch(w, j int) {
+	if old := h.matchJobByWorker[w]; old != -1 && old < h.cols && w < h.rows {
+		h.events.MatchBroken(w, old)
+	}
+	if j < h.cols && w < h.rows {
+		h.events.MatchMade(w, j)
+	}
+}
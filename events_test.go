@@ -0,0 +1,80 @@
+package munkres_test
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/charles-haynes/munkres"
+)
+
+// A Subscriber which follows the matching from the events, recording any
+// event inconsistent with it.
+type matchingView struct {
+	jobByWorker, workerByJob map[int]int
+	updates                  int
+	errors                   []string
+}
+
+func newMatchingView() *matchingView {
+	return &matchingView{jobByWorker: map[int]int{}, workerByJob: map[int]int{}}
+}
+
+func (v *matchingView) MatchMade(w, j int) {
+	if _, ok := v.jobByWorker[w]; ok {
+		v.errors = append(v.errors, fmt.Sprintf("made (%d, %d) while worker matched", w, j))
+	}
+	if _, ok := v.workerByJob[j]; ok {
+		v.errors = append(v.errors, fmt.Sprintf("made (%d, %d) while job matched", w, j))
+	}
+	v.jobByWorker[w], v.workerByJob[j] = j, w
+}
+
+func (v *matchingView) MatchBroken(w, j int) {
+	if k, ok := v.jobByWorker[w]; !ok || k != j {
+		v.errors = append(v.errors, fmt.Sprintf("broke unmatched (%d, %d)", w, j))
+	}
+	delete(v.jobByWorker, w)
+	delete(v.workerByJob, j)
+}
+
+func (v *matchingView) LabelsUpdated(delta float64) {
+	if !(delta > 0) {
+		v.errors = append(v.errors, fmt.Sprintf("labels updated by %f", delta))
+	}
+	v.updates++
+}
+
+func TestWithSubscriber(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	updates := 0
+	for i := 0; i < 50; i++ {
+		c := randomMatrix(rng, 1+rng.Intn(12), 1+rng.Intn(12))
+		v := newMatchingView()
+		h, _ := munkres.NewHungarianAlgorithm(c, munkres.WithSubscriber(v),
+			munkres.WithBackups())
+		a := h.Execute()
+		for _, e := range v.errors {
+			t.Errorf("%v: %s", c, e)
+		}
+		for w, j := range a {
+			if k, ok := v.jobByWorker[w]; j != -1 && (!ok || k != j) || j == -1 && ok {
+				t.Errorf("%v: want %v got %v", c, a, v.jobByWorker)
+				break
+			}
+		}
+		updates += v.updates
+	}
+	if updates == 0 {
+		t.Errorf("want some label updates got none")
+	}
+}
+
+func TestWithSubscriberGroups(t *testing.T) {
+	_, err := munkres.NewHungarianAlgorithm([][]float64{{1}},
+		munkres.WithSubscriber(newMatchingView()),
+		munkres.WithWorkerGroups([]int{0}))
+	if err != munkres.ErrorInvalidOption {
+		t.Errorf("want %v got %v", munkres.ErrorInvalidOption, err)
+	}
+}
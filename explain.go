@@ -0,0 +1,73 @@
+package munkres
+
+import "math"
+
+// An Explanation justifies the job given to one worker by the optimal
+// assignment, in terms of the dual prices which certify its optimality.
+type Explanation struct {
+	// Job is the worker's job, or -1 if it is unassigned.
+	Job int
+	// Cost is the cost of the worker's job, or 0 if it is unassigned.
+	Cost float64
+	// WorkerDual and JobDual are the dual prices, as given by Duals, of
+	// the worker and of its job; JobDual is 0 if it is unassigned.
+	WorkerDual, JobDual float64
+	// ReducedCosts are, for each job, its cost to the worker less the
+	// prices of the worker and the job. None is negative, and that of
+	// the worker's job is zero: at these prices no other job is a
+	// better deal for the worker.
+	ReducedCosts []float64
+	// Alternative is the worker's job in the best assignment which does
+	// not give it Job, or -1 if it is unassigned there.
+	Alternative int
+	// Delta is how much more that assignment costs in total than the
+	// optimum, or +Inf if there is no such assignment.
+	Delta float64
+}
+
+// Explain returns an explanation of why worker w received its job in the
+// optimal assignment, for justifying an automated allocation. It must be
+// called after Execute, and takes time O(n^2), or O(n^3) for an
+// unassigned worker, each of whose alternatives is priced as by
+// CostIfForced. The state of the algorithm is left unchanged.
+//
+// return ErrorDimensionMismatch if there is no worker w, or
+// ErrorInvalidOption WithWorkerGroups, for which it is not available.
+func (h *HungarianAlgorithm) Explain(w int) (Explanation, error) {
+	if w < 0 || w >= h.rows {
+		return Explanation{}, ErrorDimensionMismatch
+	}
+	if h.opts.workerGroups != nil {
+		return Explanation{}, ErrorInvalidOption
+	}
+	workerDuals, jobDuals := h.Duals()
+	e := Explanation{
+		Job:          h.matchJobByWorker[w],
+		WorkerDual:   workerDuals[w],
+		ReducedCosts: make([]float64, h.cols),
+		Alternative:  -1,
+		Delta:        math.Inf(1),
+	}
+	for j := range e.ReducedCosts {
+		e.ReducedCosts[j] = h.originalCost(w, j) - workerDuals[w] - jobDuals[j]
+	}
+	if e.Job >= h.cols {
+		// The worker is unassigned; the alternative is the job which
+		// is cheapest to force upon it.
+		e.Job = -1
+		optimum := h.assignedCost()
+		for j := 0; j < h.cols; j++ {
+			if delta := h.CostIfForced(w, j) - optimum; delta < e.Delta {
+				e.Alternative, e.Delta = j, delta
+			}
+		}
+		return e, nil
+	}
+	e.Cost = h.originalCost(w, e.Job)
+	e.JobDual = jobDuals[e.Job]
+	var saved matchingState
+	h.saveState(&saved)
+	e.Alternative, e.Delta = h.repairWithout(w, e.Job)
+	h.restoreState(&saved)
+	return e, nil
+}
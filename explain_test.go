@@ -0,0 +1,56 @@
+package munkres_test
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/charles-haynes/munkres"
+)
+
+func TestExplain(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 100; i++ {
+		rows, cols := 1+rng.Intn(6), 1+rng.Intn(6)
+		c := randomMatrix(rng, rows, cols)
+		h, _ := munkres.NewHungarianAlgorithm(c)
+		res := h.Execute()
+		optimum, _ := munkres.ComputeCost(c, res)
+		w := rng.Intn(rows)
+		e, err := h.Explain(w)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if e.Job != res[w] {
+			t.Errorf("%v worker %d: want job %d got %d", c, w, res[w], e.Job)
+		}
+		for j, r := range e.ReducedCosts {
+			if r < -1e-9 || j == e.Job && math.Abs(r) > 1e-9 {
+				t.Errorf("%v worker %d: bad reduced costs %v", c, w, e.ReducedCosts)
+			}
+		}
+		// The alternative must cost what the best assignment avoiding
+		// the worker's current status costs.
+		var want float64
+		feasible := true
+		if e.Job == -1 {
+			want = math.Inf(1)
+			for j := 0; j < cols; j++ {
+				want = math.Min(want, forcedCost(c, w, j))
+			}
+		} else {
+			want, feasible = bruteForceCost(c, func(ww, jj int) bool { return ww != w || jj != e.Job })
+		}
+		if !feasible {
+			want = math.Inf(1)
+		}
+		if got := optimum + e.Delta; got != want {
+			t.Errorf("%v worker %d: want alternative cost = %f got %f", c, w, want, got)
+		}
+	}
+	h, _ := munkres.NewHungarianAlgorithm([][]float64{{1}})
+	h.Execute()
+	if _, err := h.Explain(1); err != munkres.ErrorDimensionMismatch {
+		t.Errorf("want err = %s got %v", munkres.ErrorDimensionMismatch, err)
+	}
+}
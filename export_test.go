@@ -0,0 +1,4 @@
+package munkres
+
+// Exported for the tests of the kernels, which are in package munkres_test.
+var MinIndexMasked = minIndexMasked
@@ -0,0 +1,183 @@
+package munkres
+
+import (
+	"math"
+	"sort"
+)
+
+// A Fairness is an objective for SolveFair.
+type Fairness int
+
+const (
+	// Egalitarian minimizes the greatest cost of any worker's job.
+	Egalitarian Fairness = iota
+	// LeastEnvy minimizes the greatest regret of any worker: the amount
+	// by which the cost of its job exceeds that of its cheapest job.
+	LeastEnvy
+)
+
+// SolveFair solves the assignment problem under a fairness objective in
+// place of the total cost, for allocation settings where how the cost
+// falls on individual workers matters as much as efficiency. Among the
+// assignments which are optimal under the objective, the one of least
+// total cost is returned. The optimum is found by a binary search over
+// thresholds, checking each by solving the assignment problem with the
+// pairs beyond the threshold forbidden, so takes time O(n^3 log n).
+//
+// costMatrix is subject to the same restrictions as for
+// NewHungarianAlgorithm. An unknown objective is an ErrorInvalidOption.
+//
+// return the assignment, which assigns as many workers as Execute would,
+// and its value under the objective.
+func SolveFair(costMatrix [][]float64, objective Fairness) (Assignment, float64, error) {
+	if err := checkCostMatrix(costMatrix, false); err != nil {
+		return nil, 0, err
+	}
+	key, err := fairnessKey(costMatrix, objective)
+	if err != nil {
+		return nil, 0, err
+	}
+	return solveBottleneck(costMatrix, key, nil)
+}
+
+// SolveOptimalFair breaks the ties between the minimum total cost
+// assignments by a fairness objective, choosing among them the one which
+// is optimal under the objective, so that the solution is not only optimal
+// in sum but also individually defensible. With LeastEnvy, no worker's
+// cost exceeds that of its cheapest job by more than it must. Costs are
+// considered equal to the minimum total cost within a relative tolerance
+// of 1e-9. It takes time O(n^3 log n), as for SolveFair.
+//
+// return the assignment and its value under the objective.
+func SolveOptimalFair(costMatrix [][]float64, objective Fairness) (Assignment, float64, error) {
+	if err := checkCostMatrix(costMatrix, false); err != nil {
+		return nil, 0, err
+	}
+	key, err := fairnessKey(costMatrix, objective)
+	if err != nil {
+		return nil, 0, err
+	}
+	h, err := NewHungarianAlgorithm(costMatrix)
+	if err != nil {
+		return nil, 0, err
+	}
+	optimum := Assignment(h.Execute()).Cost(costMatrix)
+	return solveBottleneck(costMatrix, key, func(a Assignment) bool {
+		return a.Cost(costMatrix) <= optimum+optimalityTolerance*(1+math.Abs(optimum))
+	})
+}
+
+// ExecuteBottleneck solves the bottleneck assignment problem: it finds
+// the assignment whose greatest cost of any assigned pair is least, or
+// WithMaximize whose least profit is greatest, as SolveFair does for
+// Egalitarian, but of the problem as configured, avoiding the pairs
+// forbidden or rejected WithRejection. Among the bottleneck optimal
+// assignments, the one of least total cost is returned. The problem
+// itself is not solved.
+//
+// return the assignment, which assigns as many workers as Execute would,
+// and its greatest cost, or least profit, which is 0 if none are
+// assigned; ErrorInfeasible if there is no such assignment; or
+// ErrorInvalidOption with the options refused by Reoptimize, which
+// transform the costs as a whole.
+func (h *HungarianAlgorithm) ExecuteBottleneck() (Assignment, float64, error) {
+	if !h.reoptimizable() {
+		return nil, 0, ErrorInvalidOption
+	}
+	m := make([][]float64, h.rows)
+	for w := range m {
+		m[w] = make([]float64, h.cols)
+		for j := range m[w] {
+			m[w][j] = h.originalCost(w, j)
+			if h.opts.reject != nil && h.opts.reject(w, j) {
+				m[w][j] = math.Inf(1)
+			}
+		}
+	}
+	a, bottleneck, err := solveBottleneck(m, m, nil)
+	if err != nil {
+		return nil, 0, err
+	}
+	if h.opts.maximize {
+		bottleneck = -bottleneck
+	}
+	return a, bottleneck, nil
+}
+
+// The relative tolerance within which SolveOptimalFair considers a cost
+// equal to the optimum.
+const optimalityTolerance = 1e-9
+
+// return the value of each pair of a worker and a job under the objective.
+func fairnessKey(costMatrix [][]float64, objective Fairness) ([][]float64, error) {
+	key := make([][]float64, len(costMatrix))
+	for w, row := range costMatrix {
+		key[w] = make([]float64, len(row))
+		switch objective {
+		case Egalitarian:
+			copy(key[w], row)
+		case LeastEnvy:
+			cheapest := minOf(row)
+			for j, c := range row {
+				key[w][j] = c - cheapest
+			}
+		default:
+			return nil, ErrorInvalidOption
+		}
+	}
+	return key, nil
+}
+
+// Find the least threshold t such that there is an assignment, of as many
+// workers as Execute would assign, which uses only pairs (w, j) with
+// key[w][j] <= t and a finite cost, and whose cheapest such assignment is
+// accepted by accept, if it is not nil. Acceptance must be monotone in t.
+//
+// return the cheapest such assignment and t.
+func solveBottleneck(costMatrix, key [][]float64, accept func(Assignment) bool) (Assignment, float64, error) {
+	var thresholds []float64
+	for w := range key {
+		for j, k := range key[w] {
+			if !math.IsInf(costMatrix[w][j], 1) {
+				thresholds = append(thresholds, k)
+			}
+		}
+	}
+	sort.Float64s(thresholds)
+	solve := func(t float64) (Assignment, error) {
+		restricted := make([][]float64, len(costMatrix))
+		for w := range costMatrix {
+			restricted[w] = make([]float64, len(costMatrix[w]))
+			for j, c := range costMatrix[w] {
+				restricted[w][j] = c
+				if key[w][j] > t {
+					restricted[w][j] = math.Inf(1)
+				}
+			}
+		}
+		h, err := NewHungarianAlgorithm(restricted, allowForbidden())
+		if err != nil {
+			return nil, err
+		}
+		a, err := h.execute()
+		if err == nil && accept != nil && !accept(a) {
+			return nil, ErrorInfeasible
+		}
+		return a, err
+	}
+	// The least feasible threshold, or len(thresholds) if none is.
+	i := sort.Search(len(thresholds), func(i int) bool {
+		_, err := solve(thresholds[i])
+		return err == nil
+	})
+	if i == len(thresholds) {
+		if len(thresholds) == 0 {
+			// Nothing to assign.
+			a, err := solve(0)
+			return a, 0, err
+		}
+		return nil, 0, ErrorInfeasible
+	}
+	a, err := solve(thresholds[i])
+	return a, thresholds[i], err
+}
@@ -0,0 +1,211 @@
+package munkres_test
+
+import (
+	"math"
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/charles-haynes/munkres"
+)
+
+// return the least threshold on key admitting an assignment, and the
+// least cost of an assignment within it, by brute force.
+func bruteBottleneck(c, key [][]float64) (float64, float64) {
+	var thresholds []float64
+	for w := range key {
+		thresholds = append(thresholds, key[w]...)
+	}
+	sort.Float64s(thresholds)
+	for _, t := range thresholds {
+		cost, found := bruteForceCost(c, func(w, j int) bool { return key[w][j] <= t })
+		if found {
+			return t, cost
+		}
+	}
+	return 0, 0
+}
+
+func TestSolveFair(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 100; i++ {
+		rows, cols := 1+rng.Intn(5), 1+rng.Intn(5)
+		c := randomMatrix(rng, rows, cols)
+		regret := make([][]float64, rows)
+		for w := range c {
+			cheapest := math.Inf(1)
+			for _, x := range c[w] {
+				cheapest = math.Min(cheapest, x)
+			}
+			regret[w] = make([]float64, cols)
+			for j, x := range c[w] {
+				regret[w][j] = x - cheapest
+			}
+		}
+		for _, tc := range []struct {
+			objective munkres.Fairness
+			key       [][]float64
+		}{
+			{munkres.Egalitarian, c},
+			{munkres.LeastEnvy, regret},
+		} {
+			a, value, err := munkres.SolveFair(c, tc.objective)
+			if err != nil {
+				t.Fatal(err)
+			}
+			wantValue, wantCost := bruteBottleneck(c, tc.key)
+			worst := math.Inf(-1)
+			for w, j := range a {
+				if j != -1 {
+					worst = math.Max(worst, tc.key[w][j])
+				}
+			}
+			if value != wantValue || worst != wantValue {
+				t.Errorf("objective %d: want value = %f got %f, worst %f", tc.objective, wantValue, value, worst)
+			}
+			if cost := a.Cost(c); cost != wantCost {
+				t.Errorf("objective %d: want cost = %f got %f", tc.objective, wantCost, cost)
+			}
+		}
+	}
+}
+
+func TestSolveFairTradesCost(t *testing.T) {
+	// The cheapest assignment leaves worker 1 with a cost of 10.
+	c := [][]float64{
+		{1, 6},
+		{6, 10},
+	}
+	a, value, err := munkres.SolveFair(c, munkres.Egalitarian)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if value != 6 || a[0] != 1 || a[1] != 0 {
+		t.Errorf("want [1 0] with value 6 got %v with %f", a, value)
+	}
+	if _, _, err := munkres.SolveFair(c, munkres.Fairness(-1)); err != munkres.ErrorInvalidOption {
+		t.Errorf("want err = %s got %v", munkres.ErrorInvalidOption, err)
+	}
+	if a, _, err := munkres.SolveFair(nil, munkres.LeastEnvy); err != nil || len(a) != 0 {
+		t.Errorf("want an empty assignment got %v, %v", a, err)
+	}
+}
+
+func TestSolveOptimalFair(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 100; i++ {
+		rows, cols := 1+rng.Intn(5), 1+rng.Intn(5)
+		c := randomMatrix(rng, rows, cols)
+		optimum, _ := bruteForceCost(c, func(w, j int) bool { return true })
+		a, value, err := munkres.SolveOptimalFair(c, munkres.LeastEnvy)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if cost := a.Cost(c); cost != optimum {
+			t.Errorf("want cost = %f got %f", optimum, cost)
+		}
+		// No optimal assignment has a lesser greatest regret.
+		worst := math.Inf(-1)
+		for w, j := range a {
+			if j != -1 {
+				worst = math.Max(worst, c[w][j]-minOf(c[w]))
+			}
+		}
+		if worst != value {
+			t.Errorf("want value = %f got %f", worst, value)
+		}
+		below, found := bruteForceCost(c, func(w, j int) bool { return c[w][j]-minOf(c[w]) < value })
+		if found && below == optimum {
+			t.Errorf("an optimal assignment has a greatest regret below %f", value)
+		}
+	}
+}
+
+func TestSolveOptimalFairTies(t *testing.T) {
+	// Both optimal assignments cost 4, but [0 1 2] leaves worker 2 a
+	// regret of 3, while [0 2 1] leaves no worker a regret above 2.
+	c := [][]float64{
+		{0, 3, 4},
+		{3, 0, 2},
+		{1, 2, 4},
+	}
+	a, value, err := munkres.SolveOptimalFair(c, munkres.LeastEnvy)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (munkres.Assignment{0, 2, 1}); !reflect.DeepEqual(a, want) || value != 2 {
+		t.Errorf("want %v with value 2 got %v with %f", want, a, value)
+	}
+}
+
+func minOf(row []float64) float64 {
+	m := math.Inf(1)
+	for _, x := range row {
+		m = math.Min(m, x)
+	}
+	return m
+}
+
+func TestExecuteBottleneck(t *testing.T) {
+	rng := rand.New(rand.NewSource(5))
+	for i := 0; i < 100; i++ {
+		rows, cols := 1+rng.Intn(5), 1+rng.Intn(5)
+		c := randomMatrix(rng, rows, cols)
+		rejected := func(w, j int) bool { return (w+j+i)%4 == 0 }
+		h, _ := munkres.NewHungarianAlgorithm(c, munkres.WithRejection(rejected))
+		a, bottleneck, err := h.ExecuteBottleneck()
+		var thresholds []float64
+		for w := range c {
+			for j, x := range c[w] {
+				if !rejected(w, j) {
+					thresholds = append(thresholds, x)
+				}
+			}
+		}
+		sort.Float64s(thresholds)
+		want, wantCost, found := 0.0, 0.0, false
+		for _, threshold := range thresholds {
+			wantCost, found = bruteForceCost(c, func(w, j int) bool { return !rejected(w, j) && c[w][j] <= threshold })
+			if found {
+				want = threshold
+				break
+			}
+		}
+		if !found {
+			if err != munkres.ErrorInfeasible {
+				t.Fatalf("%v: want ErrorInfeasible got %v", c, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		worst := math.Inf(-1)
+		for w, j := range a {
+			if j != -1 {
+				if rejected(w, j) {
+					t.Fatalf("%v: assigned rejected pair %d, %d", c, w, j)
+				}
+				worst = math.Max(worst, c[w][j])
+			}
+		}
+		if bottleneck != want || worst != want || count(a) != assignable(rows, cols) {
+			t.Fatalf("%v: want bottleneck %f got %v with %f, worst %f", c, want, a, bottleneck, worst)
+		}
+		if got := a.Cost(c); math.Abs(got-wantCost) > 1e-9 {
+			t.Errorf("%v: want cost %f got %f", c, wantCost, got)
+		}
+	}
+}
+
+func TestExecuteBottleneckMaximize(t *testing.T) {
+	h, _ := munkres.NewHungarianAlgorithm([][]float64{{1, 9}, {8, 2}, {5, 6}}, munkres.WithMaximize())
+	a, bottleneck, err := h.ExecuteBottleneck()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (munkres.Assignment{1, 0, -1}); !reflect.DeepEqual(a, want) || bottleneck != 8 {
+		t.Errorf("want %v with least profit 8 got %v with %f", want, a, bottleneck)
+	}
+}
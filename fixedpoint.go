@@ -0,0 +1,105 @@
+package munkres
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// The greatest number of decimal places of an Amount.
+const maxScale = 18
+
+// An Amount is an exact quantity of money, held as a count of minor units,
+// such as cents, with Scale decimal places, so that totals reconcile
+// exactly with accounting systems.
+type Amount struct {
+	Units int64
+	Scale int
+}
+
+// String returns the amount in decimal with Scale decimal places, such as
+// "-12.05" for -1205 units at scale 2.
+func (a Amount) String() string {
+	s := fmt.Sprint(a.Units)
+	sign := ""
+	if a.Units < 0 {
+		sign, s = "-", s[1:]
+	}
+	if a.Scale == 0 {
+		return sign + s
+	}
+	if len(s) <= a.Scale {
+		s = strings.Repeat("0", a.Scale-len(s)+1) + s
+	}
+	return sign + s[:len(s)-a.Scale] + "." + s[len(s)-a.Scale:]
+}
+
+// Float64 returns the amount as the nearest float64, for display or
+// further computation where exactness is not needed.
+func (a Amount) Float64() float64 {
+	return float64(a.Units) / math.Pow10(a.Scale)
+}
+
+// SolveFixedPoint solves the assignment problem for money-valued costs
+// held exactly as counts of minor units, such as cents, with the given
+// scale, the number of decimal places of a unit, from 0 to 18. Every sum
+// is computed in int64 arithmetic, so the total is exact and no rounding
+// artifacts of float64 leak into it. The problem is solved by the shortest
+// augmenting path form of the Hungarian algorithm.
+//
+// The costs must be small enough that no sum of them along an augmenting
+// path can overflow: no greater in magnitude than MaxInt64 divided by
+// twice the number of workers or jobs, whichever is greater, plus 2, or a
+// *CostError wrapping ErrorCostOverflow is returned.
+//
+// return the assignment, which assigns as many workers as Execute would,
+// and its total cost at the given scale.
+func SolveFixedPoint(costMatrix [][]int64, scale int) (Assignment, Amount, error) {
+	if scale < 0 || scale > maxScale {
+		return nil, Amount{}, ErrorInvalidOption
+	}
+	rows, cols := len(costMatrix), 0
+	if rows > 0 {
+		cols = len(costMatrix[0])
+	}
+	n := rows
+	if cols > n {
+		n = cols
+	}
+	limit := math.MaxInt64 / int64(2*n+2)
+	for w, row := range costMatrix {
+		if len(row) != cols {
+			return nil, Amount{}, &ShapeError{Row: w, Cols: len(row), Want: cols}
+		}
+		for j, c := range row {
+			if c > limit || c < -limit {
+				return nil, Amount{}, &CostError{w, j, float64(c), ErrorCostOverflow}
+			}
+		}
+	}
+	a := Assignment(unassigned(rows))
+	if rows <= cols {
+		_, _, jobByWorker, _ := augmentRows(rows, cols, int64(math.MaxInt64),
+			func(w int) ([]int64, error) { return costMatrix[w], nil })
+		copy(a, jobByWorker)
+	} else {
+		column := make([]int64, rows)
+		_, _, workerByJob, _ := augmentRows(cols, rows, int64(math.MaxInt64),
+			func(j int) ([]int64, error) {
+				for w := range column {
+					column[w] = costMatrix[w][j]
+				}
+				return column, nil
+			})
+		for j, w := range workerByJob {
+			a[w] = j
+		}
+	}
+	total := Amount{Scale: scale}
+	for w, j := range a {
+		if j != -1 {
+			total.Units += costMatrix[w][j]
+		}
+	}
+	return a, total, nil
+}
@@ -0,0 +1,105 @@
+package munkres_test
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/charles-haynes/munkres"
+)
+
+// return the least total of an assignment of as many workers as possible
+// for c, by enumerating them.
+func bruteFixedPoint(c [][]int64) int64 {
+	best, found := int64(0), false
+	cols := len(c[0])
+	assigned := len(c)
+	if cols < assigned {
+		assigned = cols
+	}
+	used := make([]bool, cols)
+	var visit func(w, count int, total int64)
+	visit = func(w, count int, total int64) {
+		if w == len(c) {
+			if count == assigned && (!found || total < best) {
+				best, found = total, true
+			}
+			return
+		}
+		visit(w+1, count, total)
+		for j := range c[w] {
+			if !used[j] {
+				used[j] = true
+				visit(w+1, count+1, total+c[w][j])
+				used[j] = false
+			}
+		}
+	}
+	visit(0, 0, 0)
+	return best
+}
+
+func TestSolveFixedPoint(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 100; i++ {
+		rows, cols := 1+rng.Intn(6), 1+rng.Intn(6)
+		c := make([][]int64, rows)
+		for w := range c {
+			c[w] = make([]int64, cols)
+			for j := range c[w] {
+				// Large enough that float64 would round the sums.
+				c[w][j] = 1<<58 + rng.Int63n(1000) - 500
+			}
+		}
+		a, total, err := munkres.SolveFixedPoint(c, 2)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := bruteFixedPoint(c)
+		sum := int64(0)
+		for w, j := range a {
+			if j != -1 {
+				sum += c[w][j]
+			}
+		}
+		if total.Units != want || sum != want || total.Scale != 2 {
+			t.Errorf("%v: want %d got %v total %+v", c, want, a, total)
+		}
+	}
+}
+
+func TestSolveFixedPointErrors(t *testing.T) {
+	if _, _, err := munkres.SolveFixedPoint([][]int64{{1}}, 19); err != munkres.ErrorInvalidOption {
+		t.Errorf("want %v got %v", munkres.ErrorInvalidOption, err)
+	}
+	if _, _, err := munkres.SolveFixedPoint([][]int64{{1, 2}, {3}}, 2); !errors.Is(err, munkres.ErrorIrregularCostMatrix) {
+		t.Errorf("want %v got %v", munkres.ErrorIrregularCostMatrix, err)
+	}
+	var e *munkres.CostError
+	_, _, err := munkres.SolveFixedPoint([][]int64{{1, 2}, {3, math.MaxInt64 / 4}}, 2)
+	if !errors.As(err, &e) || e.Row != 1 || e.Col != 1 || !errors.Is(err, munkres.ErrorCostOverflow) {
+		t.Errorf("want overflow at row 1, column 1 got %v", err)
+	}
+}
+
+func TestAmount(t *testing.T) {
+	for _, tc := range []struct {
+		a    munkres.Amount
+		want string
+	}{
+		{munkres.Amount{Units: 1205, Scale: 2}, "12.05"},
+		{munkres.Amount{Units: -1205, Scale: 2}, "-12.05"},
+		{munkres.Amount{Units: 5, Scale: 3}, "0.005"},
+		{munkres.Amount{Units: -5, Scale: 1}, "-0.5"},
+		{munkres.Amount{Units: 42, Scale: 0}, "42"},
+		{munkres.Amount{Units: math.MinInt64, Scale: 18}, "-9.223372036854775808"},
+	} {
+		if got := tc.a.String(); got != tc.want {
+			t.Errorf("%+v: want %s got %s", tc.a, tc.want, got)
+		}
+	}
+	if got := (munkres.Amount{Units: 1205, Scale: 2}).Float64(); got != 12.05 {
+		t.Errorf("want 12.05 got %v", got)
+	}
+}
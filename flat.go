@@ -0,0 +1,58 @@
+package munkres
+
+// A RowViewer is a Matrix whose rows can be read in place, as those of
+// gonum's mat.Dense can, so that they need not be copied out entry by
+// entry.
+type RowViewer interface {
+	Matrix
+	// RawRowView returns row w, which must not be modified.
+	RawRowView(w int) []float64
+}
+
+// NewFromFlat constructs an instance of the algorithm, as
+// NewHungarianAlgorithm does, for the rows x cols cost matrix held in
+// row-major order in data, such as a buffer of a numeric pipeline. Its
+// rows are passed as views into data, so the costs are copied only once,
+// into the algorithm's own storage, and data is not retained.
+//
+// return ErrorDimensionMismatch if data does not hold rows x cols costs,
+// or the errors of NewHungarianAlgorithm.
+func NewFromFlat(data []float64, rows, cols int, opts ...Option) (HungarianAlgorithm, error) {
+	if rows < 0 || cols < 0 || len(data) != rows*cols {
+		return HungarianAlgorithm{}, ErrorDimensionMismatch
+	}
+	costMatrix := make([][]float64, rows)
+	for w := range costMatrix {
+		costMatrix[w] = data[w*cols : (w+1)*cols : (w+1)*cols]
+	}
+	return NewHungarianAlgorithm(costMatrix, opts...)
+}
+
+// NewFromDense constructs an instance of the algorithm, as
+// NewHungarianAlgorithm does, for a Matrix such as gonum's mat.Dense. The
+// rows of a RowViewer are read in place, so its costs are copied only
+// once, into the algorithm's own storage; those of other matrices are
+// first read into a single row-major buffer, as for NewFromFlat.
+//
+// return ErrorDimensionMismatch if the dimensions of m are negative, or
+// the errors of NewHungarianAlgorithm.
+func NewFromDense(m Matrix, opts ...Option) (HungarianAlgorithm, error) {
+	rows, cols := m.Dims()
+	if rows < 0 || cols < 0 {
+		return HungarianAlgorithm{}, ErrorDimensionMismatch
+	}
+	if v, ok := m.(RowViewer); ok {
+		costMatrix := make([][]float64, rows)
+		for w := range costMatrix {
+			costMatrix[w] = v.RawRowView(w)
+		}
+		return NewHungarianAlgorithm(costMatrix, opts...)
+	}
+	data := make([]float64, rows*cols)
+	for w := 0; w < rows; w++ {
+		for j := 0; j < cols; j++ {
+			data[w*cols+j] = m.At(w, j)
+		}
+	}
+	return NewFromFlat(data, rows, cols, opts...)
+}
@@ -0,0 +1,82 @@
+package munkres_test
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/charles-haynes/munkres"
+)
+
+// A dense matrix held in row-major order, as gonum's mat.Dense is.
+type dense struct {
+	rows, cols int
+	data       []float64
+}
+
+func (d dense) Dims() (int, int)           { return d.rows, d.cols }
+func (d dense) At(w, j int) float64        { return d.data[w*d.cols+j] }
+func (d dense) RawRowView(w int) []float64 { return d.data[w*d.cols : (w+1)*d.cols] }
+
+// A Matrix which can only be read entry by entry, its RawRowView hidden
+// by one of another signature.
+type entries struct{ dense }
+
+func (e entries) RawRowView() {}
+
+func flatten(c [][]float64) []float64 {
+	var data []float64
+	for _, row := range c {
+		data = append(data, row...)
+	}
+	return data
+}
+
+func TestNewFromFlat(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 50; i++ {
+		rows, cols := 1+rng.Intn(8), 1+rng.Intn(8)
+		c := randomMatrix(rng, rows, cols)
+		data := flatten(c)
+		want, _ := munkres.NewHungarianAlgorithm(c)
+		d := dense{rows, cols, data}
+		for _, build := range []func() (munkres.HungarianAlgorithm, error){
+			func() (munkres.HungarianAlgorithm, error) { return munkres.NewFromFlat(data, rows, cols) },
+			func() (munkres.HungarianAlgorithm, error) { return munkres.NewFromDense(d) },
+			func() (munkres.HungarianAlgorithm, error) { return munkres.NewFromDense(entries{d}) },
+		} {
+			h, err := build()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := h.Execute(); !reflect.DeepEqual(got, want.Execute()) {
+				t.Fatalf("%v: got %v want %v", c, got, want.Execute())
+			}
+		}
+		if !reflect.DeepEqual(data, flatten(c)) {
+			t.Fatalf("data modified")
+		}
+	}
+}
+
+func TestNewFromFlatOptions(t *testing.T) {
+	h, err := munkres.NewFromFlat([]float64{1, 2, 3, 5}, 2, 2, munkres.WithMaximize())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := h.Execute(); !reflect.DeepEqual(got, []int{0, 1}) {
+		t.Errorf("got %v", got)
+	}
+}
+
+func TestNewFromFlatErrors(t *testing.T) {
+	if _, err := munkres.NewFromFlat([]float64{1, 2, 3}, 2, 2); err != munkres.ErrorDimensionMismatch {
+		t.Errorf("short data: got %v", err)
+	}
+	if _, err := munkres.NewFromFlat(nil, -1, 0); err != munkres.ErrorDimensionMismatch {
+		t.Errorf("negative rows: got %v", err)
+	}
+	if _, err := munkres.NewFromDense(dense{-1, 2, nil}); err != munkres.ErrorDimensionMismatch {
+		t.Errorf("negative dims: got %v", err)
+	}
+}
@@ -0,0 +1,197 @@
+package munkres
+
+import (
+	"container/heap"
+	"math"
+)
+
+// A directed edge of a flow network. The edges of a network are stored in
+// pairs so that the residual edge of edge e is e^1.
+type flowEdge struct {
+	to   int
+	cap  float64
+	cost float64
+}
+
+// A flow network solved for minimum cost flows by successive shortest
+// paths. Shortest paths are found by Dijkstra's algorithm over costs
+// reduced by node potentials, which keeps them non-negative even though
+// edge costs may be negative.
+type flowNetwork struct {
+	edges     []flowEdge
+	adj       [][]int
+	potential []float64
+	dist      []float64
+	prevEdge  []int
+}
+
+func newFlowNetwork(nodes int) *flowNetwork {
+	return &flowNetwork{
+		adj:       make([][]int, nodes),
+		potential: make([]float64, nodes),
+		dist:      make([]float64, nodes),
+		prevEdge:  make([]int, nodes),
+	}
+}
+
+// Add an edge from u to v with the given capacity and cost per unit.
+// Capacities need not be integers, but flows are exact when they are.
+//
+// return the index of the edge, for use with flow.
+func (g *flowNetwork) addEdge(u, v int, cap, cost float64) int {
+	e := len(g.edges)
+	g.edges = append(g.edges,
+		flowEdge{to: v, cap: cap, cost: cost},
+		flowEdge{to: u, cap: 0, cost: -cost})
+	g.adj[u] = append(g.adj[u], e)
+	g.adj[v] = append(g.adj[v], e+1)
+	return e
+}
+
+// return the flow along edge e.
+func (g *flowNetwork) flow(e int) float64 {
+	return g.edges[e^1].cap
+}
+
+// Send up to limit units of flow from s to t at minimum cost. The network
+// must not contain a cycle of negative cost.
+//
+// return the amount of flow sent and its total cost.
+func (g *flowNetwork) minCostFlow(s, t int, limit float64) (float64, float64) {
+	g.initializePotentials(s)
+	flow, cost := 0.0, 0.0
+	for flow < limit && g.shortestPaths(s) {
+		if math.IsInf(g.dist[t], 1) {
+			break
+		}
+		// Capping the distances at that of t keeps the reduced
+		// costs non-negative along every residual edge.
+		for v := range g.potential {
+			g.potential[v] += math.Min(g.dist[v], g.dist[t])
+		}
+		push := limit - flow
+		for v := t; v != s; v = g.edges[g.prevEdge[v]^1].to {
+			if c := g.edges[g.prevEdge[v]].cap; c < push {
+				push = c
+			}
+		}
+		for v := t; v != s; v = g.edges[g.prevEdge[v]^1].to {
+			e := g.prevEdge[v]
+			g.edges[e].cap -= push
+			g.edges[e^1].cap += push
+			cost += push * g.edges[e].cost
+		}
+		flow += push
+	}
+	return flow, cost
+}
+
+// return which nodes are reachable from s over residual edges, the source
+// side of a minimum cut once a maximum flow has been sent.
+func (g *flowNetwork) reachable(s int) []bool {
+	seen := make([]bool, len(g.adj))
+	seen[s] = true
+	stack := []int{s}
+	for len(stack) > 0 {
+		u := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		for _, e := range g.adj[u] {
+			if v := g.edges[e].to; g.edges[e].cap > 0 && !seen[v] {
+				seen[v] = true
+				stack = append(stack, v)
+			}
+		}
+	}
+	return seen
+}
+
+// Compute the initial node potentials as shortest path distances from s
+// by the Bellman-Ford algorithm, so that negative edge costs are allowed.
+func (g *flowNetwork) initializePotentials(s int) {
+	for v := range g.potential {
+		g.potential[v] = math.Inf(1)
+	}
+	g.potential[s] = 0
+	for i := 0; i < len(g.adj); i++ {
+		changed := false
+		for u := range g.adj {
+			if math.IsInf(g.potential[u], 1) {
+				continue
+			}
+			for _, e := range g.adj[u] {
+				edge := g.edges[e]
+				if edge.cap > 0 && g.potential[u]+edge.cost < g.potential[edge.to] {
+					g.potential[edge.to] = g.potential[u] + edge.cost
+					changed = true
+				}
+			}
+		}
+		if !changed {
+			break
+		}
+	}
+	for v := range g.potential {
+		if math.IsInf(g.potential[v], 1) {
+			g.potential[v] = 0
+		}
+	}
+}
+
+// Compute the shortest path distances from s over residual edges with
+// costs reduced by the node potentials, recording the last edge of each
+// path in prevEdge.
+//
+// return true if any node other than s is reachable.
+func (g *flowNetwork) shortestPaths(s int) bool {
+	for v := range g.dist {
+		g.dist[v] = math.Inf(1)
+		g.prevEdge[v] = -1
+	}
+	g.dist[s] = 0
+	queue := &nodeQueue{{node: s}}
+	reached := false
+	for queue.Len() > 0 {
+		item := heap.Pop(queue).(nodeDistance)
+		u := item.node
+		if item.dist > g.dist[u] {
+			continue
+		}
+		for _, e := range g.adj[u] {
+			edge := g.edges[e]
+			if edge.cap == 0 {
+				continue
+			}
+			reduced := edge.cost + g.potential[u] - g.potential[edge.to]
+			if reduced < 0 {
+				// Only rounding can make a reduced cost negative.
+				reduced = 0
+			}
+			if d := g.dist[u] + reduced; d < g.dist[edge.to] {
+				g.dist[edge.to] = d
+				g.prevEdge[edge.to] = e
+				heap.Push(queue, nodeDistance{node: edge.to, dist: d})
+				reached = true
+			}
+		}
+	}
+	return reached
+}
+
+type nodeDistance struct {
+	node int
+	dist float64
+}
+
+// A priority queue of nodes by distance, for use with container/heap.
+type nodeQueue []nodeDistance
+
+func (q nodeQueue) Len() int            { return len(q) }
+func (q nodeQueue) Less(i, j int) bool  { return q[i].dist < q[j].dist }
+func (q nodeQueue) Swap(i, j int)       { q[i], q[j] = q[j], q[i] }
+func (q *nodeQueue) Push(x interface{}) { *q = append(*q, x.(nodeDistance)) }
+func (q *nodeQueue) Pop() interface{} {
+	old := *q
+	x := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return x
+}
@@ -0,0 +1,68 @@
+package munkres
+
+import "math"
+
+// Forbidden is the cost of a pair of a worker and a job which may never
+// be assigned, +Inf, WithForbidden.
+var Forbidden = math.Inf(1)
+
+// WithForbidden allows Forbidden (+Inf) entries in the cost matrix, such
+// as those of the pairs which skills or geography rule out, which mark
+// pairs that are never assigned, rather than faking them with costs so
+// great that they cost the others their precision. Solve returns
+// ErrorInfeasible if the forbidden pairs leave no assignment of as many
+// workers as there are workers or jobs, whichever is fewer, unless
+// WithPartialAssignment is also set.
+func WithForbidden() Option {
+	return allowForbidden()
+}
+
+// WithPartialAssignment allows Forbidden entries as WithForbidden does,
+// but where those leave no complete assignment, leaves workers and jobs
+// unassigned, marking the workers by -1, rather than failing: Execute
+// then assigns as many workers as any assignment can, at least cost
+// among those doing so. That assignment is found by the blossom
+// algorithm of MaxWeightMatching on the allowed pairs, in time O(n^3),
+// and the labels, and so the Duals, are then not meaningful.
+func WithPartialAssignment() Option {
+	return func(o *options) {
+		o.forbidden = true
+		o.partial = true
+	}
+}
+
+// Match as many workers as the allowed pairs permit, at least cost, for
+// WithPartialAssignment, after the algorithm has found no complete
+// assignment.
+//
+// return the assignment as for Execute.
+func (h *HungarianAlgorithm) executePartial() []int {
+	var edges []graphEdge
+	top := math.Inf(-1)
+	for w := 0; w < h.rows; w++ {
+		for j := 0; j < h.cols; j++ {
+			if c := h.originalCost(w, j); !math.IsInf(c, 1) && !h.rejected(w, j) {
+				top = math.Max(top, c)
+				edges = append(edges, graphEdge{w, h.rows + j, c})
+			}
+		}
+	}
+	// Maximizing the weight of a maximum cardinality matching under
+	// positive weights which fall as the costs rise minimizes the cost
+	// among the matchings of maximum cardinality.
+	for i := range edges {
+		edges[i].weight = top + 1 - edges[i].weight
+	}
+	mate := maxWeightMatching(h.rows+h.cols, edges, true)
+	for i := range h.matchJobByWorker {
+		h.matchJobByWorker[i] = -1
+		h.matchWorkerByJob[i] = -1
+	}
+	for w := 0; w < h.rows; w++ {
+		if v := mate[w]; v != -1 {
+			h.match(w, v-h.rows)
+		}
+	}
+	h.progress.setMatched(h.matched())
+	return h.result()
+}
@@ -0,0 +1,81 @@
+package munkres_test
+
+import (
+	"math"
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/charles-haynes/munkres"
+)
+
+// return the greatest number of workers any assignment of the allowed
+// pairs assigns, and the least cost of doing so.
+func bruteForcePartial(c [][]float64) (int, float64) {
+	best, least := 0, 0.0
+	used := map[int]bool{}
+	var search func(w, n int, cost float64)
+	search = func(w, n int, cost float64) {
+		if w == len(c) {
+			if n > best || n == best && cost < least {
+				best, least = n, cost
+			}
+			return
+		}
+		search(w+1, n, cost)
+		for j, x := range c[w] {
+			if !used[j] && !math.IsInf(x, 1) {
+				used[j] = true
+				search(w+1, n+1, cost+x)
+				used[j] = false
+			}
+		}
+	}
+	search(0, 0, 0)
+	return best, least
+}
+
+func TestForbidden(t *testing.T) {
+	c := [][]float64{{1, munkres.Forbidden}, {munkres.Forbidden, munkres.Forbidden}}
+	if _, err := munkres.NewHungarianAlgorithm(c); err == nil {
+		t.Errorf("want an error for forbidden costs by default")
+	}
+	h, err := munkres.NewHungarianAlgorithm(c, munkres.WithForbidden())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := h.Solve(); err != munkres.ErrorInfeasible {
+		t.Errorf("want ErrorInfeasible got %v", err)
+	}
+	h, _ = munkres.NewHungarianAlgorithm(c, munkres.WithPartialAssignment())
+	if a, err := h.Solve(); err != nil || !reflect.DeepEqual(a, []int{0, -1}) {
+		t.Errorf("want [0 -1] got %v (%v)", a, err)
+	}
+}
+
+func TestPartialAssignment(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 200; i++ {
+		rows, cols := 1+rng.Intn(5), 1+rng.Intn(5)
+		c := randomMatrix(rng, rows, cols)
+		for w := range c {
+			for j := range c[w] {
+				if rng.Intn(3) > 0 {
+					c[w][j] = munkres.Forbidden
+				}
+			}
+		}
+		h, err := munkres.NewHungarianAlgorithm(c, munkres.WithPartialAssignment())
+		if err != nil {
+			t.Fatal(err)
+		}
+		a, err := h.Solve()
+		if err != nil {
+			t.Fatal(err)
+		}
+		n, least := bruteForcePartial(c)
+		if got := munkres.Assignment(a).Cost(c); count(a) != n || math.Abs(got-least) > 1e-9 {
+			t.Fatalf("%v: want %d assigned costing %v got %v costing %v", c, n, least, a, got)
+		}
+	}
+}
@@ -0,0 +1,125 @@
+/*
+Package generate produces instances of the assignment problem, for tests,
+benchmarks and bug reports. Every generator is deterministic given its
+source of randomness, so an instance can be reproduced from its seed.
+*/
+package generate
+
+import (
+	"math"
+	"math/rand"
+)
+
+// Uniform returns a rows x cols cost matrix of integer costs drawn
+// uniformly from [0, max).
+func Uniform(rng *rand.Rand, rows, cols, max int) [][]float64 {
+	c := make([][]float64, rows)
+	for w := range c {
+		c[w] = make([]float64, cols)
+		for j := range c[w] {
+			c[w][j] = float64(rng.Intn(max))
+		}
+	}
+	return c
+}
+
+// MacholWien returns the n x n Machol-Wien instance, c[i][j] = (i+1)(j+1),
+// a classic worst case for the Hungarian algorithm: every assignment
+// costs nearly the same, and the labels must be raised many times.
+func MacholWien(n int) [][]float64 {
+	c := make([][]float64, n)
+	for i := range c {
+		c[i] = make([]float64, n)
+		for j := range c[i] {
+			c[i][j] = float64((i + 1) * (j + 1))
+		}
+	}
+	return c
+}
+
+// RandomMacholWien returns an n x n instance with c[i][j] drawn uniformly
+// from the integers in [1, (i+1)(j+1)], a randomized Machol-Wien instance
+// which is hard for most assignment algorithms.
+func RandomMacholWien(rng *rand.Rand, n int) [][]float64 {
+	c := make([][]float64, n)
+	for i := range c {
+		c[i] = make([]float64, n)
+		for j := range c[i] {
+			c[i][j] = float64(1 + rng.Intn((i+1)*(j+1)))
+		}
+	}
+	return c
+}
+
+// Constant returns a rows x cols matrix of equal costs, the most
+// degenerate instance: every assignment is optimal, so every comparison
+// is a tie.
+func Constant(rows, cols int, cost float64) [][]float64 {
+	c := make([][]float64, rows)
+	for i := range c {
+		c[i] = make([]float64, cols)
+		for j := range c[i] {
+			c[i][j] = cost
+		}
+	}
+	return c
+}
+
+// Points returns n points drawn uniformly from the unit cube of the given
+// dimension.
+func Points(rng *rand.Rand, n, dim int) [][]float64 {
+	points := make([][]float64, n)
+	for i := range points {
+		points[i] = make([]float64, dim)
+		for d := range points[i] {
+			points[i][d] = rng.Float64()
+		}
+	}
+	return points
+}
+
+// Geometric returns a geometric instance: rows worker points and cols job
+// points in the unit cube of the given dimension, and the matrix of
+// Euclidean distances between them, which behaves very differently from
+// a matrix of independent costs.
+//
+// If noise is zero the two point clouds are independent. Otherwise the
+// jobs are copies of the workers, as in tracking from one frame to the
+// next, each displaced by Gaussian noise with standard deviation noise;
+// any jobs beyond the number of workers are placed uniformly. Each job is
+// instead an outlier with probability outliers, placed uniformly in a
+// cube ten times as wide.
+func Geometric(rng *rand.Rand, rows, cols, dim int, noise, outliers float64) (workers, jobs, costMatrix [][]float64) {
+	workers = Points(rng, rows, dim)
+	jobs = Points(rng, cols, dim)
+	for j, p := range jobs {
+		switch {
+		case rng.Float64() < outliers:
+			for d := range p {
+				p[d] = 10*p[d] - 4.5
+			}
+		case noise > 0 && j < rows:
+			for d := range p {
+				p[d] = workers[j][d] + noise*rng.NormFloat64()
+			}
+		}
+	}
+	return workers, jobs, Distances(workers, jobs)
+}
+
+// Distances returns the matrix of Euclidean distances from each of the
+// points a to each of the points b.
+func Distances(a, b [][]float64) [][]float64 {
+	c := make([][]float64, len(a))
+	for i := range a {
+		c[i] = make([]float64, len(b))
+		for j := range b {
+			d := 0.0
+			for k := range a[i] {
+				d += (a[i][k] - b[j][k]) * (a[i][k] - b[j][k])
+			}
+			c[i][j] = math.Sqrt(d)
+		}
+	}
+	return c
+}
@@ -0,0 +1,68 @@
+package generate_test
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/charles-haynes/munkres/generate"
+)
+
+func TestUniform(t *testing.T) {
+	c := generate.Uniform(rand.New(rand.NewSource(1)), 3, 5, 10)
+	if len(c) != 3 || len(c[0]) != 5 {
+		t.Fatalf("want a 3x5 matrix got %v", c)
+	}
+	for _, row := range c {
+		for _, x := range row {
+			if x < 0 || x >= 10 || x != float64(int(x)) {
+				t.Errorf("want integers in [0, 10) got %v", c)
+			}
+		}
+	}
+	if again := generate.Uniform(rand.New(rand.NewSource(1)), 3, 5, 10); !reflect.DeepEqual(again, c) {
+		t.Errorf("want %v again got %v", c, again)
+	}
+}
+
+func TestMacholWien(t *testing.T) {
+	want := [][]float64{{1, 2, 3}, {2, 4, 6}, {3, 6, 9}}
+	if got := generate.MacholWien(3); !reflect.DeepEqual(got, want) {
+		t.Errorf("want %v got %v", want, got)
+	}
+	c := generate.RandomMacholWien(rand.New(rand.NewSource(1)), 4)
+	for i, row := range c {
+		for j, x := range row {
+			if x < 1 || x > float64((i+1)*(j+1)) {
+				t.Errorf("want c[%d][%d] in [1, %d] got %f", i, j, (i+1)*(j+1), x)
+			}
+		}
+	}
+	if got, want := generate.Constant(1, 2, 7), [][]float64{{7, 7}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("want %v got %v", want, got)
+	}
+}
+
+func TestGeometric(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	workers, jobs, c := generate.Geometric(rng, 20, 30, 2, 0.01, 0)
+	if len(workers) != 20 || len(jobs) != 30 || len(c) != 20 || len(c[0]) != 30 {
+		t.Fatalf("want 20 workers, 30 jobs and a 20x30 matrix")
+	}
+	// Without outliers each worker is close to its noisy copy.
+	for w := range workers {
+		if c[w][w] > 0.1 {
+			t.Errorf("want worker %d within 0.1 of job %d got %f", w, w, c[w][w])
+		}
+	}
+	_, jobs, _ = generate.Geometric(rng, 20, 20, 2, 0, 1)
+	outside := 0
+	for _, p := range jobs {
+		if p[0] < 0 || p[0] > 1 || p[1] < 0 || p[1] > 1 {
+			outside++
+		}
+	}
+	if outside == 0 {
+		t.Errorf("want outliers outside the unit square got %v", jobs)
+	}
+}
@@ -0,0 +1,87 @@
+package munkres
+
+import "math"
+
+// SolveGeometric matches a set of worker points to a set of job points so
+// as to minimise the total Euclidean distance between matched points, for
+// point-matching problems too large for a dense cost matrix.
+//
+// A k-d tree is built over each set of points, and only the distances from
+// each point to its k nearest neighbours in the other set are considered,
+// so the problem takes memory proportional to k times the number of
+// points. The result is the optimal assignment among those pairs, which is
+// usually the optimal assignment overall when k is modest; if those pairs
+// admit no assignment of as many workers as there are workers or jobs,
+// whichever is fewer, ErrorInfeasible is returned and a larger k should be
+// tried.
+//
+// All points must have the same, non-zero, number of coordinates, each of
+// which must be a non-infinite number.
+func SolveGeometric(workers, jobs [][]float64, k int) ([]int, error) {
+	if err := checkPoints(workers, jobs); err != nil {
+		return nil, err
+	}
+	if len(workers) == 0 || len(jobs) == 0 {
+		return unassigned(len(workers)), nil
+	}
+	seen := map[[2]int]bool{}
+	var edges []sparseEdge
+	addEdge := func(w, j int) {
+		if !seen[[2]int{w, j}] {
+			seen[[2]int{w, j}] = true
+			edges = append(edges, sparseEdge{
+				w: w, j: j,
+				cost: math.Sqrt(squaredDistance(workers[w], jobs[j]))})
+		}
+	}
+	jobTree := newKDTree(jobs)
+	for w, p := range workers {
+		for _, j := range jobTree.nearest(p, k) {
+			addEdge(w, j)
+		}
+	}
+	workerTree := newKDTree(workers)
+	for j, p := range jobs {
+		for _, w := range workerTree.nearest(p, k) {
+			addEdge(w, j)
+		}
+	}
+	result, _, _, err := solveSparse(len(workers), len(jobs), edges)
+	return result, err
+}
+
+// Check that all points have the same number of finite coordinates.
+func checkPoints(sets ...[][]float64) error {
+	dim := -1
+	for _, points := range sets {
+		for _, p := range points {
+			if dim == -1 {
+				dim = len(p)
+			}
+			if len(p) != dim || dim == 0 {
+				return ErrorDimensionMismatch
+			}
+			for _, x := range p {
+				if math.IsInf(x, 0) {
+					return ErrorInfiniteCost
+				}
+				if math.IsNaN(x) {
+					return ErrorNaNCost
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// return an assignment of n workers, none of whom are assigned.
+func unassigned(n int) []int {
+	if n == 0 {
+		return nil
+	}
+	result := make([]int, n)
+	for w := range result {
+		result[w] = -1
+	}
+	return result
+}
@@ -0,0 +1,110 @@
+package munkres_test
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/charles-haynes/munkres"
+	"github.com/charles-haynes/munkres/generate"
+)
+
+func randomPoints(rng *rand.Rand, n, dim int) [][]float64 {
+	points := make([][]float64, n)
+	for i := range points {
+		points[i] = make([]float64, dim)
+		for d := range points[i] {
+			points[i][d] = rng.Float64()
+		}
+	}
+	return points
+}
+
+func distanceMatrix(a, b [][]float64) [][]float64 {
+	c := make([][]float64, len(a))
+	for i := range a {
+		c[i] = make([]float64, len(b))
+		for j := range b {
+			d := 0.0
+			for k := range a[i] {
+				d += (a[i][k] - b[j][k]) * (a[i][k] - b[j][k])
+			}
+			c[i][j] = math.Sqrt(d)
+		}
+	}
+	return c
+}
+
+func TestSolveGeometric(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 50; i++ {
+		dim := 1 + rng.Intn(3)
+		workers := randomPoints(rng, 1+rng.Intn(30), dim)
+		jobs := randomPoints(rng, 1+rng.Intn(30), dim)
+		c := distanceMatrix(workers, jobs)
+		h, _ := munkres.NewHungarianAlgorithm(c)
+		want, _ := munkres.ComputeCost(c, h.Execute())
+
+		// With every neighbour the result is exact.
+		res, err := munkres.SolveGeometric(workers, jobs, len(workers)+len(jobs))
+		if err != nil {
+			t.Fatal(err)
+		}
+		cost, err := munkres.ComputeCost(c, res)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if math.Abs(cost-want) > 1e-9 {
+			t.Errorf("want cost = %f got %f", want, cost)
+		}
+
+		// With a few neighbours it is a valid assignment.
+		res, err = munkres.SolveGeometric(workers, jobs, 3)
+		if err == munkres.ErrorInfeasible {
+			continue
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		cost, err = munkres.ComputeCost(c, res)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assigned := 0
+		for _, j := range res {
+			if j != -1 {
+				assigned++
+			}
+		}
+		if assigned != len(workers) && assigned != len(jobs) || cost < want-1e-9 {
+			t.Errorf("assigned %d at cost %f, optimum %f", assigned, cost, want)
+		}
+	}
+}
+
+func TestSolveGeometricErrors(t *testing.T) {
+	if _, err := munkres.SolveGeometric([][]float64{{0, 0}}, [][]float64{{0}}, 1); err != munkres.ErrorDimensionMismatch {
+		t.Errorf("want err = %s got %v", munkres.ErrorDimensionMismatch, err)
+	}
+	if _, err := munkres.SolveGeometric([][]float64{{math.NaN()}}, [][]float64{{0}}, 1); err != munkres.ErrorNaNCost {
+		t.Errorf("want err = %s got %v", munkres.ErrorNaNCost, err)
+	}
+	// Both workers have the same nearest job, but the other job has a
+	// nearest worker of its own.
+	_, err := munkres.SolveGeometric([][]float64{{0}, {1}}, [][]float64{{0}, {10}}, 1)
+	if err != nil {
+		t.Errorf("want no error got %v", err)
+	}
+}
+
+// Geometric instances behave very differently from uniform ones, so
+// benchmark both; compare BenchmarkExecute.
+func BenchmarkExecuteGeometric(b *testing.B) {
+	rng := rand.New(rand.NewSource(1))
+	_, _, c := generate.Geometric(rng, 300, 300, 2, 0, 0)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		h, _ := munkres.NewHungarianAlgorithm(c)
+		h.Execute()
+	}
+}
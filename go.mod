@@ -1,5 +1,5 @@
 module github.com/charles-haynes/munkres
 
-go 1.12
+go 1.18
 
 require go.etcd.io/etcd v3.3.15+incompatible
@@ -0,0 +1,41 @@
+package munkres
+
+import "sort"
+
+// SolveGreedy quickly finds a good, but not necessarily optimal,
+// assignment for problems where the exact solver is too slow. All pairs
+// are sorted by cost and each is taken in turn if neither its worker nor
+// its job has already been taken, which takes time O(n^2 log n).
+//
+// costMatrix is subject to the same restrictions as for
+// NewHungarianAlgorithm.
+//
+// return the assignment, which assigns as many workers as Execute would,
+// and its quality.
+func SolveGreedy(costMatrix [][]float64) (Assignment, Quality, error) {
+	if err := checkCostMatrix(costMatrix, false); err != nil {
+		return nil, Quality{}, err
+	}
+	rows, cols := len(costMatrix), 0
+	if rows > 0 {
+		cols = len(costMatrix[0])
+	}
+	pairs := make([]int, rows*cols)
+	for p := range pairs {
+		pairs[p] = p
+	}
+	sort.Slice(pairs, func(a, b int) bool {
+		return costMatrix[pairs[a]/cols][pairs[a]%cols] <
+			costMatrix[pairs[b]/cols][pairs[b]%cols]
+	})
+	a := Assignment(unassigned(rows))
+	taken := make([]bool, cols)
+	for _, p := range pairs {
+		w, j := p/cols, p%cols
+		if a[w] == -1 && !taken[j] {
+			a[w] = j
+			taken[j] = true
+		}
+	}
+	return a, quality(costMatrix, a), nil
+}
@@ -0,0 +1,103 @@
+package munkres_test
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/charles-haynes/munkres"
+)
+
+// Check that an approximate assignment assigns as many workers as Execute
+// and that its quality brackets the optimum.
+func checkApproximation(t *testing.T, c [][]float64, a munkres.Assignment, q munkres.Quality) {
+	t.Helper()
+	h, _ := munkres.NewHungarianAlgorithm(c)
+	opt := h.Execute()
+	optimum, _ := munkres.ComputeCost(c, opt)
+	cost, err := munkres.ComputeCost(c, a)
+	if err != nil {
+		t.Fatalf("%v: %s", c, err)
+	}
+	if count(a) != count(opt) {
+		t.Errorf("%v: want %d assigned got %d", c, count(opt), count(a))
+	}
+	if math.Abs(q.Cost-cost) > 1e-9 || math.Abs(q.Gap-(q.Cost-q.LowerBound)) > 1e-9 {
+		t.Errorf("%v: inconsistent quality %+v for cost %f", c, q, cost)
+	}
+	if q.LowerBound > optimum+1e-9 || cost < optimum-1e-9 {
+		t.Errorf("%v: optimum %f outside [%f, %f]",
+			c, optimum, q.LowerBound, cost)
+	}
+}
+
+// return the number of assigned workers.
+func count(a []int) int {
+	n := 0
+	for _, j := range a {
+		if j != -1 {
+			n++
+		}
+	}
+	return n
+}
+
+func TestSolveGreedy(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 100; i++ {
+		c := randomMatrix(rng, 1+rng.Intn(8), 1+rng.Intn(8))
+		a, q, err := munkres.SolveGreedy(c)
+		if err != nil {
+			t.Fatal(err)
+		}
+		checkApproximation(t, c, a, q)
+	}
+}
+
+func TestSolveGreedyExample(t *testing.T) {
+	a, q, err := munkres.SolveGreedy([][]float64{{1, 2}, {2, 10}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a[0] != 0 || a[1] != 1 || q.Cost != 11 || q.LowerBound != 4 {
+		t.Errorf("want [0 1] at 11 with bound 4 got %v %+v", a, q)
+	}
+}
+
+func TestGap(t *testing.T) {
+	c := [][]float64{{1, 2}, {2, 10}}
+	gap, err := munkres.Gap(c, []int{0, 1})
+	if err != nil || gap != 7 {
+		t.Errorf("want 7, <nil> got %f, %v", gap, err)
+	}
+	gap, err = munkres.Gap(c, []int{1, 0})
+	if err != nil || gap != 0 {
+		t.Errorf("want 0, <nil> got %f, %v", gap, err)
+	}
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 100; i++ {
+		c := randomMatrix(rng, 1+rng.Intn(8), 1+rng.Intn(8))
+		a, _, _ := munkres.SolveGreedy(c)
+		gap, err := munkres.Gap(c, a)
+		if err != nil || gap < -1e-9 {
+			t.Errorf("%v: %v gap %f, %v", c, a, gap, err)
+		}
+	}
+}
+
+func TestGapErrors(t *testing.T) {
+	c := [][]float64{{1, 2}, {2, 10}}
+	for _, tc := range []struct {
+		assignment []int
+		want       error
+	}{
+		{[]int{0}, munkres.ErrorDimensionMismatch},
+		{[]int{0, 0}, munkres.ErrorDuplicateJob},
+		{[]int{0, -1}, munkres.ErrorIncompleteAssignment},
+	} {
+		if _, err := munkres.Gap(c, tc.assignment); !errors.Is(err, tc.want) {
+			t.Errorf("%v: want %v got %v", tc.assignment, tc.want, err)
+		}
+	}
+}
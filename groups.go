@@ -0,0 +1,75 @@
+package munkres
+
+import "math"
+
+// WithWorkerGroups restricts the assignment so that at most one worker of
+// each group is assigned, for workers which are mutually exclusive, such
+// as several candidate drivers of one vehicle. groupOf[w] is the group of
+// worker w, or -1 if the worker belongs to no group; it must have one
+// entry for each worker.
+//
+// As in the unrestricted problem, Execute assigns as many workers as
+// possible and, among such assignments, returns one of minimum cost. The
+// problem is solved as a minimum cost flow rather than by the Hungarian
+// algorithm, so backups are not computed.
+func WithWorkerGroups(groupOf []int) Option {
+	return func(o *options) {
+		o.workerGroups = groupOf
+	}
+}
+
+// Execute the algorithm subject to the worker groups.
+func (h *HungarianAlgorithm) executeGroups() []int {
+	if h.rows == 0 {
+		return nil
+	}
+	groups := map[int]int{}
+	for _, g := range h.opts.workerGroups {
+		if _, ok := groups[g]; !ok && g >= 0 {
+			groups[g] = len(groups)
+		}
+	}
+	// Nodes are the source, the groups, the workers, the jobs and the
+	// sink, in that order.
+	worker := func(w int) int { return 1 + len(groups) + w }
+	job := func(j int) int { return 1 + len(groups) + h.rows + j }
+	source, sink := 0, 1+len(groups)+h.rows+h.cols
+	g := newFlowNetwork(sink + 1)
+	for i := 0; i < len(groups); i++ {
+		g.addEdge(source, 1+i, 1, 0)
+	}
+	edges := make([][]int, h.rows)
+	for w := 0; w < h.rows; w++ {
+		if group := h.opts.workerGroups[w]; group >= 0 {
+			g.addEdge(1+groups[group], worker(w), 1, 0)
+		} else {
+			g.addEdge(source, worker(w), 1, 0)
+		}
+		edges[w] = make([]int, h.cols)
+		for j := 0; j < h.cols; j++ {
+			edges[w][j] = -1
+			if c := h.costs[w*h.dim+j]; !math.IsInf(c, 1) {
+				edges[w][j] = g.addEdge(worker(w), job(j), 1, c)
+			}
+		}
+	}
+	for j := 0; j < h.cols; j++ {
+		g.addEdge(job(j), sink, 1, 0)
+	}
+	g.minCostFlow(source, sink, float64(h.rows))
+
+	result := make([]int, h.rows)
+	matched := 0
+	for w := range result {
+		result[w] = -1
+		for j, e := range edges[w] {
+			if e != -1 && g.flow(e) > 0 {
+				result[w] = j
+				matched++
+			}
+		}
+	}
+	h.progress.start(matched)
+	h.progress.setMatched(matched)
+	return result
+}
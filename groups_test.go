@@ -0,0 +1,90 @@
+package munkres_test
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/charles-haynes/munkres"
+)
+
+// return the largest number of workers which can be assigned with at most
+// one worker of each group, and the minimum cost of doing so.
+func bruteForceGroups(c [][]float64, groupOf []int) (int, float64) {
+	bestCount, bestCost := 0, 0.0
+	usedJob := map[int]bool{}
+	usedGroup := map[int]bool{}
+	var visit func(w, count int, cost float64)
+	visit = func(w, count int, cost float64) {
+		if w == len(c) {
+			if count > bestCount || count == bestCount && cost < bestCost {
+				bestCount, bestCost = count, cost
+			}
+			return
+		}
+		visit(w+1, count, cost)
+		g := groupOf[w]
+		if g >= 0 && usedGroup[g] {
+			return
+		}
+		for j := range c[w] {
+			if usedJob[j] {
+				continue
+			}
+			usedJob[j], usedGroup[g] = true, g >= 0
+			visit(w+1, count+1, cost+c[w][j])
+			usedJob[j], usedGroup[g] = false, false
+		}
+	}
+	visit(0, 0, 0.0)
+	return bestCount, bestCost
+}
+
+func TestWorkerGroups(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 100; i++ {
+		rows, cols := 1+rng.Intn(5), 1+rng.Intn(5)
+		c := randomMatrix(rng, rows, cols)
+		groupOf := make([]int, rows)
+		for w := range groupOf {
+			groupOf[w] = rng.Intn(4) - 1
+		}
+		h, err := munkres.NewHungarianAlgorithm(c, munkres.WithWorkerGroups(groupOf))
+		if err != nil {
+			t.Fatal(err)
+		}
+		res := h.Execute()
+		cost, err := munkres.ComputeCost(c, res)
+		if err != nil {
+			t.Fatalf("%v: %s", c, err)
+		}
+		count := 0
+		assigned := map[int]bool{}
+		for w, j := range res {
+			if j == -1 {
+				continue
+			}
+			count++
+			if g := groupOf[w]; g >= 0 {
+				if assigned[g] {
+					t.Fatalf("%v %v: group %d assigned twice in %v",
+						c, groupOf, g, res)
+				}
+				assigned[g] = true
+			}
+		}
+		wantCount, wantCost := bruteForceGroups(c, groupOf)
+		if count != wantCount || math.Abs(cost-wantCost) > 1e-9 {
+			t.Errorf("%v %v: want %d assigned at cost %f got %d at %f",
+				c, groupOf, wantCount, wantCost, count, cost)
+		}
+	}
+}
+
+func TestWorkerGroupsMismatch(t *testing.T) {
+	_, err := munkres.NewHungarianAlgorithm([][]float64{{1, 2}, {3, 4}},
+		munkres.WithWorkerGroups([]int{0}))
+	if err != munkres.ErrorDimensionMismatch {
+		t.Errorf("want err = %s got %s", munkres.ErrorDimensionMismatch, err)
+	}
+}
@@ -0,0 +1,204 @@
+package munkres
+
+import (
+	"container/heap"
+	"math"
+)
+
+// A Hypothesis is one joint association of tracks to measurements, as
+// used by multiple hypothesis tracking, returned by RankHypotheses.
+type Hypothesis struct {
+	// Assignment gives the measurement associated with each track, or
+	// -1 if the track is missed.
+	Assignment Assignment
+	// Cost is the total cost of the associations.
+	Cost float64
+	// Likelihood is exp(-Cost), the likelihood of the hypothesis
+	// relative to that in which every track is missed and every
+	// measurement is clutter.
+	Likelihood float64
+	// Probability is the likelihood normalized over the hypotheses
+	// returned.
+	Probability float64
+	// UnassignedTracks are the tracks missed, and
+	// UnassignedMeasurements the measurements associated with no track,
+	// in increasing order.
+	UnassignedTracks, UnassignedMeasurements []int
+}
+
+// RankHypotheses generates the k best association hypotheses for a
+// multiple hypothesis tracker, in increasing order of cost, by Murty's
+// algorithm for ranking assignments. costMatrix[t][m] holds the cost of
+// associating track t with measurement m, as the negative log of the
+// likelihood ratio of the association against the track being missed
+// and the measurement being clutter, or +Inf if the pair is gated out.
+// Any track may be missed and any measurement left unassigned, at no
+// cost, so fewer than k hypotheses are returned only if there are fewer
+// than k in all.
+//
+// Each hypothesis takes up to t solves of the padded problem, where t is
+// the number of tracks, so the hypotheses take time O(k t (t+m)^3) in
+// all, where m is the number of measurements.
+func RankHypotheses(costMatrix [][]float64, k int) ([]Hypothesis, error) {
+	if err := checkCostMatrix(costMatrix, true); err != nil {
+		return nil, err
+	}
+	if k < 0 {
+		return nil, ErrorNegativeQuantity
+	}
+	tracks, measurements := len(costMatrix), 0
+	if tracks > 0 {
+		measurements = len(costMatrix[0])
+	}
+	// Give each track a dummy measurement, by which it is missed, and
+	// each measurement a dummy track, so that a permutation of the
+	// padded matrix is a hypothesis. Only the tracks' rows are branched
+	// on, since they determine the hypothesis.
+	dim := tracks + measurements
+	padded := make([][]float64, dim)
+	for i := range padded {
+		padded[i] = make([]float64, dim)
+		for j := range padded[i] {
+			padded[i][j] = math.Inf(1)
+		}
+	}
+	for t := range costMatrix {
+		copy(padded[t], costMatrix[t])
+		padded[t][measurements+t] = 0
+	}
+	for m := 0; m < measurements; m++ {
+		padded[tracks+m][m] = 0
+		for t := 0; t < tracks; t++ {
+			padded[tracks+m][measurements+t] = 0
+		}
+	}
+
+	var hypotheses []Hypothesis
+	total := 0.0
+	for _, r := range rankAssignments(padded, tracks, k) {
+		h := Hypothesis{
+			Assignment: make(Assignment, tracks),
+			Cost:       r.cost,
+			Likelihood: math.Exp(-r.cost),
+		}
+		associated := make([]bool, measurements)
+		for t := range h.Assignment {
+			h.Assignment[t] = -1
+			if m := r.perm[t]; m < measurements {
+				h.Assignment[t] = m
+				associated[m] = true
+			} else {
+				h.UnassignedTracks = append(h.UnassignedTracks, t)
+			}
+		}
+		for m, a := range associated {
+			if !a {
+				h.UnassignedMeasurements = append(h.UnassignedMeasurements, m)
+			}
+		}
+		total += h.Likelihood
+		hypotheses = append(hypotheses, h)
+	}
+	for i := range hypotheses {
+		hypotheses[i].Probability = hypotheses[i].Likelihood / total
+	}
+	return hypotheses, nil
+}
+
+// A rankedAssignment is a subproblem of Murty's algorithm: the assignments
+// of a square cost matrix with some pairs forced and others forbidden,
+// and the optimal assignment among them.
+type rankedAssignment struct {
+	forced, forbidden [][2]int
+	perm              []int
+	cost              float64
+}
+
+// return up to k assignments of the square cost matrix, which may contain
+// forbidden entries, in increasing order of cost, distinct in the jobs of
+// the first branchRows workers, which must determine the cost.
+func rankAssignments(costMatrix [][]float64, branchRows, k int) []rankedAssignment {
+	var ranked []rankedAssignment
+	queue := &assignmentQueue{}
+	if root, ok := solveRanked(costMatrix, nil, nil); ok {
+		heap.Push(queue, root)
+	}
+	for len(ranked) < k && queue.Len() > 0 {
+		best := heap.Pop(queue).(rankedAssignment)
+		ranked = append(ranked, best)
+		// Partition the remaining assignments of the subproblem by
+		// the first of its free rows at which they differ from the
+		// best.
+		forced := best.forced
+		for r := 0; r < branchRows; r++ {
+			if isForced(best.forced, r) {
+				continue
+			}
+			forbidden := append(append([][2]int(nil), best.forbidden...), [2]int{r, best.perm[r]})
+			if child, ok := solveRanked(costMatrix, forced, forbidden); ok {
+				heap.Push(queue, child)
+			}
+			forced = append(append([][2]int(nil), forced...), [2]int{r, best.perm[r]})
+		}
+	}
+	return ranked
+}
+
+// return the optimal assignment of the cost matrix with the given pairs
+// forced and forbidden, and false if there is none.
+func solveRanked(costMatrix [][]float64, forced, forbidden [][2]int) (rankedAssignment, bool) {
+	m := make([][]float64, len(costMatrix))
+	for w := range m {
+		m[w] = append([]float64(nil), costMatrix[w]...)
+	}
+	for _, p := range forbidden {
+		m[p[0]][p[1]] = math.Inf(1)
+	}
+	for _, p := range forced {
+		for i := range m {
+			if i != p[1] {
+				m[p[0]][i] = math.Inf(1)
+			}
+			if i != p[0] {
+				m[i][p[1]] = math.Inf(1)
+			}
+		}
+	}
+	h, err := NewHungarianAlgorithm(m, allowForbidden())
+	if err != nil {
+		return rankedAssignment{}, false
+	}
+	perm, err := h.execute()
+	if err != nil {
+		return rankedAssignment{}, false
+	}
+	return rankedAssignment{
+		forced:    forced,
+		forbidden: forbidden,
+		perm:      perm,
+		cost:      Assignment(perm).Cost(costMatrix),
+	}, true
+}
+
+func isForced(forced [][2]int, w int) bool {
+	for _, p := range forced {
+		if p[0] == w {
+			return true
+		}
+	}
+	return false
+}
+
+// A priority queue of subproblems by cost, for use with container/heap.
+type assignmentQueue []rankedAssignment
+
+func (q assignmentQueue) Len() int            { return len(q) }
+func (q assignmentQueue) Less(i, j int) bool  { return q[i].cost < q[j].cost }
+func (q assignmentQueue) Swap(i, j int)       { q[i], q[j] = q[j], q[i] }
+func (q *assignmentQueue) Push(x interface{}) { *q = append(*q, x.(rankedAssignment)) }
+func (q *assignmentQueue) Pop() interface{} {
+	old := *q
+	x := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return x
+}
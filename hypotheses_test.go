@@ -0,0 +1,120 @@
+package munkres_test
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/charles-haynes/munkres"
+)
+
+// return the costs of every hypothesis, in increasing order, by brute
+// force.
+func bruteHypotheses(c [][]float64) []float64 {
+	measurements := 0
+	if len(c) > 0 {
+		measurements = len(c[0])
+	}
+	used := make([]bool, measurements)
+	var costs []float64
+	var visit func(t int, cost float64)
+	visit = func(t int, cost float64) {
+		if t == len(c) {
+			costs = append(costs, cost)
+			return
+		}
+		visit(t+1, cost)
+		for m := range used {
+			if !used[m] && !math.IsInf(c[t][m], 1) {
+				used[m] = true
+				visit(t+1, cost+c[t][m])
+				used[m] = false
+			}
+		}
+	}
+	visit(0, 0)
+	sort.Float64s(costs)
+	return costs
+}
+
+func TestRankHypotheses(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 30; i++ {
+		tracks, measurements := 1+rng.Intn(3), rng.Intn(4)
+		c := randomMatrix(rng, tracks, measurements)
+		for t := range c {
+			for m := range c[t] {
+				// Negative log likelihood ratios, some gated out.
+				c[t][m] -= 5
+				if rng.Intn(4) == 0 {
+					c[t][m] = math.Inf(1)
+				}
+			}
+		}
+		want := bruteHypotheses(c)
+		k := 1 + rng.Intn(len(want)+2)
+		hs, err := munkres.RankHypotheses(c, k)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if k > len(want) {
+			k = len(want)
+		}
+		if len(hs) != k {
+			t.Fatalf("want %d hypotheses got %d", k, len(hs))
+		}
+		seen := map[string]bool{}
+		probability := 0.0
+		for r, h := range hs {
+			if h.Cost != want[r] {
+				t.Errorf("want hypothesis %d to cost %f got %f", r, want[r], h.Cost)
+			}
+			if cost := h.Assignment.Cost(c); cost != h.Cost {
+				t.Errorf("want cost = %f got %f", cost, h.Cost)
+			}
+			key := fmt.Sprint(h.Assignment)
+			if seen[key] {
+				t.Errorf("hypothesis %v repeated", h.Assignment)
+			}
+			seen[key] = true
+			probability += h.Probability
+			if len(h.UnassignedTracks)+measurements-len(h.UnassignedMeasurements) != tracks {
+				t.Errorf("unassigned sets %v and %v inconsistent with %v",
+					h.UnassignedTracks, h.UnassignedMeasurements, h.Assignment)
+			}
+		}
+		if math.Abs(probability-1) > 1e-9 {
+			t.Errorf("want probabilities summing to 1 got %f", probability)
+		}
+	}
+}
+
+func TestRankHypothesesUnassigned(t *testing.T) {
+	inf := math.Inf(1)
+	c := [][]float64{
+		{-3, inf},
+		{inf, 1},
+	}
+	hs, err := munkres.RankHypotheses(c, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	best := hs[0]
+	if want := (munkres.Assignment{0, -1}); !reflect.DeepEqual(best.Assignment, want) {
+		t.Errorf("want %v got %v", want, best.Assignment)
+	}
+	if !reflect.DeepEqual(best.UnassignedTracks, []int{1}) ||
+		!reflect.DeepEqual(best.UnassignedMeasurements, []int{1}) {
+		t.Errorf("want track 1 and measurement 1 unassigned got %v and %v",
+			best.UnassignedTracks, best.UnassignedMeasurements)
+	}
+	if best.Likelihood != math.Exp(3) {
+		t.Errorf("want likelihood = %f got %f", math.Exp(3), best.Likelihood)
+	}
+	if _, err := munkres.RankHypotheses(c, -1); err != munkres.ErrorNegativeQuantity {
+		t.Errorf("want err = %s got %v", munkres.ErrorNegativeQuantity, err)
+	}
+}
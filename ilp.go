@@ -0,0 +1,360 @@
+package munkres
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+)
+
+// The amount by which a solution of an ILPModel may violate a constraint
+// or bound, or differ from an integer, to allow for rounding by the
+// solver.
+const ilpTolerance = 1e-6
+
+// An ILPSense is the relation of an ILPConstraint's terms to its RHS.
+type ILPSense int
+
+const (
+	// LessEqual constrains the sum of the terms to at most the RHS.
+	LessEqual ILPSense = iota
+	// Equal constrains the sum of the terms to equal the RHS.
+	Equal
+	// GreaterEqual constrains the sum of the terms to at least the RHS.
+	GreaterEqual
+)
+
+// An ILPVariable is a non-negative integer variable of an ILPModel.
+type ILPVariable struct {
+	Name string
+	// Cost is the coefficient of the variable in the objective.
+	Cost float64
+	// Upper is the upper bound of the variable, which may be +Inf.
+	Upper float64
+}
+
+// An ILPTerm is a variable of an ILPModel, by its index, and its
+// coefficient in a constraint.
+type ILPTerm struct {
+	Variable    int
+	Coefficient float64
+}
+
+// An ILPConstraint is a linear constraint of an ILPModel.
+type ILPConstraint struct {
+	Name  string
+	Terms []ILPTerm
+	Sense ILPSense
+	RHS   float64
+}
+
+// An ILPModel is an integer linear program formulating an assignment
+// problem, or one of its constrained variants which this package solves
+// only approximately or not at all, so that it can be solved exactly by a
+// mixed integer programming solver, through a MIPSolver or by writing it
+// out with WriteLP. The objective, the sum of each variable times its
+// cost, is minimized. There is one variable for each pair of a worker w
+// and a job j, at index w*cols + j, giving the units of work w does on j.
+type ILPModel struct {
+	Variables   []ILPVariable
+	Constraints []ILPConstraint
+	rows, cols  int
+	// Whether each worker does at most one job, so that the model may be
+	// further constrained by AddWorkerGroups and AddCoverage.
+	assignment bool
+	// The index of the constraint on the number of workers assigned.
+	cardinality int
+}
+
+// A MIPSolver solves an ILPModel exactly, such as by calling bindings for
+// CBC, HiGHS or Gurobi. Solve returns the value of each variable in an
+// optimal solution, or an error, which should be ErrorInfeasible if the
+// model has no solution.
+type MIPSolver interface {
+	Solve(model *ILPModel) ([]float64, error)
+}
+
+// NewAssignmentModel formulates the assignment problem for the cost matrix
+// as an ILPModel, in which, as for Execute, each worker does at most one
+// job, each job is done by at most one worker, and as many workers are
+// assigned as there are workers or jobs, whichever is fewer. Forbidden
+// (+Inf) costs are allowed, and fix the variables of their pairs at 0.
+//
+// costMatrix is otherwise subject to the same restrictions as for
+// NewHungarianAlgorithm.
+func NewAssignmentModel(costMatrix [][]float64) (*ILPModel, error) {
+	m, err := newILPModel(costMatrix, 1)
+	if err != nil {
+		return nil, err
+	}
+	m.assignment = true
+	for w := 0; w < m.rows; w++ {
+		m.addConstraint(fmt.Sprintf("worker_%d", w), m.workerTerms(w), LessEqual, 1)
+	}
+	for j := 0; j < m.cols; j++ {
+		m.addConstraint(fmt.Sprintf("job_%d", j), m.jobTerms(j), LessEqual, 1)
+	}
+	assigned := m.rows
+	if m.cols < assigned {
+		assigned = m.cols
+	}
+	m.cardinality = len(m.Constraints)
+	m.addConstraint("assigned", m.terms(func(w, j int) float64 { return 1 }),
+		Equal, float64(assigned))
+	return m, nil
+}
+
+// NewCapacitatedModel formulates the allocation problem of
+// SolveCapacitated as an ILPModel, in which worker i supplies at most
+// capacity[i] units of work and job j receives exactly demand[j] units.
+// costMatrix is subject to the same restrictions as for
+// NewAssignmentModel.
+func NewCapacitatedModel(costMatrix [][]float64, capacity, demand []int) (*ILPModel, error) {
+	m, err := newILPModel(costMatrix, math.Inf(1))
+	if err != nil {
+		return nil, err
+	}
+	if len(capacity) != m.rows || len(demand) != m.cols {
+		return nil, ErrorDimensionMismatch
+	}
+	for _, quantities := range [][]int{capacity, demand} {
+		for _, q := range quantities {
+			if q < 0 {
+				return nil, ErrorNegativeQuantity
+			}
+		}
+	}
+	for w := 0; w < m.rows; w++ {
+		m.addConstraint(fmt.Sprintf("capacity_%d", w), m.workerTerms(w),
+			LessEqual, float64(capacity[w]))
+	}
+	for j := 0; j < m.cols; j++ {
+		m.addConstraint(fmt.Sprintf("demand_%d", j), m.jobTerms(j),
+			Equal, float64(demand[j]))
+	}
+	return m, nil
+}
+
+// return a model with a variable of the given upper bound for each pair
+// of a worker and a job of the cost matrix, and no constraints.
+func newILPModel(costMatrix [][]float64, upper float64) (*ILPModel, error) {
+	if err := checkCostMatrix(costMatrix, true); err != nil {
+		return nil, err
+	}
+	m := &ILPModel{rows: len(costMatrix), cardinality: -1}
+	if m.rows > 0 {
+		m.cols = len(costMatrix[0])
+	}
+	m.Variables = make([]ILPVariable, 0, m.rows*m.cols)
+	for w, row := range costMatrix {
+		for j, c := range row {
+			v := ILPVariable{Name: fmt.Sprintf("x_%d_%d", w, j), Cost: c, Upper: upper}
+			if math.IsInf(c, 1) {
+				v.Cost, v.Upper = 0, 0
+			}
+			m.Variables = append(m.Variables, v)
+		}
+	}
+	return m, nil
+}
+
+// return the terms of the pairs for which coefficient is not zero.
+func (m *ILPModel) terms(coefficient func(w, j int) float64) []ILPTerm {
+	var terms []ILPTerm
+	for w := 0; w < m.rows; w++ {
+		for j := 0; j < m.cols; j++ {
+			if c := coefficient(w, j); c != 0 {
+				terms = append(terms, ILPTerm{w*m.cols + j, c})
+			}
+		}
+	}
+	return terms
+}
+
+// return the terms of the pairs of worker w.
+func (m *ILPModel) workerTerms(w int) []ILPTerm {
+	return m.terms(func(x, j int) float64 { return boolToFloat(x == w) })
+}
+
+// return the terms of the pairs of job j.
+func (m *ILPModel) jobTerms(j int) []ILPTerm {
+	return m.terms(func(w, k int) float64 { return boolToFloat(k == j) })
+}
+
+func boolToFloat(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+func (m *ILPModel) addConstraint(name string, terms []ILPTerm, sense ILPSense, rhs float64) {
+	m.Constraints = append(m.Constraints, ILPConstraint{name, terms, sense, rhs})
+}
+
+// AddWorkerGroups constrains the model so that at most one worker of each
+// group is assigned, as WithWorkerGroups does, with as many workers
+// assigned as the groups allow. groupOf[w] is the group of worker w, or -1
+// if the worker belongs to no group. It returns ErrorInvalidOption for a
+// model not made by NewAssignmentModel.
+func (m *ILPModel) AddWorkerGroups(groupOf []int) error {
+	if !m.assignment {
+		return ErrorInvalidOption
+	}
+	if len(groupOf) != m.rows {
+		return ErrorDimensionMismatch
+	}
+	groups := map[int]bool{}
+	assignable := 0
+	for _, g := range groupOf {
+		if g < 0 {
+			assignable++
+			continue
+		}
+		if !groups[g] {
+			groups[g] = true
+			assignable++
+			m.addConstraint(fmt.Sprintf("group_%d", g),
+				m.terms(func(x, j int) float64 { return boolToFloat(groupOf[x] == g) }),
+				LessEqual, 1)
+		}
+	}
+	c := &m.Constraints[m.cardinality]
+	c.RHS = math.Min(c.RHS, float64(assignable))
+	return nil
+}
+
+// AddCoverage constrains the model so that at least minimum[c] jobs of
+// each class c are assigned, as SolveCoverage does. classOf[j] is the
+// class of job j, or -1 if the job belongs to no class. It returns
+// ErrorInvalidOption for a model not made by NewAssignmentModel.
+func (m *ILPModel) AddCoverage(classOf, minimum []int) error {
+	if !m.assignment {
+		return ErrorInvalidOption
+	}
+	if len(classOf) != m.cols {
+		return ErrorDimensionMismatch
+	}
+	for k, n := range minimum {
+		if n < 0 {
+			return ErrorNegativeQuantity
+		}
+		m.addConstraint(fmt.Sprintf("coverage_%d", k),
+			m.terms(func(w, j int) float64 { return boolToFloat(classOf[j] == k) }),
+			GreaterEqual, float64(n))
+	}
+	return nil
+}
+
+// AddSideConstraint constrains the model so that the sum of the
+// constraint's coefficients times the variables of their pairs does not
+// exceed its bound, as for SolveLagrangian.
+func (m *ILPModel) AddSideConstraint(c SideConstraint) error {
+	if len(c.Coefficients) != m.rows {
+		return ErrorDimensionMismatch
+	}
+	for _, row := range c.Coefficients {
+		if len(row) != m.cols {
+			return ErrorDimensionMismatch
+		}
+	}
+	m.addConstraint(fmt.Sprintf("side_%d", len(m.Constraints)),
+		m.terms(func(w, j int) float64 { return c.Coefficients[w][j] }),
+		LessEqual, c.Bound)
+	return nil
+}
+
+// WriteLP writes the model in the CPLEX LP format, which CBC, HiGHS,
+// Gurobi and most other solvers read.
+func (m *ILPModel) WriteLP(w io.Writer) error {
+	b := bufio.NewWriter(w)
+	objective := make([]ILPTerm, len(m.Variables))
+	for i, v := range m.Variables {
+		objective[i] = ILPTerm{i, v.Cost}
+	}
+	fmt.Fprint(b, "Minimize\n obj:")
+	m.writeTerms(b, objective)
+	fmt.Fprint(b, "\nSubject To\n")
+	for _, c := range m.Constraints {
+		fmt.Fprintf(b, " %s:", c.Name)
+		m.writeTerms(b, c.Terms)
+		fmt.Fprintf(b, " %s %s\n", [...]string{"<=", "=", ">="}[c.Sense], formatLP(c.RHS))
+	}
+	fmt.Fprint(b, "Bounds\n")
+	for _, v := range m.Variables {
+		if !math.IsInf(v.Upper, 1) {
+			fmt.Fprintf(b, " 0 <= %s <= %s\n", v.Name, formatLP(v.Upper))
+		}
+	}
+	fmt.Fprint(b, "General\n")
+	for _, v := range m.Variables {
+		fmt.Fprintf(b, " %s\n", v.Name)
+	}
+	fmt.Fprint(b, "End\n")
+	return b.Flush()
+}
+
+// Write terms as " 2 x - 3 y", or " 0" if there are none.
+func (m *ILPModel) writeTerms(b *bufio.Writer, terms []ILPTerm) {
+	if len(terms) == 0 {
+		fmt.Fprint(b, " 0")
+	}
+	for i, t := range terms {
+		c := t.Coefficient
+		switch {
+		case c < 0:
+			fmt.Fprint(b, " - ")
+			c = -c
+		case i > 0:
+			fmt.Fprint(b, " + ")
+		default:
+			fmt.Fprint(b, " ")
+		}
+		fmt.Fprintf(b, "%s %s", formatLP(c), m.Variables[t.Variable].Name)
+	}
+}
+
+func formatLP(x float64) string {
+	return strconv.FormatFloat(x, 'g', -1, 64)
+}
+
+// Solve solves the model with the solver, checks that the solution
+// satisfies the model, and returns it as a matrix holding the value of the
+// variable of each pair, such as 1 for a pair which is assigned.
+//
+// return the error of the solver, or ErrorInvalidSolution if the solution
+// does not satisfy the model.
+func (m *ILPModel) Solve(solver MIPSolver) ([][]int, error) {
+	values, err := solver.Solve(m)
+	if err != nil {
+		return nil, err
+	}
+	if len(values) != len(m.Variables) {
+		return nil, ErrorInvalidSolution
+	}
+	for i, x := range values {
+		if math.Abs(x-math.Round(x)) > ilpTolerance ||
+			x < -ilpTolerance || x > m.Variables[i].Upper+ilpTolerance {
+			return nil, ErrorInvalidSolution
+		}
+	}
+	for _, c := range m.Constraints {
+		total := 0.0
+		for _, t := range c.Terms {
+			total += t.Coefficient * values[t.Variable]
+		}
+		if c.Sense != GreaterEqual && total > c.RHS+ilpTolerance ||
+			c.Sense != LessEqual && total < c.RHS-ilpTolerance {
+			return nil, ErrorInvalidSolution
+		}
+	}
+	solution := make([][]int, m.rows)
+	for w := range solution {
+		solution[w] = make([]int, m.cols)
+		for j := range solution[w] {
+			solution[w][j] = int(math.Round(values[w*m.cols+j]))
+		}
+	}
+	return solution, nil
+}
@@ -0,0 +1,214 @@
+package munkres_test
+
+import (
+	"bytes"
+	"errors"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/charles-haynes/munkres"
+)
+
+// A MIPSolver which enumerates every solution with each variable at most
+// limit.
+type bruteMIP struct {
+	limit int
+}
+
+func (s bruteMIP) Solve(m *munkres.ILPModel) ([]float64, error) {
+	values := make([]float64, len(m.Variables))
+	var best []float64
+	bestCost := math.Inf(1)
+	var visit func(i int, cost float64)
+	visit = func(i int, cost float64) {
+		if i == len(values) {
+			for _, c := range m.Constraints {
+				total := 0.0
+				for _, t := range c.Terms {
+					total += t.Coefficient * values[t.Variable]
+				}
+				if c.Sense != munkres.GreaterEqual && total > c.RHS ||
+					c.Sense != munkres.LessEqual && total < c.RHS {
+					return
+				}
+			}
+			if cost < bestCost {
+				best, bestCost = append([]float64(nil), values...), cost
+			}
+			return
+		}
+		for x := 0; x <= s.limit && float64(x) <= m.Variables[i].Upper; x++ {
+			values[i] = float64(x)
+			visit(i+1, cost+float64(x)*m.Variables[i].Cost)
+		}
+		values[i] = 0
+	}
+	visit(0, 0)
+	if best == nil {
+		return nil, munkres.ErrorInfeasible
+	}
+	return best, nil
+}
+
+// return the cost of a solution for the cost matrix.
+func solutionCost(c [][]float64, solution [][]int) float64 {
+	total := 0.0
+	for w := range solution {
+		for j, x := range solution[w] {
+			if x != 0 {
+				total += float64(x) * c[w][j]
+			}
+		}
+	}
+	return total
+}
+
+func TestAssignmentModel(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 20; i++ {
+		c := randomMatrix(rng, 1+rng.Intn(3), 1+rng.Intn(3))
+		m, err := munkres.NewAssignmentModel(c)
+		if err != nil {
+			t.Fatal(err)
+		}
+		solution, err := m.Solve(bruteMIP{1})
+		if err != nil {
+			t.Fatalf("%v: %v", c, err)
+		}
+		want, _ := bruteForceCost(c, func(w, j int) bool { return true })
+		if got := solutionCost(c, solution); got != want {
+			t.Errorf("%v: want %f got %f", c, want, got)
+		}
+	}
+}
+
+func TestAssignmentModelGroups(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 20; i++ {
+		c := randomMatrix(rng, 1+rng.Intn(3), 1+rng.Intn(3))
+		groupOf := make([]int, len(c))
+		for w := range groupOf {
+			groupOf[w] = rng.Intn(3) - 1
+		}
+		m, _ := munkres.NewAssignmentModel(c)
+		if err := m.AddWorkerGroups(groupOf); err != nil {
+			t.Fatal(err)
+		}
+		solution, err := m.Solve(bruteMIP{1})
+		if err != nil {
+			t.Fatalf("%v %v: %v", c, groupOf, err)
+		}
+		if _, want := bruteForceGroups(c, groupOf); solutionCost(c, solution) != want {
+			t.Errorf("%v %v: want %f got %v", c, groupOf, want, solution)
+		}
+	}
+}
+
+func TestAssignmentModelCoverage(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 20; i++ {
+		c := randomMatrix(rng, 1+rng.Intn(3), 1+rng.Intn(3))
+		classOf := make([]int, len(c[0]))
+		for j := range classOf {
+			classOf[j] = rng.Intn(3) - 1
+		}
+		minimum := []int{rng.Intn(2), rng.Intn(2)}
+		m, _ := munkres.NewAssignmentModel(c)
+		if err := m.AddCoverage(classOf, minimum); err != nil {
+			t.Fatal(err)
+		}
+		solution, err := m.Solve(bruteMIP{1})
+		want, feasible := bruteForceCoverage(c, classOf, minimum)
+		if !feasible {
+			if !errors.Is(err, munkres.ErrorInfeasible) {
+				t.Errorf("%v %v %v: want %v got %v", c, classOf, minimum,
+					munkres.ErrorInfeasible, err)
+			}
+			continue
+		}
+		if err != nil || solutionCost(c, solution) != want {
+			t.Errorf("%v %v %v: want %f got %v, %v", c, classOf, minimum,
+				want, solution, err)
+		}
+	}
+}
+
+func TestCapacitatedModel(t *testing.T) {
+	c := [][]float64{{1, 4}, {2, 3}}
+	m, err := munkres.NewCapacitatedModel(c, []int{3, 2}, []int{2, 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	solution, err := m.Solve(bruteMIP{3})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, _ := munkres.SolveCapacitated(c, []int{3, 2}, []int{2, 2})
+	if got := solutionCost(c, solution); got != solutionCost(c, want) {
+		t.Errorf("want %v got %v", want, solution)
+	}
+	if err := m.AddCoverage([]int{0, 0}, []int{1}); err != munkres.ErrorInvalidOption {
+		t.Errorf("want %v got %v", munkres.ErrorInvalidOption, err)
+	}
+}
+
+func TestWriteLP(t *testing.T) {
+	m, _ := munkres.NewAssignmentModel([][]float64{{1, math.Inf(1)}, {-2.5, 0}})
+	m.AddSideConstraint(munkres.SideConstraint{
+		Coefficients: [][]float64{{1, 0}, {-1, 2}},
+		Bound:        1,
+	})
+	var b bytes.Buffer
+	if err := m.WriteLP(&b); err != nil {
+		t.Fatal(err)
+	}
+	want := `Minimize
+ obj: 1 x_0_0 + 0 x_0_1 - 2.5 x_1_0 + 0 x_1_1
+Subject To
+ worker_0: 1 x_0_0 + 1 x_0_1 <= 1
+ worker_1: 1 x_1_0 + 1 x_1_1 <= 1
+ job_0: 1 x_0_0 + 1 x_1_0 <= 1
+ job_1: 1 x_0_1 + 1 x_1_1 <= 1
+ assigned: 1 x_0_0 + 1 x_0_1 + 1 x_1_0 + 1 x_1_1 = 2
+ side_5: 1 x_0_0 - 1 x_1_0 + 2 x_1_1 <= 1
+Bounds
+ 0 <= x_0_0 <= 1
+ 0 <= x_0_1 <= 0
+ 0 <= x_1_0 <= 1
+ 0 <= x_1_1 <= 1
+General
+ x_0_0
+ x_0_1
+ x_1_0
+ x_1_1
+End
+`
+	if b.String() != want {
+		t.Errorf("want\n%s\ngot\n%s", want, b.String())
+	}
+}
+
+// A MIPSolver returning fixed values.
+type fixedMIP []float64
+
+func (s fixedMIP) Solve(m *munkres.ILPModel) ([]float64, error) {
+	return s, nil
+}
+
+func TestILPModelSolveErrors(t *testing.T) {
+	m, _ := munkres.NewAssignmentModel([][]float64{{1, 2}, {3, 4}})
+	for _, values := range [][]float64{
+		{1, 0, 0},
+		{1, 0, 0, 0},
+		{1, 0, 1, 0},
+		{0.5, 0.5, 0.5, 0.5},
+	} {
+		if _, err := m.Solve(fixedMIP(values)); err != munkres.ErrorInvalidSolution {
+			t.Errorf("%v: want %v got %v", values, munkres.ErrorInvalidSolution, err)
+		}
+	}
+	if got, err := m.Solve(fixedMIP{0, 1, 1, 0}); err != nil || got[0][1] != 1 || got[1][0] != 1 {
+		t.Errorf("want [[0 1] [1 0]] got %v, %v", got, err)
+	}
+}
@@ -0,0 +1,73 @@
+package munkres
+
+import "time"
+
+// Improve polishes an assignment, such as one from SolveGreedy or one
+// edited by hand, by local search within a time budget. Any workers it
+// leaves unassigned which could be are first given the cheapest jobs
+// left, then pairs of workers exchange their jobs, or a worker moves to
+// a job no worker has, whenever that lowers the total cost, until no
+// such exchange or move does or the budget is spent. The result is
+// therefore optimal among assignments differing from it in the jobs of
+// at most two workers, unless the budget ran out. The assignment given is
+// not modified.
+//
+// costMatrix is subject to the same restrictions as for
+// NewHungarianAlgorithm, and the assignment must be valid for it, as for
+// ComputeCost.
+//
+// return the improved assignment, which assigns as many workers as
+// Execute would, and its quality.
+func Improve(costMatrix [][]float64, assignment []int, budget time.Duration) (Assignment, Quality, error) {
+	deadline := time.Now().Add(budget)
+	if err := checkCostMatrix(costMatrix, false); err != nil {
+		return nil, Quality{}, err
+	}
+	rows, cols := len(costMatrix), 0
+	if rows > 0 {
+		cols = len(costMatrix[0])
+	}
+	a := append(Assignment(nil), assignment...)
+	if err := a.check(rows, cols); err != nil {
+		return nil, Quality{}, err
+	}
+	completeMatching(costMatrix, a)
+	cost := func(w, j int) float64 {
+		if j == -1 {
+			return 0
+		}
+		return costMatrix[w][j]
+	}
+	taken := make([]bool, cols)
+	for _, j := range a {
+		if j != -1 {
+			taken[j] = true
+		}
+	}
+	for improved := true; improved; {
+		improved = false
+		for w1 := 0; w1 < rows; w1++ {
+			if !time.Now().Before(deadline) {
+				return a, quality(costMatrix, a), nil
+			}
+			for w2 := w1 + 1; w2 < rows; w2++ {
+				j1, j2 := a[w1], a[w2]
+				if cost(w1, j2)+cost(w2, j1) < cost(w1, j1)+cost(w2, j2) {
+					a[w1], a[w2] = j2, j1
+					improved = true
+				}
+			}
+			if a[w1] == -1 {
+				continue
+			}
+			for j := range taken {
+				if !taken[j] && costMatrix[w1][j] < costMatrix[w1][a[w1]] {
+					taken[a[w1]], taken[j] = false, true
+					a[w1] = j
+					improved = true
+				}
+			}
+		}
+	}
+	return a, quality(costMatrix, a), nil
+}
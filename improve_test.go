@@ -0,0 +1,60 @@
+package munkres_test
+
+import (
+	"errors"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/charles-haynes/munkres"
+)
+
+func TestImprove(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 100; i++ {
+		c := randomMatrix(rng, 1+rng.Intn(8), 1+rng.Intn(8))
+		start, greedy, _ := munkres.SolveGreedy(c)
+		a, q, err := munkres.Improve(c, start, time.Second)
+		if err != nil {
+			t.Fatal(err)
+		}
+		checkApproximation(t, c, a, q)
+		if q.Cost > greedy.Cost {
+			t.Errorf("%v: cost %f worse than start %f", c, q.Cost, greedy.Cost)
+		}
+	}
+}
+
+func TestImproveExample(t *testing.T) {
+	c := [][]float64{{1, 2, 0}, {2, 10, 5}}
+	start := []int{0, -1}
+	a, q, err := munkres.Improve(c, start, time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Completed to [0 2] costing 6, then the workers exchange jobs.
+	if q.Cost != 2 || a[0] != 2 || a[1] != 0 {
+		t.Errorf("want [2 0] cost 2 got %v cost %f", a, q.Cost)
+	}
+	if start[1] != -1 {
+		t.Errorf("start modified to %v", start)
+	}
+}
+
+func TestImproveBudget(t *testing.T) {
+	c := [][]float64{{1, 2}, {2, 10}}
+	a, q, err := munkres.Improve(c, []int{0, 1}, 0)
+	if err != nil || q.Cost != 11 || a[0] != 0 || a[1] != 1 {
+		t.Errorf("want [0 1] cost 11 got %v cost %f, %v", a, q.Cost, err)
+	}
+}
+
+func TestImproveErrors(t *testing.T) {
+	c := [][]float64{{1, 2}, {2, 10}}
+	if _, _, err := munkres.Improve(c, []int{1, 1}, time.Second); !errors.Is(err, munkres.ErrorDuplicateJob) {
+		t.Errorf("want %v got %v", munkres.ErrorDuplicateJob, err)
+	}
+	if _, _, err := munkres.Improve(c, []int{1}, time.Second); !errors.Is(err, munkres.ErrorDimensionMismatch) {
+		t.Errorf("want %v got %v", munkres.ErrorDimensionMismatch, err)
+	}
+}
@@ -0,0 +1,242 @@
+package munkres
+
+import "math"
+
+// UpdateCost sets the cost of worker w doing job j, to take effect at the
+// next Resolve, which repairs the solution rather than solving afresh.
+//
+// return ErrorDimensionMismatch if the pair is not in the cost matrix,
+// the errors of checking the cost as for NewHungarianAlgorithm, or
+// ErrorInvalidOption with the options refused by Reoptimize.
+func (h *HungarianAlgorithm) UpdateCost(w, j int, cost float64) error {
+	if !h.reoptimizable() {
+		return ErrorInvalidOption
+	}
+	if w < 0 || w >= h.rows || j < 0 || j >= h.cols {
+		return ErrorDimensionMismatch
+	}
+	if err := checkCost(w, j, cost, h.opts.forbidden); err != nil {
+		return err
+	}
+	h.keepSolution()
+	h.setCost(w, j, cost)
+	return h.MarkDirty([]int{w}, []int{j})
+}
+
+// AddWorker adds a worker with the costs of row, numbered after the
+// existing workers, to be assigned at the next Resolve.
+//
+// return ErrorDimensionMismatch if row is not of a cost for each job, the
+// errors of checking it as for NewHungarianAlgorithm, or
+// ErrorInvalidOption with the options refused by Reoptimize.
+func (h *HungarianAlgorithm) AddWorker(row []float64) error {
+	if !h.reoptimizable() {
+		return ErrorInvalidOption
+	}
+	if len(row) != h.cols {
+		return ErrorDimensionMismatch
+	}
+	if err := checkRow(h.rows, row, h.opts.forbidden); err != nil {
+		return err
+	}
+	h.keepSolution()
+	if h.rows == h.dim {
+		h.resize(h.dim + 1)
+	}
+	w := h.rows
+	h.rows++
+	h.resultByWorker = append(h.resultByWorker[:w:w], -1)
+	if h.dirtyWorkers != nil {
+		h.dirtyWorkers = append(h.dirtyWorkers, false)
+	}
+	for j, c := range row {
+		h.setCost(w, j, c)
+	}
+	return h.MarkDirty([]int{w}, nil)
+}
+
+// RemoveJob removes job j, renumbering the jobs after it down by one, so
+// that the next Resolve assigns its worker elsewhere.
+//
+// return ErrorDimensionMismatch if j is not a job of the cost matrix, or
+// ErrorInvalidOption with the options refused by Reoptimize.
+func (h *HungarianAlgorithm) RemoveJob(j int) error {
+	if !h.reoptimizable() {
+		return ErrorInvalidOption
+	}
+	if j < 0 || j >= h.cols {
+		return ErrorDimensionMismatch
+	}
+	h.keepSolution()
+	// Rotate the column to the end of the padded matrix, where it becomes
+	// a padding column of zero costs.
+	last := h.dim - 1
+	for w := 0; w < h.dim; w++ {
+		row := h.row(w)
+		copy(row[j:], row[j+1:])
+		row[last] = -h.reductionByWorker[w]
+	}
+	copy(h.labelByJob[j:], h.labelByJob[j+1:])
+	copy(h.reductionByJob[j:], h.reductionByJob[j+1:])
+	copy(h.matchWorkerByJob[j:], h.matchWorkerByJob[j+1:])
+	h.reductionByJob[last] = 0
+	h.matchWorkerByJob[last] = -1
+	for w, k := range h.matchJobByWorker {
+		switch {
+		case k == j:
+			h.matchJobByWorker[w] = -1
+		case k > j:
+			h.matchJobByWorker[w] = k - 1
+		}
+	}
+	h.labelByJob[last] = h.feasibleJobLabel(last, h.dim)
+	h.cols--
+	if h.dirtyJobs != nil {
+		h.dirtyJobs = append(h.dirtyJobs[:j], h.dirtyJobs[j+1:]...)
+	}
+	if h.dim > h.rows && h.dim > h.cols {
+		// The last worker and job are both padding, and would otherwise
+		// let a worker go unassigned at no cost.
+		h.resize(h.dim - 1)
+	}
+	return nil
+}
+
+// Resolve repairs the solution after UpdateCost, AddWorker, RemoveJob or
+// MarkDirty, as Reoptimize does, keeping the labels and the pairs which
+// are still tight, so that a few changes take a few phases rather than a
+// full solve. If the problem has not been solved, or its solve failed,
+// it is solved in full.
+//
+// return the assignment as for Solve, or ErrorInvalidOption with the
+// options refused by Reoptimize.
+func (h *HungarianAlgorithm) Resolve() ([]int, error) {
+	if !h.reoptimizable() {
+		return nil, ErrorInvalidOption
+	}
+	if !h.solved() {
+		h.restart()
+		h.dirtyWorkers, h.dirtyJobs = nil, nil
+		return h.execute()
+	}
+	return h.resolve()
+}
+
+// Report whether the options allow the costs to be changed after a
+// solve: none which transform the costs as a whole.
+func (h *HungarianAlgorithm) reoptimizable() bool {
+	return h.opts.workerGroups == nil && h.opts.sinkhorn == (sinkhornSchedule{}) &&
+		!h.opts.rankTransform && h.opts.sanitize == nil && !h.opts.monotone
+}
+
+// Report whether the labels and matching are those of a completed or
+// interrupted solve, from which the solve may be repaired or continued.
+func (h *HungarianAlgorithm) solved() bool {
+	return h.interrupted || h.dim > 0 && h.fetchUnmatchedWorker() == h.dim
+}
+
+// Mark the labels and matching of a completed solve to be repaired and
+// continued by Resolve, rather than solved afresh, once a change leaves
+// workers unmatched.
+func (h *HungarianAlgorithm) keepSolution() {
+	if h.solved() {
+		h.interrupted = true
+	}
+}
+
+// Repair the solution after the costs of the dirty workers and jobs have
+// changed, and continue the solve from it.
+func (h *HungarianAlgorithm) resolve() ([]int, error) {
+	if h.dirtyWorkers != nil {
+		h.repair()
+		h.progress.setMatched(h.matched())
+		h.dirtyWorkers, h.dirtyJobs = nil, nil
+	}
+	h.interrupted = true
+	return h.execute()
+}
+
+// Set the cost of worker w doing job j, held reduced as the rest are.
+func (h *HungarianAlgorithm) setCost(w, j int, cost float64) {
+	if h.opts.maximize {
+		cost = -cost
+	}
+	h.costs[w*h.dim+j] = cost - h.reductionByWorker[w] - h.reductionByJob[j]
+}
+
+// Restore the costs as given from those held reduced, and forget the
+// labels and matching, so that the next solve starts afresh.
+func (h *HungarianAlgorithm) restart() {
+	for w := 0; w < h.dim; w++ {
+		row := h.row(w)
+		for j := range row {
+			row[j] += h.reductionByWorker[w] + h.reductionByJob[j]
+		}
+	}
+	for i := 0; i < h.dim; i++ {
+		h.labelByWorker[i], h.labelByJob[i] = 0, 0
+		h.reductionByWorker[i], h.reductionByJob[i] = 0, 0
+		h.matchJobByWorker[i], h.matchWorkerByJob[i] = -1, -1
+	}
+	h.interrupted = false
+}
+
+// Resize the padded square matrix to n x n, keeping the costs, labels and
+// matching of the workers and jobs of both sizes. The costs of the
+// workers and jobs added are zero, as for padding, and their labels the
+// greatest which are feasible; the pairs of those removed are unmatched.
+func (h *HungarianAlgorithm) resize(n int) {
+	r := newHungarianAlgorithm(n, n, h.opts)
+	r.rows, r.cols = h.rows, h.cols
+	r.resultByWorker = h.resultByWorker
+	r.progress = h.progress
+	r.progress.setTotal(n)
+	r.interrupted, r.epsilon, r.updates = h.interrupted, h.epsilon, h.updates
+	r.dirtyWorkers, r.dirtyJobs = h.dirtyWorkers, h.dirtyJobs
+	k := h.dim
+	if n < k {
+		k = n
+	}
+	for w := 0; w < k; w++ {
+		copy(r.row(w), h.row(w)[:k])
+		r.labelByWorker[w], r.labelByJob[w] = h.labelByWorker[w], h.labelByJob[w]
+		r.reductionByWorker[w], r.reductionByJob[w] = h.reductionByWorker[w], h.reductionByJob[w]
+		if j := h.matchJobByWorker[w]; j != -1 && j < k {
+			r.matchJobByWorker[w], r.matchWorkerByJob[j] = j, w
+		}
+	}
+	for w := 0; w < n; w++ {
+		for j := 0; j < n; j++ {
+			if w >= k || j >= k {
+				r.row(w)[j] = -r.reductionByWorker[w] - r.reductionByJob[j]
+			}
+		}
+	}
+	for j := k; j < n; j++ {
+		r.labelByJob[j] = r.feasibleJobLabel(j, k)
+	}
+	for w := k; w < n; w++ {
+		label := math.Inf(1)
+		for j, c := range r.row(w) {
+			label = math.Min(label, c-r.labelByJob[j])
+		}
+		if math.IsInf(label, 1) {
+			label = 0
+		}
+		r.labelByWorker[w] = label
+	}
+	*h = r
+}
+
+// return the greatest label of job j which is feasible with the labels of
+// the first k workers, or 0 if none constrain it.
+func (h *HungarianAlgorithm) feasibleJobLabel(j, k int) float64 {
+	label := math.Inf(1)
+	for w := 0; w < k; w++ {
+		label = math.Min(label, h.costs[w*h.dim+j]-h.labelByWorker[w])
+	}
+	if math.IsInf(label, 1) {
+		return 0
+	}
+	return label
+}
@@ -0,0 +1,118 @@
+package munkres_test
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/charles-haynes/munkres"
+)
+
+func TestResolve(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for _, opts := range [][]munkres.Option{nil, {munkres.WithIntegerCosts()}, {munkres.WithScipyTieOrder()}} {
+		for i := 0; i < 50; i++ {
+			rows, cols := 1+rng.Intn(6), 1+rng.Intn(6)
+			c := randomMatrix(rng, rows, cols)
+			h, _ := munkres.NewHungarianAlgorithm(c, opts...)
+			h.Execute()
+			for round := 0; round < 6; round++ {
+				switch op := rng.Intn(3); {
+				case op == 0:
+					w, j := rng.Intn(len(c)), rng.Intn(len(c[0]))
+					c[w][j] = math.Floor(rng.Float64() * 100)
+					if err := h.UpdateCost(w, j, c[w][j]); err != nil {
+						t.Fatal(err)
+					}
+				case op == 1 && len(c) < 7:
+					row := randomMatrix(rng, 1, len(c[0]))[0]
+					c = append(c, row)
+					if err := h.AddWorker(row); err != nil {
+						t.Fatal(err)
+					}
+				case len(c[0]) > 1:
+					j := rng.Intn(len(c[0]))
+					for w := range c {
+						c[w] = append(c[w][:j:j], c[w][j+1:]...)
+					}
+					if err := h.RemoveJob(j); err != nil {
+						t.Fatal(err)
+					}
+				}
+				a, err := h.Resolve()
+				if err != nil {
+					t.Fatal(err)
+				}
+				want, _ := bruteForceCost(c, func(w, j int) bool { return true })
+				assigned := assignable(len(c), len(c[0]))
+				if len(a) != len(c) || count(a) != assigned {
+					t.Fatalf("%v: got %v", c, a)
+				}
+				if got := munkres.Assignment(a).Cost(c); math.Abs(got-want) > 1e-9 {
+					t.Fatalf("%v: want cost %f got %v costing %f", c, want, a, got)
+				}
+			}
+		}
+	}
+}
+
+func TestResolvePhases(t *testing.T) {
+	rng := rand.New(rand.NewSource(2))
+	c := randomMatrix(rng, 100, 100)
+	h, _ := munkres.NewHungarianAlgorithm(c)
+	h.Execute()
+	before := h.Snapshot()
+	if err := h.AddWorker(randomMatrix(rng, 1, 100)[0]); err != nil {
+		t.Fatal(err)
+	}
+	if err := h.UpdateCost(3, 4, 0); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := h.Resolve(); err != nil {
+		t.Fatal(err)
+	}
+	// Only the new worker, and the worker of the changed cost if its
+	// pair is no longer tight, are augmented again.
+	if after := h.Snapshot(); after.Phases > before.Phases+2 || after.Matched != 101 || after.Total != 101 {
+		t.Errorf("want at most two more phases got %+v then %+v", before, after)
+	}
+}
+
+func TestResolveUnsolved(t *testing.T) {
+	h, _ := munkres.NewHungarianAlgorithm([][]float64{{1, 2}, {3, 4}})
+	if err := h.UpdateCost(0, 0, 9); err != nil {
+		t.Fatal(err)
+	}
+	if err := h.AddWorker([]float64{0, 9}); err != nil {
+		t.Fatal(err)
+	}
+	a, err := h.Resolve()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []int{1, -1, 0}; !reflect.DeepEqual(a, want) {
+		t.Errorf("got %v want %v", a, want)
+	}
+}
+
+func TestIncrementalErrors(t *testing.T) {
+	h, _ := munkres.NewHungarianAlgorithm([][]float64{{1, 2}, {3, 4}})
+	if err := h.UpdateCost(2, 0, 1); err != munkres.ErrorDimensionMismatch {
+		t.Errorf("UpdateCost out of range: got %v", err)
+	}
+	if err := h.UpdateCost(0, 0, math.NaN()); !errors.Is(err, munkres.ErrorNaNCost) {
+		t.Errorf("UpdateCost NaN: got %v", err)
+	}
+	if err := h.AddWorker([]float64{1}); err != munkres.ErrorDimensionMismatch {
+		t.Errorf("AddWorker short: got %v", err)
+	}
+	if err := h.RemoveJob(2); err != munkres.ErrorDimensionMismatch {
+		t.Errorf("RemoveJob out of range: got %v", err)
+	}
+	g, _ := munkres.NewHungarianAlgorithm([][]float64{{1}}, munkres.WithRankTransform())
+	if _, err := g.Resolve(); err != munkres.ErrorInvalidOption {
+		t.Errorf("Resolve WithRankTransform: got %v", err)
+	}
+}
@@ -0,0 +1,185 @@
+package munkres
+
+import (
+	"context"
+	"math"
+)
+
+// The largest magnitude of a cost for which Execute solves the problem in
+// integer arithmetic. The labels are bounded by the sum of the costs along
+// an augmenting path, so they cannot overflow an int64 for any matrix
+// which fits in memory.
+const integerCostLimit = 1 << 30
+
+// WithIntegerCosts makes Execute solve the problem in integer arithmetic
+// if every cost is an integer no greater in magnitude than 2^30, as ranks
+// and other ordinal costs are, which is noticeably faster than the default
+// float form. Otherwise, and WithBackups, WithSinkhornScaling or
+// WithDebugChecks, which need the float form, the option has no effect.
+//
+// The integer form may choose a different assignment than the float form
+// among those of equal cost.
+func WithIntegerCosts() Option {
+	return func(o *options) {
+		o.integerCosts = true
+	}
+}
+
+// return whether Execute should solve the problem by executeIntegers:
+// whether WithIntegerCosts is set, every cost is an integer no greater in
+// magnitude than integerCostLimit, and no option which needs the padded
+// square form of the algorithm is set.
+func (h *HungarianAlgorithm) integral() bool {
+	if !h.opts.integerCosts || h.opts.backups || h.opts.sinkhorn.iterations > 0 || h.opts.debugChecks || h.opts.forbidden ||
+		h.opts.subscriber != nil {
+		return false
+	}
+	if h.rows == 0 || h.cols == 0 || h.interrupted {
+		return false
+	}
+	for w := 0; w < h.rows; w++ {
+		row := h.row(w)
+		for _, c := range row[:h.cols] {
+			if c != math.Trunc(c) || math.Abs(c) > integerCostLimit {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// Execute the algorithm by the shortest augmenting path form of the
+// Hungarian algorithm, as augmentRows does, but with the labels and
+// slacks held as int64s, so that finding the minimum slack compares
+// integers rather than floats, and a zero slack is exact. The costs are
+// converted as they are read, so no copy of the matrix is made.
+//
+// If the solve is stopped, the labels and the partial matching are left
+// as the padded form of the algorithm would leave them, so that it
+// continues in that form.
+//
+// return the error from stopping if the solve is stopped, or
+// ErrorInconsistent if a phase finds no augmenting path.
+func (h *HungarianAlgorithm) executeIntegers(ctx context.Context) error {
+	// Job n stands for the worker being matched in the current phase.
+	n := h.dim
+	labelW := make([]int64, n)
+	labelJ := make([]int64, n+1)
+	workerByJob := make([]int, n+1)
+	parentJob := make([]int, n+1)
+	minSlack := make([]int64, n+1)
+	uncommitted := make([]int, 0, n)
+	committed := make([]int, 0, n+1)
+	// Leave the labels and matching in the state of the algorithm,
+	// whether the solve completes or not.
+	defer func() {
+		for w := range labelW {
+			h.labelByWorker[w] = float64(labelW[w])
+		}
+		for j := 0; j < n; j++ {
+			h.labelByJob[j] = float64(labelJ[j])
+			if w := workerByJob[j]; w != -1 {
+				h.match(w, j)
+			}
+		}
+	}()
+	h.progress.start(n)
+
+	// Start, as the float form does, from the labels of the row and
+	// column reductions and a greedy matching of zero slack pairs, so
+	// that only the workers left unmatched need a phase.
+	for w := range labelW {
+		labelW[w] = math.MaxInt64
+		for _, c := range h.row(w) {
+			if int64(c) < labelW[w] {
+				labelW[w] = int64(c)
+			}
+		}
+	}
+	for j := range labelJ {
+		labelJ[j] = math.MaxInt64
+		workerByJob[j] = -1
+	}
+	for w, label := range labelW {
+		row := h.row(w)[:n]
+		for j, c := range row {
+			if slack := int64(c) - label; slack < labelJ[j] {
+				labelJ[j] = slack
+			}
+		}
+	}
+	matched := make([]bool, n)
+	count := 0
+	for w, label := range labelW {
+		row := h.row(w)[:n]
+		for j, c := range row {
+			if workerByJob[j] == -1 && int64(c)-label-labelJ[j] == 0 {
+				workerByJob[j], matched[w] = w, true
+				count++
+				break
+			}
+		}
+	}
+	h.progress.setMatched(count)
+
+	for w := range matched {
+		if matched[w] {
+			continue
+		}
+		if err := h.stopping(ctx); err != nil {
+			h.interrupted = true
+			return err
+		}
+		start := h.beginPhase(w)
+		workerByJob[n] = w
+		uncommitted = uncommitted[:0]
+		for j := 0; j < n; j++ {
+			uncommitted = append(uncommitted, j)
+			minSlack[j] = math.MaxInt64
+		}
+		committed = committed[:0]
+		j0 := n
+		for {
+			committed = append(committed, j0)
+			w0 := workerByJob[j0]
+			row, label := h.row(w0)[:n], labelW[w0]
+			delta, i1 := int64(math.MaxInt64), -1
+			for i, j := range uncommitted {
+				if slack := int64(row[j]) - label - labelJ[j]; slack < minSlack[j] {
+					minSlack[j] = slack
+					parentJob[j] = j0
+				}
+				if minSlack[j] < delta {
+					delta, i1 = minSlack[j], i
+				}
+			}
+			if i1 == -1 {
+				h.endPhase(w, start)
+				return ErrorInconsistent
+			}
+			// Remove the job preserving order, so that ties are
+			// broken in favour of the lowest job.
+			j1 := uncommitted[i1]
+			uncommitted = append(uncommitted[:i1], uncommitted[i1+1:]...)
+			if delta > 0 {
+				h.labelUpdates++
+				h.updates.observe(float64(delta))
+				for _, j := range committed {
+					labelW[workerByJob[j]] += delta
+					labelJ[j] -= delta
+				}
+				for _, j := range uncommitted {
+					minSlack[j] -= delta
+				}
+			}
+			j0 = j1
+			if workerByJob[j0] == -1 {
+				break
+			}
+		}
+		augmentPath(workerByJob, parentJob, j0, n)
+		h.endPhase(w, start)
+		h.progress.phase()
+	}
+	return nil
+}
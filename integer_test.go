@@ -0,0 +1,86 @@
+package munkres_test
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+
+	"github.com/charles-haynes/munkres"
+)
+
+func TestWithIntegerCosts(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 100; i++ {
+		rows, cols := 1+rng.Intn(8), 1+rng.Intn(8)
+		c := randomMatrix(rng, rows, cols)
+		for w := range c {
+			for j := range c[w] {
+				c[w][j] -= 5
+			}
+		}
+		want, _ := bruteForceCost(c, func(w, j int) bool { return true })
+		h, err := munkres.NewHungarianAlgorithm(c, munkres.WithIntegerCosts())
+		if err != nil {
+			t.Fatal(err)
+		}
+		res, err := h.Solve()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, _ := munkres.ComputeCost(c, res); got != want {
+			t.Errorf("%v: want cost %v got %v", c, want, got)
+		}
+		// The labels are left as duals of the solution.
+		u, v := h.Duals()
+		for w := range c {
+			for j := range c[w] {
+				if c[w][j] < u[w]+v[j] || res[w] == j && c[w][j] != u[w]+v[j] {
+					t.Fatalf("%v: duals %v %v infeasible at (%d, %d)", c, u, v, w, j)
+				}
+			}
+		}
+	}
+}
+
+func TestWithIntegerCostsFractional(t *testing.T) {
+	// Costs which are not integers are solved in the float form.
+	c := [][]float64{{1.5, 1}, {1, 1.25}}
+	h, err := munkres.NewHungarianAlgorithm(c, munkres.WithIntegerCosts())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, _ := munkres.ComputeCost(c, h.Execute()); got != 2 {
+		t.Errorf("want cost 2 got %v", got)
+	}
+}
+
+func TestWithIntegerCostsResume(t *testing.T) {
+	c := benchmarkMatrix(40)
+	h, _ := munkres.NewHungarianAlgorithm(c)
+	want, _ := munkres.ComputeCost(c, h.Execute())
+
+	// A stopped integer solve continues in the float form.
+	h, _ = munkres.NewHungarianAlgorithm(c, munkres.WithIntegerCosts())
+	if _, err := h.ExecuteContext(&countdownContext{context.Background(), 3}); err != context.Canceled {
+		t.Fatalf("want err = %s got %v", context.Canceled, err)
+	}
+	if s := h.Snapshot(); s.Phases != 2 || s.Matched == s.Total {
+		t.Errorf("want the solve stopped after 2 phases got %+v", s)
+	}
+	resumed, _ := munkres.NewHungarianAlgorithm(c, munkres.WithIntegerCosts())
+	if err := resumed.Resume(h.ResumeToken()); err != nil {
+		t.Fatal(err)
+	}
+	if got, _ := munkres.ComputeCost(c, resumed.Execute()); got != want {
+		t.Errorf("want cost %v got %v", want, got)
+	}
+}
+
+func BenchmarkIntegerCosts(b *testing.B) {
+	c := benchmarkMatrix(benchmarkSize)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		h, _ := munkres.NewHungarianAlgorithm(c, munkres.WithIntegerCosts())
+		h.Execute()
+	}
+}
@@ -0,0 +1,192 @@
+package munkres
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"sync/atomic"
+)
+
+// ExecuteContext executes the algorithm as Execute does, but stops at the
+// end of the current phase if ctx is done or Stop is called, returning
+// ctx.Err() or ErrorStopped. The state of a stopped solve is kept, so a
+// later call continues where it stopped, and can be saved by ResumeToken
+// to continue in another instance, such as after a preemptible batch
+// worker is rescheduled.
+//
+// A solve which matches the smaller side of a very unbalanced matrix
+// directly stops between its phases too, and continues in the padded
+// square form. Solves WithWorkerGroups or WithScipyTieOrder have no
+// phases to stop between, so run to completion once started.
+func (h *HungarianAlgorithm) ExecuteContext(ctx context.Context) ([]int, error) {
+	return h.executeContext(ctx)
+}
+
+// Stop asks the running Execute or ExecuteContext to stop at the end of
+// its current phase, or the next one to stop before it starts. It may be
+// called from any goroutine.
+func (h *HungarianAlgorithm) Stop() {
+	if h.progress != nil {
+		atomic.StoreInt32(&h.progress.stop, 1)
+	}
+}
+
+// return the error from stopping if ctx is done or Stop has been called,
+// consuming the call to Stop.
+func (h *HungarianAlgorithm) stopping(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if h.progress != nil && atomic.CompareAndSwapInt32(&h.progress.stop, 1, 0) {
+		return ErrorStopped
+	}
+	return nil
+}
+
+// return the number of workers of the padded matrix currently matched.
+func (h *HungarianAlgorithm) matched() int {
+	matched := 0
+	for _, j := range h.matchJobByWorker {
+		if j != -1 {
+			matched++
+		}
+	}
+	return matched
+}
+
+// The state of an interrupted solve, as saved in a resume token.
+type resumeState struct {
+	reductionByWorker, reductionByJob []float64
+	scaleByWorker, scaleByJob         []float64
+	labelByWorker, labelByJob         []float64
+	matchJobByWorker                  []int64
+}
+
+// The first bytes of a resume token.
+const resumeMagic = "munkres1"
+
+// ResumeToken returns an opaque token capturing the state of a solve
+// stopped by ExecuteContext or Stop: the reductions of the cost matrix,
+// the labels and the matching. It returns nil if there is no stopped
+// solve.
+func (h *HungarianAlgorithm) ResumeToken() []byte {
+	if !h.interrupted {
+		return nil
+	}
+	s := resumeState{
+		reductionByWorker: h.reductionByWorker,
+		reductionByJob:    h.reductionByJob,
+		scaleByWorker:     h.scaleByWorker,
+		scaleByJob:        h.scaleByJob,
+		labelByWorker:     h.labelByWorker,
+		labelByJob:        h.labelByJob,
+		matchJobByWorker:  make([]int64, h.dim),
+	}
+	for w, j := range h.matchJobByWorker {
+		s.matchJobByWorker[w] = int64(j)
+	}
+	var b bytes.Buffer
+	b.WriteString(resumeMagic)
+	scaled := uint8(0)
+	if s.scaleByWorker != nil {
+		scaled = 1
+	}
+	binary.Write(&b, binary.LittleEndian, uint64(h.dim))
+	binary.Write(&b, binary.LittleEndian, scaled)
+	binary.Write(&b, binary.LittleEndian, uint64(atomic.LoadInt64(&h.progress.phases)))
+	for _, f := range s.floats() {
+		binary.Write(&b, binary.LittleEndian, *f)
+	}
+	binary.Write(&b, binary.LittleEndian, s.matchJobByWorker)
+	return b.Bytes()
+}
+
+// the float arrays of the state, in the order they are saved.
+func (s *resumeState) floats() []*[]float64 {
+	f := []*[]float64{&s.reductionByWorker, &s.reductionByJob}
+	if s.scaleByWorker != nil {
+		f = append(f, &s.scaleByWorker, &s.scaleByJob)
+	}
+	return append(f, &s.labelByWorker, &s.labelByJob)
+}
+
+// Resume restores the state captured by a resume token into an instance
+// constructed from the same cost matrix with the same options, which has
+// not yet been executed, so that ExecuteContext or Execute continues the
+// solve where it stopped.
+//
+// ErrorInvalidResumeToken is returned if the token is malformed or was
+// taken from a problem of a different size.
+func (h *HungarianAlgorithm) Resume(token []byte) error {
+	r := bytes.NewReader(token)
+	magic := make([]byte, len(resumeMagic))
+	var dim, phases uint64
+	var scaled uint8
+	if _, err := r.Read(magic); err != nil || string(magic) != resumeMagic {
+		return ErrorInvalidResumeToken
+	}
+	if binary.Read(r, binary.LittleEndian, &dim) != nil ||
+		binary.Read(r, binary.LittleEndian, &scaled) != nil ||
+		binary.Read(r, binary.LittleEndian, &phases) != nil ||
+		dim != uint64(h.dim) || scaled > 1 {
+		return ErrorInvalidResumeToken
+	}
+	s := resumeState{
+		reductionByWorker: make([]float64, dim),
+		reductionByJob:    make([]float64, dim),
+		labelByWorker:     make([]float64, dim),
+		labelByJob:        make([]float64, dim),
+		matchJobByWorker:  make([]int64, dim),
+	}
+	if scaled == 1 {
+		s.scaleByWorker, s.scaleByJob = make([]float64, dim), make([]float64, dim)
+	}
+	for _, f := range s.floats() {
+		if binary.Read(r, binary.LittleEndian, *f) != nil {
+			return ErrorInvalidResumeToken
+		}
+	}
+	if binary.Read(r, binary.LittleEndian, s.matchJobByWorker) != nil || r.Len() != 0 {
+		return ErrorInvalidResumeToken
+	}
+	used := make([]bool, dim)
+	for _, j := range s.matchJobByWorker {
+		if j < -1 || j >= int64(dim) || j != -1 && used[j] {
+			return ErrorInvalidResumeToken
+		}
+		if j != -1 {
+			used[j] = true
+		}
+	}
+
+	// Repeat the scaling and reduction exactly as they were first
+	// applied, so that the matrix is identical.
+	for w := 0; w < h.dim; w++ {
+		for j := 0; j < h.dim; j++ {
+			if s.scaleByWorker != nil {
+				h.costs[w*h.dim+j] -= s.scaleByWorker[w] + s.scaleByJob[j]
+			}
+			h.costs[w*h.dim+j] -= s.reductionByWorker[w]
+			h.costs[w*h.dim+j] -= s.reductionByJob[j]
+		}
+	}
+	h.scaleByWorker, h.scaleByJob = s.scaleByWorker, s.scaleByJob
+	copy(h.reductionByWorker, s.reductionByWorker)
+	copy(h.reductionByJob, s.reductionByJob)
+	copy(h.labelByWorker, s.labelByWorker)
+	copy(h.labelByJob, s.labelByJob)
+	for w := range h.matchWorkerByJob {
+		h.matchWorkerByJob[w] = -1
+	}
+	for w, j := range s.matchJobByWorker {
+		h.matchJobByWorker[w] = int(j)
+		if j != -1 {
+			h.matchWorkerByJob[j] = w
+		}
+	}
+	h.progress.start(h.dim)
+	h.progress.setMatched(h.matched())
+	atomic.StoreInt64(&h.progress.phases, int64(phases))
+	h.interrupted = true
+	return nil
+}
@@ -0,0 +1,161 @@
+package munkres_test
+
+import (
+	"context"
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/charles-haynes/munkres"
+	"github.com/charles-haynes/munkres/generate"
+)
+
+// A context which is cancelled once Err has been called a given number of
+// times, so that a solve is interrupted at a deterministic point.
+type countdownContext struct {
+	context.Context
+	calls int
+}
+
+func (c *countdownContext) Err() error {
+	if c.calls--; c.calls < 0 {
+		return context.Canceled
+	}
+	return nil
+}
+
+func TestExecuteContextResume(t *testing.T) {
+	for _, opts := range [][]munkres.Option{
+		nil,
+		{munkres.WithSinkhornScaling(10, 0)},
+	} {
+		c := generate.Uniform(rand.New(rand.NewSource(1)), 60, 60, 1000)
+		h, err := munkres.NewHungarianAlgorithm(c, opts...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := h.Execute()
+
+		h, _ = munkres.NewHungarianAlgorithm(c, opts...)
+		ctx := &countdownContext{context.Background(), 5}
+		if _, err := h.ExecuteContext(ctx); err != context.Canceled {
+			t.Fatalf("want err = %s got %v", context.Canceled, err)
+		}
+		if s := h.Snapshot(); s.Matched == s.Total {
+			t.Fatalf("want the solve interrupted part way got %+v", s)
+		}
+		token := h.ResumeToken()
+		if token == nil {
+			t.Fatal("want a resume token")
+		}
+
+		// Resume in a fresh instance...
+		resumed, _ := munkres.NewHungarianAlgorithm(c, opts...)
+		if err := resumed.Resume(token); err != nil {
+			t.Fatal(err)
+		}
+		if s := resumed.Snapshot(); s != h.Snapshot() {
+			t.Errorf("want progress %+v restored got %+v", h.Snapshot(), s)
+		}
+		if got := resumed.Execute(); !reflect.DeepEqual(got, want) {
+			t.Errorf("want %v got %v", want, got)
+		}
+		// ...or continue in the interrupted one.
+		got, err := h.ExecuteContext(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("want %v got %v", want, got)
+		}
+		if h.ResumeToken() != nil {
+			t.Errorf("want no resume token once complete")
+		}
+	}
+}
+
+func TestExecuteContextRectangular(t *testing.T) {
+	for _, size := range [][2]int{{10, 60}, {60, 10}} {
+		c := generate.Uniform(rand.New(rand.NewSource(1)), size[0], size[1], 1000)
+		h, _ := munkres.NewHungarianAlgorithm(c)
+		want := munkres.Assignment(h.Execute()).Cost(c)
+
+		h, _ = munkres.NewHungarianAlgorithm(c)
+		if _, err := h.ExecuteContext(&countdownContext{context.Background(), 5}); err != context.Canceled {
+			t.Fatalf("%v: want err = %s got %v", size, context.Canceled, err)
+		}
+		if s := h.Snapshot(); s.Phases != 5 || s.Matched == s.Total {
+			t.Fatalf("%v: want the solve interrupted after 5 phases got %+v", size, s)
+		}
+		resumed, _ := munkres.NewHungarianAlgorithm(c)
+		if err := resumed.Resume(h.ResumeToken()); err != nil {
+			t.Fatal(err)
+		}
+		if got := munkres.Assignment(resumed.Execute()).Cost(c); got != want {
+			t.Errorf("%v: want resumed cost %v got %v", size, want, got)
+		}
+		got, err := h.ExecuteContext(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if cost := munkres.Assignment(got).Cost(c); cost != want {
+			t.Errorf("%v: want cost %v got %v", size, want, cost)
+		}
+
+		// Stop from the progress callback, as a caller would.
+		var stopped munkres.HungarianAlgorithm
+		stopped, _ = munkres.NewHungarianAlgorithm(c, munkres.WithProgress(func(s munkres.Snapshot) {
+			if s.Phases == 3 {
+				stopped.Stop()
+			}
+		}))
+		if _, err := stopped.ExecuteContext(context.Background()); err != munkres.ErrorStopped {
+			t.Fatalf("%v: want err = %s got %v", size, munkres.ErrorStopped, err)
+		}
+		if s := stopped.Snapshot(); s.Phases != 3 {
+			t.Errorf("%v: want the solve stopped after 3 phases got %+v", size, s)
+		}
+		if got := munkres.Assignment(stopped.Execute()).Cost(c); got != want {
+			t.Errorf("%v: want cost %v got %v", size, want, got)
+		}
+	}
+}
+
+func TestStop(t *testing.T) {
+	c := generate.Uniform(rand.New(rand.NewSource(1)), 20, 20, 1000)
+	h, err := munkres.NewHungarianAlgorithm(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := h.Execute()
+	h, _ = munkres.NewHungarianAlgorithm(c)
+	h.Stop()
+	if got := h.Execute(); got != nil {
+		t.Errorf("want nil when stopped got %v", got)
+	}
+	if _, err := h.ExecuteContext(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if got := h.Execute(); !reflect.DeepEqual(got, want) {
+		t.Errorf("want %v got %v", want, got)
+	}
+	h.Stop()
+	if _, err := h.ExecuteContext(context.Background()); err != munkres.ErrorStopped {
+		t.Errorf("want err = %s got %v", munkres.ErrorStopped, err)
+	}
+}
+
+func TestResumeInvalid(t *testing.T) {
+	c := generate.Uniform(rand.New(rand.NewSource(1)), 20, 20, 1000)
+	h, _ := munkres.NewHungarianAlgorithm(c)
+	if _, err := h.ExecuteContext(&countdownContext{context.Background(), 2}); err != context.Canceled {
+		t.Fatalf("want err = %s got %v", context.Canceled, err)
+	}
+	token := h.ResumeToken()
+	other, _ := munkres.NewHungarianAlgorithm(generate.Uniform(rand.New(rand.NewSource(1)), 10, 10, 1000))
+	for _, bad := range [][]byte{nil, []byte("garbage"), token[:len(token)-1], token} {
+		if err := other.Resume(bad); err != munkres.ErrorInvalidResumeToken {
+			t.Errorf("want err = %s got %v", munkres.ErrorInvalidResumeToken, err)
+		}
+	}
+}
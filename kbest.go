@@ -0,0 +1,73 @@
+package munkres
+
+import "math"
+
+// KBest ranks the k best assignments of the problem, in increasing order
+// of cost, or decreasing order of profit WithMaximize, by Murty's
+// algorithm over solutions of the Hungarian algorithm. Each assigns as
+// many workers as Execute does, avoiding the pairs forbidden or rejected
+// WithRejection, and no two assign the workers to the same jobs, so
+// fewer than k are returned only if there are fewer than k in all. The
+// results have no duals, which prove only the best optimal.
+//
+// Each assignment takes up to m solves, where m is the lesser of the
+// numbers of workers and jobs, so the assignments take time O(k m n^3)
+// in all, where n is the greater. The problem itself is not solved.
+//
+// return ErrorNegativeQuantity if k is negative, ErrorInfeasible if k is
+// positive and there is no assignment, or ErrorInvalidOption with the
+// options refused by Reoptimize, which transform the costs as a whole.
+func (h *HungarianAlgorithm) KBest(k int) ([]Result, error) {
+	if k < 0 {
+		return nil, ErrorNegativeQuantity
+	}
+	if !h.reoptimizable() {
+		return nil, ErrorInvalidOption
+	}
+	// Rank the assignments of the padded square matrix, transposed if
+	// there are more workers than jobs, branching only on the rows of
+	// the lesser side, whose pairs determine the assignment.
+	transposed := h.rows > h.cols
+	branchRows := h.rows
+	if transposed {
+		branchRows = h.cols
+	}
+	m := make([][]float64, h.dim)
+	for i := range m {
+		m[i] = make([]float64, h.dim)
+	}
+	for w := 0; w < h.rows; w++ {
+		for j := 0; j < h.cols; j++ {
+			c := h.originalCost(w, j)
+			if h.opts.reject != nil && h.opts.reject(w, j) {
+				c = math.Inf(1)
+			}
+			if transposed {
+				m[j][w] = c
+			} else {
+				m[w][j] = c
+			}
+		}
+	}
+	ranked := rankAssignments(m, branchRows, k)
+	if k > 0 && len(ranked) == 0 {
+		return nil, ErrorInfeasible
+	}
+	results := make([]Result, len(ranked))
+	for i, r := range ranked {
+		a := Assignment(unassigned(h.rows))
+		for row, col := range r.perm[:branchRows] {
+			switch {
+			case transposed && col < h.rows:
+				a[col] = row
+			case !transposed && col < h.cols:
+				a[row] = col
+			}
+		}
+		if h.opts.maximize {
+			r.cost = -r.cost
+		}
+		results[i] = newResult(a, h.cols, r.cost)
+	}
+	return results, nil
+}
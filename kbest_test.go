@@ -0,0 +1,113 @@
+package munkres_test
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/charles-haynes/munkres"
+)
+
+// return the costs of every assignment of as many workers as possible,
+// in increasing order, by brute force.
+func bruteAssignments(c [][]float64) []float64 {
+	cols := len(c[0])
+	assigned := assignable(len(c), cols)
+	used := make([]bool, cols)
+	var costs []float64
+	var visit func(w, count int, cost float64)
+	visit = func(w, count int, cost float64) {
+		if w == len(c) {
+			if count == assigned {
+				costs = append(costs, cost)
+			}
+			return
+		}
+		visit(w+1, count, cost)
+		for j := range used {
+			if !used[j] && !math.IsInf(c[w][j], 1) {
+				used[j] = true
+				visit(w+1, count+1, cost+c[w][j])
+				used[j] = false
+			}
+		}
+	}
+	visit(0, 0, 0)
+	sort.Float64s(costs)
+	return costs
+}
+
+func TestKBest(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 50; i++ {
+		rows, cols := 1+rng.Intn(4), 1+rng.Intn(4)
+		c := randomMatrix(rng, rows, cols)
+		if i%2 == 1 {
+			c[rng.Intn(rows)][rng.Intn(cols)] = munkres.Forbidden
+		}
+		want := bruteAssignments(c)
+		k := 1 + rng.Intn(len(want)+2)
+		h, _ := munkres.NewHungarianAlgorithm(c, munkres.WithForbidden())
+		if i%3 == 0 {
+			// The ranking is of the original costs, even once solved.
+			h.Execute()
+		}
+		results, err := h.KBest(k)
+		if len(want) == 0 {
+			if err != munkres.ErrorInfeasible {
+				t.Fatalf("%v: want ErrorInfeasible got %v", c, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		if k > len(want) {
+			k = len(want)
+		}
+		if len(results) != k {
+			t.Fatalf("%v: want %d assignments got %d", c, k, len(results))
+		}
+		seen := map[string]bool{}
+		for r, result := range results {
+			if math.Abs(result.Cost-want[r]) > 1e-9 {
+				t.Errorf("%v: want assignment %d to cost %f got %f", c, r, want[r], result.Cost)
+			}
+			if cost := result.JobByWorker.Cost(c); math.Abs(cost-result.Cost) > 1e-9 {
+				t.Errorf("want cost %f got %f", cost, result.Cost)
+			}
+			if n := count(result.JobByWorker); n != assignable(rows, cols) {
+				t.Errorf("%v assigns %d workers", result.JobByWorker, n)
+			}
+			key := fmt.Sprint(result.JobByWorker)
+			if seen[key] {
+				t.Errorf("assignment %v repeated", result.JobByWorker)
+			}
+			seen[key] = true
+		}
+	}
+}
+
+func TestKBestMaximize(t *testing.T) {
+	c := [][]float64{{1, 2}, {3, 5}}
+	h, _ := munkres.NewHungarianAlgorithm(c, munkres.WithMaximize())
+	results, err := h.KBest(3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 2 || results[0].Cost != 6 || results[1].Cost != 5 {
+		t.Errorf("want profits 6 then 5 got %+v", results)
+	}
+}
+
+func TestKBestErrors(t *testing.T) {
+	h, _ := munkres.NewHungarianAlgorithm([][]float64{{1}})
+	if _, err := h.KBest(-1); err != munkres.ErrorNegativeQuantity {
+		t.Errorf("want ErrorNegativeQuantity got %v", err)
+	}
+	if results, err := h.KBest(0); err != nil || len(results) != 0 {
+		t.Errorf("want no assignments got %v %v", results, err)
+	}
+}
@@ -0,0 +1,107 @@
+package munkres
+
+import (
+	"container/heap"
+	"sort"
+)
+
+// A k-d tree over a set of points, for finding the nearest neighbours of a
+// query point. The tree is stored implicitly: the median point of each
+// subtree by its splitting axis is at the middle of the subtree's range of
+// indices, with the lower and upper halves of the range holding its
+// children.
+type kdTree struct {
+	points [][]float64
+	index  []int
+}
+
+func newKDTree(points [][]float64) *kdTree {
+	t := &kdTree{points: points, index: make([]int, len(points))}
+	for i := range t.index {
+		t.index[i] = i
+	}
+	t.build(0, len(t.index), 0)
+	return t
+}
+
+// Arrange index[lo:hi] so that it forms a subtree split along axis.
+func (t *kdTree) build(lo, hi, axis int) {
+	if hi-lo <= 1 {
+		return
+	}
+	sub := t.index[lo:hi]
+	sort.Slice(sub, func(a, b int) bool {
+		return t.points[sub[a]][axis] < t.points[sub[b]][axis]
+	})
+	mid := (lo + hi) / 2
+	next := (axis + 1) % len(t.points[t.index[mid]])
+	t.build(lo, mid, next)
+	t.build(mid+1, hi, next)
+}
+
+// return the indices of the k points nearest to q, nearest first.
+func (t *kdTree) nearest(q []float64, k int) []int {
+	if k > len(t.points) {
+		k = len(t.points)
+	}
+	if k <= 0 {
+		return nil
+	}
+	found := &farthestQueue{}
+	t.search(q, k, 0, len(t.index), 0, found)
+	result := make([]int, found.Len())
+	for i := len(result) - 1; i >= 0; i-- {
+		result[i] = heap.Pop(found).(nodeDistance).node
+	}
+	return result
+}
+
+// Search the subtree index[lo:hi], split along axis, for points nearer to
+// q than the farthest of the k nearest found so far.
+func (t *kdTree) search(q []float64, k, lo, hi, axis int, found *farthestQueue) {
+	if lo >= hi {
+		return
+	}
+	mid := (lo + hi) / 2
+	p := t.index[mid]
+	if d := squaredDistance(q, t.points[p]); found.Len() < k {
+		heap.Push(found, nodeDistance{node: p, dist: d})
+	} else if d < (*found)[0].dist {
+		(*found)[0] = nodeDistance{node: p, dist: d}
+		heap.Fix(found, 0)
+	}
+	next := (axis + 1) % len(q)
+	diff := q[axis] - t.points[p][axis]
+	near, far := [2]int{lo, mid}, [2]int{mid + 1, hi}
+	if diff > 0 {
+		near, far = far, near
+	}
+	t.search(q, k, near[0], near[1], next, found)
+	if found.Len() < k || diff*diff < (*found)[0].dist {
+		t.search(q, k, far[0], far[1], next, found)
+	}
+}
+
+// return the squared Euclidean distance between p and q.
+func squaredDistance(p, q []float64) float64 {
+	d := 0.0
+	for i := range p {
+		d += (p[i] - q[i]) * (p[i] - q[i])
+	}
+	return d
+}
+
+// A priority queue of nodes with the farthest first, for use with
+// container/heap.
+type farthestQueue []nodeDistance
+
+func (q farthestQueue) Len() int            { return len(q) }
+func (q farthestQueue) Less(i, j int) bool  { return q[i].dist > q[j].dist }
+func (q farthestQueue) Swap(i, j int)       { q[i], q[j] = q[j], q[i] }
+func (q *farthestQueue) Push(x interface{}) { *q = append(*q, x.(nodeDistance)) }
+func (q *farthestQueue) Pop() interface{} {
+	old := *q
+	x := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return x
+}
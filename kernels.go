@@ -0,0 +1,171 @@
+package munkres
+
+import "math"
+
+// The kernels below are the inner loops of the reduction and slack
+// initialization. They are unrolled, and reslice their arguments to a
+// common length up front so that the compiler can eliminate the bounds
+// checks in the loop bodies.
+//
+// They are written in Go rather than assembly: the package has no
+// dependency with which to detect CPU features at run time, and subConst
+// already compiles to a tight loop. Instead the minimum slack scan of
+// executePhase chooses at run time between minIndexMasked, which reads
+// the slack of every job contiguously, and gathering the slack through
+// the list of uncommitted jobs, which BenchmarkMinSlackScan measures
+// faster for small matrices and once an eighth of the jobs are
+// committed.
+
+// The least dimension for which executePhase scans the slack of every job
+// by minIndexMasked.
+const maskedScanDim = 512
+
+// return the minimum of s, or +Inf if s is empty.
+func minOf(s []float64) float64 {
+	m0, m1, m2, m3 := math.Inf(1), math.Inf(1), math.Inf(1), math.Inf(1)
+	i := 0
+	for ; i+4 <= len(s); i += 4 {
+		x := s[i : i+4 : i+4]
+		if x[0] < m0 {
+			m0 = x[0]
+		}
+		if x[1] < m1 {
+			m1 = x[1]
+		}
+		if x[2] < m2 {
+			m2 = x[2]
+		}
+		if x[3] < m3 {
+			m3 = x[3]
+		}
+	}
+	for ; i < len(s); i++ {
+		if s[i] < m0 {
+			m0 = s[i]
+		}
+	}
+	if m1 < m0 {
+		m0 = m1
+	}
+	if m3 < m2 {
+		m2 = m3
+	}
+	if m2 < m0 {
+		m0 = m2
+	}
+	return m0
+}
+
+// return the minimum of the s[i] with mask[i] == -1 and the least such i
+// holding it, or +Inf and -1 if there are none.
+func minIndexMasked(s []float64, mask []int) (float64, int) {
+	mask = mask[:len(s)]
+	m0, m1, m2, m3 := math.Inf(1), math.Inf(1), math.Inf(1), math.Inf(1)
+	i0, i1, i2, i3 := -1, -1, -1, -1
+	i := 0
+	for ; i+4 <= len(s); i += 4 {
+		x, k := s[i:i+4:i+4], mask[i:i+4:i+4]
+		if x[0] < m0 && k[0] == -1 {
+			m0, i0 = x[0], i
+		}
+		if x[1] < m1 && k[1] == -1 {
+			m1, i1 = x[1], i+1
+		}
+		if x[2] < m2 && k[2] == -1 {
+			m2, i2 = x[2], i+2
+		}
+		if x[3] < m3 && k[3] == -1 {
+			m3, i3 = x[3], i+3
+		}
+	}
+	for ; i < len(s); i++ {
+		if s[i] < m0 && mask[i] == -1 {
+			m0, i0 = s[i], i
+		}
+	}
+	// Each lane holds the first index of its minimum, so of equal
+	// minima the lesser index is the first.
+	if m1 < m0 || m1 == m0 && uint(i1) < uint(i0) {
+		m0, i0 = m1, i1
+	}
+	if m3 < m2 || m3 == m2 && uint(i3) < uint(i2) {
+		m2, i2 = m3, i3
+	}
+	if m2 < m0 || m2 == m0 && uint(i2) < uint(i0) {
+		m0, i0 = m2, i2
+	}
+	return m0, i0
+}
+
+// set dst[i] to the lesser of dst[i] and s[i].
+func minInto(dst, s []float64) {
+	s = s[:len(dst)]
+	i := 0
+	for ; i+4 <= len(dst); i += 4 {
+		d, x := dst[i:i+4:i+4], s[i:i+4:i+4]
+		if x[0] < d[0] {
+			d[0] = x[0]
+		}
+		if x[1] < d[1] {
+			d[1] = x[1]
+		}
+		if x[2] < d[2] {
+			d[2] = x[2]
+		}
+		if x[3] < d[3] {
+			d[3] = x[3]
+		}
+	}
+	for ; i < len(dst); i++ {
+		if s[i] < dst[i] {
+			dst[i] = s[i]
+		}
+	}
+}
+
+// subtract c from each element of dst.
+func subConst(dst []float64, c float64) {
+	i := 0
+	for ; i+4 <= len(dst); i += 4 {
+		d := dst[i : i+4 : i+4]
+		d[0] -= c
+		d[1] -= c
+		d[2] -= c
+		d[3] -= c
+	}
+	for ; i < len(dst); i++ {
+		dst[i] -= c
+	}
+}
+
+// subtract s[i] from each dst[i].
+func subInto(dst, s []float64) {
+	s = s[:len(dst)]
+	i := 0
+	for ; i+4 <= len(dst); i += 4 {
+		d, x := dst[i:i+4:i+4], s[i:i+4:i+4]
+		d[0] -= x[0]
+		d[1] -= x[1]
+		d[2] -= x[2]
+		d[3] -= x[3]
+	}
+	for ; i < len(dst); i++ {
+		dst[i] -= s[i]
+	}
+}
+
+// set dst[i] to a[i] - c - b[i].
+func slackInto(dst, a []float64, c float64, b []float64) {
+	a, b = a[:len(dst)], b[:len(dst)]
+	i := 0
+	for ; i+4 <= len(dst); i += 4 {
+		d, x, y := dst[i:i+4:i+4], a[i:i+4:i+4], b[i:i+4:i+4]
+		d[0] = x[0] - c - y[0]
+		d[1] = x[1] - c - y[1]
+		d[2] = x[2] - c - y[2]
+		d[3] = x[3] - c - y[3]
+	}
+	for ; i < len(dst); i++ {
+		dst[i] = a[i] - c - b[i]
+	}
+}
@@ -0,0 +1,143 @@
+package munkres_test
+
+import (
+	"math"
+	"math/rand"
+	"strconv"
+	"testing"
+
+	"github.com/charles-haynes/munkres"
+)
+
+// The reduction kernels are unrolled by four, so check every remainder
+// with real-valued costs of either sign.
+func TestReductionKernels(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	allowed := func(w, j int) bool { return true }
+	for rows := 1; rows <= 7; rows++ {
+		for cols := 1; cols <= 7; cols++ {
+			c := make([][]float64, rows)
+			for w := range c {
+				c[w] = make([]float64, cols)
+				for j := range c[w] {
+					c[w][j] = rng.Float64()*200 - 100
+				}
+			}
+			h, err := munkres.NewHungarianAlgorithm(c)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := munkres.ComputeCost(c, h.Execute())
+			if err != nil {
+				t.Fatal(err)
+			}
+			want, _ := bruteForceCost(c, allowed)
+			if math.Abs(got-want) > 1e-9 {
+				t.Errorf("%v: want cost = %f got %f", c, want, got)
+			}
+		}
+	}
+}
+
+// A matrix large enough that executePhase scans the slack of every job
+// by MinIndexMasked solves to the optimum found by another algorithm.
+func TestMaskedScan(t *testing.T) {
+	rng := rand.New(rand.NewSource(3))
+	n := 600
+	c, ints := make([][]float64, n), make([][]int, n)
+	for w := range c {
+		c[w], ints[w] = make([]float64, n), make([]int, n)
+		for j := range c[w] {
+			ints[w][j] = rng.Intn(1000)
+			c[w][j] = float64(ints[w][j])
+		}
+	}
+	h, _ := munkres.NewHungarianAlgorithm(c)
+	got, _ := munkres.ComputeCost(c, h.Execute())
+	_, want, err := munkres.SolveInts(ints)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != float64(want) {
+		t.Errorf("want cost = %d got %f", want, got)
+	}
+}
+
+func TestMinIndexMasked(t *testing.T) {
+	rng := rand.New(rand.NewSource(2))
+	for i := 0; i < 1000; i++ {
+		n := rng.Intn(10)
+		s, mask := make([]float64, n), make([]int, n)
+		for j := range s {
+			// Few values, so that ties across lanes are common,
+			// and some infinite, as the slack of forbidden jobs.
+			s[j], mask[j] = float64(rng.Intn(3)), rng.Intn(2)-1
+			if rng.Intn(10) == 0 {
+				s[j] = math.Inf(1)
+			}
+		}
+		want, wantIndex := math.Inf(1), -1
+		for j := range s {
+			if mask[j] == -1 && s[j] < want {
+				want, wantIndex = s[j], j
+			}
+		}
+		got, index := munkres.MinIndexMasked(s, mask)
+		if got != want || index != wantIndex {
+			t.Fatalf("%v %v: want %f at %d got %f at %d", s, mask, want, wantIndex, got, index)
+		}
+	}
+}
+
+// The minimum slack scan of executePhase over the list of uncommitted
+// jobs.
+func scanList(slack []float64, jobs []int) (float64, int) {
+	min, index := math.Inf(1), -1
+	for i, j := range jobs {
+		if v := slack[j]; v < min {
+			min, index = v, i
+		}
+	}
+	return min, index
+}
+
+var scanned float64
+
+// The two forms of the minimum slack scan between which executePhase
+// chooses: with every job uncommitted, as early in a phase, they are
+// about as fast, but as the phase commits jobs the masked scan still
+// reads every job, so it falls behind.
+func BenchmarkMinSlackScan(b *testing.B) {
+	rng := rand.New(rand.NewSource(1))
+	for _, n := range []int{100, 1000, 10000} {
+		for _, uncommitted := range []int{100, 90, 50, 10} {
+			slack := make([]float64, n)
+			parent := make([]int, n)
+			var jobs []int
+			for j := range slack {
+				slack[j] = rng.Float64()
+				if rng.Intn(100) < uncommitted {
+					parent[j] = -1
+					jobs = append(jobs, j)
+				}
+			}
+			v, i := scanList(slack, jobs)
+			if m, j := munkres.MinIndexMasked(slack, parent); m != v || j != jobs[i] {
+				b.Fatalf("masked scan found %f at job %d, want %f at job %d", m, j, v, jobs[i])
+			}
+			name := strconv.Itoa(n) + "/" + strconv.Itoa(uncommitted) + "%"
+			b.Run("list/"+name, func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					v, _ := scanList(slack, jobs)
+					scanned += v
+				}
+			})
+			b.Run("masked/"+name, func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					v, _ := munkres.MinIndexMasked(slack, parent)
+					scanned += v
+				}
+			})
+		}
+	}
+}
@@ -0,0 +1,152 @@
+package munkres
+
+import "math"
+
+// A SideConstraint is a linear constraint on the assignment variables of
+// an assignment problem, which is satisfied by an assignment if the sum of
+// Coefficients[i][j] over the assigned pairs (i, j) does not exceed Bound.
+// A lower bound can be expressed by negating the coefficients and bound.
+type SideConstraint struct {
+	Coefficients [][]float64
+	Bound        float64
+}
+
+// return the amount by which the assignment violates the constraint, which
+// is negative if it is satisfied with room to spare.
+func (c *SideConstraint) violation(assignment []int) float64 {
+	total := -c.Bound
+	for w, j := range assignment {
+		if j != -1 {
+			total += c.Coefficients[w][j]
+		}
+	}
+	return total
+}
+
+// A LagrangianResult is the outcome of SolveLagrangian.
+type LagrangianResult struct {
+	// Assignment is the best assignment found which satisfies every side
+	// constraint, or nil if none was found.
+	Assignment []int
+	// Cost is the cost of Assignment, or +Inf if it is nil.
+	Cost float64
+	// LowerBound is the best lower bound found on the cost of any
+	// assignment which satisfies every side constraint.
+	LowerBound float64
+	// Gap is Cost - LowerBound, which is zero if Assignment is known to
+	// be optimal.
+	Gap float64
+	// Multipliers are the Lagrange multipliers of the side constraints
+	// which gave LowerBound.
+	Multipliers []float64
+}
+
+// SolveLagrangian approximately solves an assignment problem with
+// additional side constraints, which break the structure that the
+// Hungarian algorithm relies on, by Lagrangian relaxation. The side
+// constraints are moved into the cost matrix, weighted by non-negative
+// multipliers, and the relaxed problem is solved by the Hungarian
+// algorithm for up to the given number of iterations, with the
+// multipliers updated by subgradient steps in between.
+//
+// Each relaxed solution gives a lower bound on the constrained optimum,
+// and those relaxed solutions which happen to satisfy the side constraints
+// are candidate assignments. The best of each, and the gap between them,
+// are returned.
+func SolveLagrangian(costMatrix [][]float64, constraints []SideConstraint, iterations int) (LagrangianResult, error) {
+	if err := checkCostMatrix(costMatrix, false); err != nil {
+		return LagrangianResult{}, err
+	}
+	for _, c := range constraints {
+		if len(c.Coefficients) != len(costMatrix) {
+			return LagrangianResult{}, ErrorDimensionMismatch
+		}
+		if err := checkCostMatrix(c.Coefficients, false); err != nil {
+			return LagrangianResult{}, err
+		}
+		if len(costMatrix) > 0 && len(c.Coefficients[0]) != len(costMatrix[0]) {
+			return LagrangianResult{}, ErrorDimensionMismatch
+		}
+	}
+
+	result := LagrangianResult{
+		Cost:        math.Inf(1),
+		LowerBound:  math.Inf(-1),
+		Multipliers: make([]float64, len(constraints)),
+	}
+	multipliers := make([]float64, len(constraints))
+	subgradient := make([]float64, len(constraints))
+	relaxed := make([][]float64, len(costMatrix))
+	for w := range relaxed {
+		relaxed[w] = make([]float64, len(costMatrix[w]))
+	}
+	// The step size is scaled by theta, which is halved whenever the
+	// bound fails to improve for a few iterations.
+	theta, stalled := 2.0, 0
+	for k := 0; k < iterations; k++ {
+		bound := 0.0
+		for w := range costMatrix {
+			for j, c := range costMatrix[w] {
+				relaxed[w][j] = c
+				for i := range constraints {
+					relaxed[w][j] += multipliers[i] *
+						constraints[i].Coefficients[w][j]
+				}
+			}
+		}
+		for i := range constraints {
+			bound -= multipliers[i] * constraints[i].Bound
+		}
+		h, err := NewHungarianAlgorithm(relaxed)
+		if err != nil {
+			return LagrangianResult{}, err
+		}
+		assignment := h.Execute()
+		cost := 0.0
+		feasible := true
+		norm := 0.0
+		for w, j := range assignment {
+			if j != -1 {
+				bound += relaxed[w][j]
+				cost += costMatrix[w][j]
+			}
+		}
+		for i := range constraints {
+			subgradient[i] = constraints[i].violation(assignment)
+			if subgradient[i] > 0 {
+				feasible = false
+			}
+			if subgradient[i] > 0 || multipliers[i] > 0 {
+				norm += subgradient[i] * subgradient[i]
+			}
+		}
+		if bound > result.LowerBound {
+			result.LowerBound = bound
+			copy(result.Multipliers, multipliers)
+			stalled = 0
+		} else if stalled++; stalled >= 5 {
+			theta /= 2
+			stalled = 0
+		}
+		if feasible && cost < result.Cost {
+			result.Assignment, result.Cost = assignment, cost
+		}
+		if norm == 0 || result.Cost-result.LowerBound <= 0 {
+			// The relaxed solution is optimal for the
+			// constrained problem.
+			break
+		}
+		// Aim the step at the best assignment found so far, or at a
+		// guess if none has been found.
+		target := result.Cost
+		if math.IsInf(target, 1) {
+			target = bound + math.Abs(bound) + 1
+		}
+		step := theta * (target - bound) / norm
+		for i := range multipliers {
+			multipliers[i] = math.Max(0, multipliers[i]+step*subgradient[i])
+		}
+	}
+	result.Gap = result.Cost - result.LowerBound
+	return result, nil
+}
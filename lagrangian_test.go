@@ -0,0 +1,91 @@
+package munkres_test
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/charles-haynes/munkres"
+)
+
+func TestSolveLagrangian(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 50; i++ {
+		n := 2 + rng.Intn(4)
+		c := randomMatrix(rng, n, n)
+		// A budget on a second resource consumed by each pair.
+		constraint := munkres.SideConstraint{
+			Coefficients: randomMatrix(rng, n, n),
+			Bound:        float64(3 * n),
+		}
+		// Brute force the constrained optimum.
+		best := math.Inf(1)
+		perm := make([]int, n)
+		for k := range perm {
+			perm[k] = k
+		}
+		var visit func(k int)
+		visit = func(k int) {
+			if k == n {
+				used, cost := 0.0, 0.0
+				for w, j := range perm {
+					used += constraint.Coefficients[w][j]
+					cost += c[w][j]
+				}
+				if used <= constraint.Bound && cost < best {
+					best = cost
+				}
+				return
+			}
+			for l := k; l < n; l++ {
+				perm[k], perm[l] = perm[l], perm[k]
+				visit(k + 1)
+				perm[k], perm[l] = perm[l], perm[k]
+			}
+		}
+		visit(0)
+
+		res, err := munkres.SolveLagrangian(c,
+			[]munkres.SideConstraint{constraint}, 100)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if res.LowerBound > best+1e-9 {
+			t.Errorf("%v: lower bound %f exceeds optimum %f",
+				c, res.LowerBound, best)
+		}
+		if res.Assignment == nil {
+			if !math.IsInf(res.Cost, 1) {
+				t.Errorf("%v: want infinite cost got %f", c, res.Cost)
+			}
+			continue
+		}
+		cost, _ := munkres.ComputeCost(c, res.Assignment)
+		used := 0.0
+		for w, j := range res.Assignment {
+			used += constraint.Coefficients[w][j]
+		}
+		if used > constraint.Bound {
+			t.Errorf("%v: assignment %v violates constraint",
+				c, res.Assignment)
+		}
+		if cost != res.Cost || cost < best-1e-9 {
+			t.Errorf("%v: optimum %f, reported cost %f, actual cost %f",
+				c, best, res.Cost, cost)
+		}
+		if math.Abs(res.Gap-(res.Cost-res.LowerBound)) > 1e-9 {
+			t.Errorf("%v: wrong gap %f", c, res.Gap)
+		}
+	}
+}
+
+func TestSolveLagrangianUnconstrained(t *testing.T) {
+	c := [][]float64{{4, 1.5, 4}, {4, 4.5, 6}, {3, 2.25, 3}}
+	res, err := munkres.SolveLagrangian(c, nil, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Gap != 0 || res.Cost != 8.5 {
+		t.Errorf("want cost 8.5 with no gap got %+v", res)
+	}
+}
@@ -0,0 +1,45 @@
+package munkres
+
+import "fmt"
+
+// A DimensionLimitError is returned when a cost matrix is too large for
+// the limit set by WithMaxDimension.
+type DimensionLimitError struct {
+	// Dim is the dimension of the padded square matrix, the greater of
+	// the numbers of rows and columns, or for a matrix still being read,
+	// the dimension reached so far.
+	Dim int
+	// Limit is the greatest dimension allowed.
+	Limit int
+}
+
+func (e *DimensionLimitError) Error() string {
+	return fmt.Sprintf("Padded dimension %d exceeds the limit of %d", e.Dim, e.Limit)
+}
+
+// WithMaxDimension makes the constructors reject a cost matrix whose
+// padded square dimension, the greater of its numbers of rows and
+// columns, exceeds limit, returning a *DimensionLimitError before
+// allocating the padded matrix, rather than attempting an allocation
+// which could exhaust the memory of the process. Services which embed the
+// solver can use it for admission control, along with EstimateMemory.
+// NewFromReader stops reading as soon as the limit is exceeded. A limit of
+// 0, the default, sets no limit, and a negative limit is invalid.
+func WithMaxDimension(limit int) Option {
+	return func(o *options) {
+		o.maxDimension = limit
+	}
+}
+
+// Check the dimensions of a cost matrix against the limit set by
+// WithMaxDimension, if any.
+func (o *options) checkDimension(rows, cols int) error {
+	dim := rows
+	if cols > dim {
+		dim = cols
+	}
+	if o.maxDimension > 0 && dim > o.maxDimension {
+		return &DimensionLimitError{Dim: dim, Limit: o.maxDimension}
+	}
+	return nil
+}
@@ -0,0 +1,41 @@
+package munkres_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/charles-haynes/munkres"
+)
+
+func TestWithMaxDimension(t *testing.T) {
+	c := [][]float64{{1, 2, 3}, {4, 5, 6}}
+	if _, err := munkres.NewHungarianAlgorithm(c, munkres.WithMaxDimension(3)); err != nil {
+		t.Errorf("want no error within the limit got %v", err)
+	}
+	_, err := munkres.NewHungarianAlgorithm(c, munkres.WithMaxDimension(2))
+	var limit *munkres.DimensionLimitError
+	if !errors.As(err, &limit) || limit.Dim != 3 || limit.Limit != 2 {
+		t.Fatalf("want a DimensionLimitError for 3 over 2 got %v", err)
+	}
+	if want := "Padded dimension 3 exceeds the limit of 2"; err.Error() != want {
+		t.Errorf("want %q got %q", want, err.Error())
+	}
+	if _, err := munkres.NewHungarianAlgorithm(c, munkres.WithMaxDimension(-1)); err != munkres.ErrorInvalidOption {
+		t.Errorf("want err = %s got %v", munkres.ErrorInvalidOption, err)
+	}
+}
+
+func TestWithMaxDimensionReader(t *testing.T) {
+	// The limit is hit on the third row, before the rest is read.
+	input := "1,2\n3,4\n5,6\nnot,numbers\n"
+	_, err := munkres.NewFromReader(strings.NewReader(input), munkres.FormatCSV, munkres.WithMaxDimension(2))
+	var limit *munkres.DimensionLimitError
+	if !errors.As(err, &limit) || limit.Dim != 3 {
+		t.Errorf("want a DimensionLimitError at 3 got %v", err)
+	}
+	_, err = munkres.NewFromReader(strings.NewReader("1,2,3\n"), munkres.FormatCSV, munkres.WithMaxDimension(2))
+	if !errors.As(err, &limit) || limit.Dim != 3 {
+		t.Errorf("want a DimensionLimitError at 3 got %v", err)
+	}
+}
@@ -0,0 +1,63 @@
+package munkres
+
+import (
+	"math"
+	"sort"
+)
+
+// SolveOnLine matches the points a to the points b on a line, such as
+// timestamps or positions, so as to minimise the total absolute
+// difference between matched points. The points are sorted, and some
+// optimal matching then pairs them in order: if a and b are the same
+// length, the i'th least of a with the i'th least of b, in time
+// O(n log n); otherwise the points of the shorter are matched in order to
+// some of the longer, chosen by dynamic programming in time
+// O(len(a) * len(b)), far less than the general algorithm's O(n^3).
+//
+// The points must be non-infinite numbers, or a *CostError is returned,
+// with Row 0 for a point of a and 1 for one of b, and Col its index.
+//
+// return the index of the point of b matched with each point of a, as
+// many of which are matched as there are points in a or b, whichever is
+// fewer, with -1 for those unmatched.
+func SolveOnLine(a, b []float64) ([]int, error) {
+	for i, points := range [][]float64{a, b} {
+		for k, p := range points {
+			if err := checkCost(i, k, p, false); err != nil {
+				return nil, err
+			}
+		}
+	}
+	byA, byB := sortedOrder(a), sortedOrder(b)
+	result := unassigned(len(a))
+	if len(a) == len(b) {
+		for i, k := range byA {
+			result[k] = byB[i]
+		}
+		return result, nil
+	}
+	if len(a) < len(b) {
+		for i, k := range monotone(len(a), len(b), func(i, k int) float64 {
+			return math.Abs(a[byA[i]] - b[byB[k]])
+		}) {
+			result[byA[i]] = byB[k]
+		}
+		return result, nil
+	}
+	for k, i := range monotone(len(b), len(a), func(k, i int) float64 {
+		return math.Abs(a[byA[i]] - b[byB[k]])
+	}) {
+		result[byA[i]] = byB[k]
+	}
+	return result, nil
+}
+
+// return the indices of the points in increasing order of the points.
+func sortedOrder(points []float64) []int {
+	order := make([]int, len(points))
+	for i := range order {
+		order[i] = i
+	}
+	sort.Slice(order, func(x, y int) bool { return points[order[x]] < points[order[y]] })
+	return order
+}
@@ -0,0 +1,55 @@
+package munkres_test
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/charles-haynes/munkres"
+)
+
+func TestSolveOnLine(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 200; i++ {
+		a, b := make([]float64, rng.Intn(8)), make([]float64, rng.Intn(8))
+		for k := range a {
+			a[k] = float64(rng.Intn(20))
+		}
+		for k := range b {
+			b[k] = float64(rng.Intn(20))
+		}
+		c := make([][]float64, len(a))
+		for w := range c {
+			c[w] = make([]float64, len(b))
+			for j := range c[w] {
+				c[w][j] = math.Abs(a[w] - b[j])
+			}
+		}
+		want, _ := bruteForceCost(c, func(w, j int) bool { return true })
+		got, err := munkres.SolveOnLine(a, b)
+		if err != nil {
+			t.Fatal(err)
+		}
+		cost, err := munkres.ComputeCost(c, got)
+		if err != nil || cost != want {
+			t.Errorf("%v %v: want cost %f got %f from %v (%v)", a, b, want, cost, got, err)
+		}
+		wantAssigned := len(a)
+		if len(b) < wantAssigned {
+			wantAssigned = len(b)
+		}
+		if count(got) != wantAssigned {
+			t.Errorf("%v %v: want %d matched got %v", a, b, wantAssigned, got)
+		}
+	}
+}
+
+func TestSolveOnLineInvalid(t *testing.T) {
+	_, err := munkres.SolveOnLine([]float64{1}, []float64{2, math.Inf(-1)})
+	var costError *munkres.CostError
+	if !errors.As(err, &costError) || costError.Row != 1 || costError.Col != 1 ||
+		!errors.Is(err, munkres.ErrorInfiniteCost) {
+		t.Errorf("want an infinite cost at (1, 1) got %v", err)
+	}
+}
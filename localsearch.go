@@ -0,0 +1,149 @@
+package munkres
+
+import "math"
+
+// A LocalSearchProblem describes a variant of the assignment problem for
+// the local search solvers, SolveAnnealing and ImproveTabu: the objective
+// to minimize and the side constraints, such as on groups, budgets or
+// precedence, which an assignment must satisfy. The hooks are called once
+// for each assignment the search considers, must not modify or retain the
+// assignment they are given, and should be fast.
+type LocalSearchProblem struct {
+	// Objective returns the value of an assignment. If nil, the total
+	// cost of the assignment is used.
+	Objective func(a Assignment) float64
+	// Constraints each return by how much an assignment violates them,
+	// which is zero or less if it is satisfied.
+	Constraints []func(a Assignment) float64
+	// Penalty weighs the total violation of the constraints against the
+	// objective in the search. It must be positive.
+	Penalty float64
+}
+
+// Check that the problem is valid.
+func (p *LocalSearchProblem) valid() bool {
+	return p.Penalty > 0 && !math.IsInf(p.Penalty, 1)
+}
+
+// The state of a local search over the assignments of the padded square
+// matrix, whose moves exchange the jobs of two workers. Exchanging the
+// jobs of two assigned workers swaps them, while exchanging with a padding
+// worker or an unassigned one shifts a worker to a job no worker has.
+type localSearch struct {
+	costMatrix [][]float64
+	problem    *LocalSearchProblem
+	rows, cols int
+	// The job of each padded worker, where jobs from cols on are padding.
+	jobByWorker []int
+	// The assignment of the workers of costMatrix to its jobs.
+	assignment Assignment
+	// The objective value and total violation of the assignment.
+	value, violation float64
+	// The best assignment found which satisfies every constraint, or nil,
+	// and its objective value.
+	best      Assignment
+	bestValue float64
+}
+
+// Start a local search from a valid assignment for the cost matrix, which
+// is completed to assign as many workers as Execute would.
+func newLocalSearch(costMatrix [][]float64, a Assignment, problem *LocalSearchProblem) *localSearch {
+	rows, cols := len(costMatrix), 0
+	if rows > 0 {
+		cols = len(costMatrix[0])
+	}
+	dim := rows
+	if cols > dim {
+		dim = cols
+	}
+	s := &localSearch{
+		costMatrix:  costMatrix,
+		problem:     problem,
+		rows:        rows,
+		cols:        cols,
+		jobByWorker: make([]int, dim),
+		assignment:  append(Assignment(nil), a...),
+		bestValue:   math.Inf(1),
+	}
+	completeMatching(costMatrix, s.assignment)
+	taken := make([]bool, dim)
+	for w, j := range s.assignment {
+		if j != -1 {
+			taken[j] = true
+		}
+		s.jobByWorker[w] = j
+	}
+	free := 0
+	for w := range s.jobByWorker {
+		if w >= rows || s.jobByWorker[w] == -1 {
+			for taken[free] {
+				free++
+			}
+			s.jobByWorker[w], taken[free] = free, true
+		}
+	}
+	s.value, s.violation = s.evaluate()
+	s.record()
+	return s
+}
+
+// return the objective value and total violation of the assignment.
+func (s *localSearch) evaluate() (float64, float64) {
+	value := 0.0
+	if s.problem.Objective != nil {
+		value = s.problem.Objective(s.assignment)
+	} else {
+		value = s.assignment.Cost(s.costMatrix)
+	}
+	violation := 0.0
+	for _, c := range s.problem.Constraints {
+		violation += math.Max(0, c(s.assignment))
+	}
+	return value, violation
+}
+
+// return the value searched on, the objective plus the penalized violation.
+func (s *localSearch) energy(value, violation float64) float64 {
+	return value + s.problem.Penalty*violation
+}
+
+// Report whether exchanging the jobs of padded workers x and y would
+// change the assignment.
+func (s *localSearch) moves(x, y int) bool {
+	return x != y && (x < s.rows || y < s.rows) &&
+		(s.jobByWorker[x] < s.cols || s.jobByWorker[y] < s.cols)
+}
+
+// Exchange the jobs of padded workers x and y.
+func (s *localSearch) exchange(x, y int) {
+	s.jobByWorker[x], s.jobByWorker[y] = s.jobByWorker[y], s.jobByWorker[x]
+	s.assign(x)
+	s.assign(y)
+}
+
+// Update the assignment of padded worker w.
+func (s *localSearch) assign(w int) {
+	if w < s.rows {
+		s.assignment[w] = s.jobByWorker[w]
+		if s.assignment[w] >= s.cols {
+			s.assignment[w] = -1
+		}
+	}
+}
+
+// Record the assignment as the best found if it satisfies every
+// constraint and improves on the best.
+func (s *localSearch) record() {
+	if s.violation == 0 && s.value < s.bestValue {
+		s.best, s.bestValue = append(s.best[:0], s.assignment...), s.value
+	}
+}
+
+// return the best assignment found which satisfies every constraint and
+// its value, or ErrorInfeasible if none was found.
+func (s *localSearch) result() (Assignment, float64, error) {
+	if s.best == nil {
+		return nil, 0, ErrorInfeasible
+	}
+	return s.best, s.bestValue, nil
+}
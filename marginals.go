@@ -0,0 +1,105 @@
+package munkres
+
+import (
+	"math"
+	"math/rand"
+)
+
+// Problems whose padded square matrix has at most this dimension have
+// their marginal probabilities computed exactly.
+const exactPermanentLimit = 10
+
+// MarginalProbabilities returns, for each worker w and job j, the
+// probability that w is assigned j under the Gibbs distribution at the
+// given temperature, in which each assignment has probability
+// proportional to exp(-cost/T), as needed for joint probabilistic data
+// association. Each probability is a ratio of matrix permanents, which
+// are computed exactly by Ryser's formula for problems of up to 10
+// workers and jobs, whichever is greater. Larger problems are estimated
+// from the given number of samples drawn by a Sampler using rng, one
+// sweep apart after a burn-in of as many sweeps.
+//
+// The cost matrix is subject to the same restrictions as for
+// NewHungarianAlgorithm, and the temperature must be positive.
+func MarginalProbabilities(costMatrix [][]float64, temperature float64, samples int, rng *rand.Rand) ([][]float64, error) {
+	s, err := NewSampler(costMatrix, temperature, rng)
+	if err != nil {
+		return nil, err
+	}
+	marginals := make([][]float64, s.rows)
+	for w := range marginals {
+		marginals[w] = make([]float64, s.cols)
+	}
+	dim := len(s.costMatrix)
+	if dim <= exactPermanentLimit {
+		// Scaling a row leaves the ratios unchanged, so scale each
+		// row's weights to at most 1 to avoid underflow.
+		weights := make([][]float64, dim)
+		for w, row := range s.costMatrix {
+			least := minOf(row)
+			weights[w] = make([]float64, dim)
+			for j, c := range row {
+				weights[w][j] = math.Exp(-(c - least) / temperature)
+			}
+		}
+		total := permanentWithout(weights, -1, -1)
+		for w := range marginals {
+			for j := range marginals[w] {
+				marginals[w][j] = weights[w][j] * permanentWithout(weights, w, j) / total
+			}
+		}
+		return marginals, nil
+	}
+	if samples <= 0 {
+		return nil, ErrorInvalidOption
+	}
+	s.Sample(samples)
+	for i := 0; i < samples; i++ {
+		for w, j := range s.Sample(1) {
+			if j != -1 {
+				marginals[w][j] += 1 / float64(samples)
+			}
+		}
+	}
+	return marginals, nil
+}
+
+// return the permanent of the square matrix m without row w and column j,
+// or of the whole matrix if they are -1, by Ryser's formula.
+func permanentWithout(m [][]float64, w, j int) float64 {
+	var rows, cols []int
+	for i := range m {
+		if i != w {
+			rows = append(rows, i)
+		}
+		if i != j {
+			cols = append(cols, i)
+		}
+	}
+	n := len(rows)
+	total := 0.0
+	for subset := 1; subset < 1<<uint(n); subset++ {
+		product, size := 1.0, 0
+		for _, r := range rows {
+			sum := 0.0
+			for k, c := range cols {
+				if subset&(1<<uint(k)) != 0 {
+					sum += m[r][c]
+				}
+			}
+			product *= sum
+		}
+		for b := subset; b != 0; b &= b - 1 {
+			size++
+		}
+		if (n-size)%2 == 0 {
+			total += product
+		} else {
+			total -= product
+		}
+	}
+	if n == 0 {
+		return 1
+	}
+	return total
+}
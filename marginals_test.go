@@ -0,0 +1,113 @@
+package munkres_test
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/charles-haynes/munkres"
+)
+
+// return the marginal probabilities of the Gibbs distribution at
+// temperature 1 by enumerating the permutations of the square matrix c.
+func bruteMarginals(c [][]float64) [][]float64 {
+	n := len(c)
+	m := make([][]float64, n)
+	for w := range m {
+		m[w] = make([]float64, n)
+	}
+	perm := make([]int, n)
+	for k := range perm {
+		perm[k] = k
+	}
+	z := 0.0
+	var visit func(k int)
+	visit = func(k int) {
+		if k == n {
+			p := math.Exp(-munkres.Assignment(perm).Cost(c))
+			z += p
+			for w, j := range perm {
+				m[w][j] += p
+			}
+			return
+		}
+		for l := k; l < n; l++ {
+			perm[k], perm[l] = perm[l], perm[k]
+			visit(k + 1)
+			perm[k], perm[l] = perm[l], perm[k]
+		}
+	}
+	visit(0)
+	for w := range m {
+		for j := range m[w] {
+			m[w][j] /= z
+		}
+	}
+	return m
+}
+
+func TestMarginalProbabilities(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 20; i++ {
+		n := 1 + rng.Intn(6)
+		c := randomMatrix(rng, n, n)
+		got, err := munkres.MarginalProbabilities(c, 1, 0, rng)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := bruteMarginals(c)
+		for w := range want {
+			for j := range want[w] {
+				if math.Abs(got[w][j]-want[w][j]) > 1e-9 {
+					t.Fatalf("want %v got %v", want, got)
+				}
+			}
+		}
+	}
+}
+
+func TestMarginalProbabilitiesRectangular(t *testing.T) {
+	c := [][]float64{{0, 1, 2}, {2, 1, 0}}
+	m, err := munkres.MarginalProbabilities(c, 1, 0, rand.New(rand.NewSource(1)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for w := range m {
+		sum := 0.0
+		for _, p := range m[w] {
+			sum += p
+		}
+		if math.Abs(sum-1) > 1e-9 {
+			t.Errorf("want worker %d assigned with probability 1 got %f", w, sum)
+		}
+	}
+	// By symmetry.
+	if math.Abs(m[0][0]-m[1][2]) > 1e-9 || m[0][0] <= m[0][2] {
+		t.Errorf("want symmetric marginals favouring the cheap jobs got %v", m)
+	}
+}
+
+func TestMarginalProbabilitiesSampled(t *testing.T) {
+	// Too large to compute exactly; at a low temperature the optimum
+	// dominates.
+	n := 12
+	c := make([][]float64, n)
+	for w := range c {
+		c[w] = make([]float64, n)
+		for j := range c[w] {
+			c[w][j] = math.Abs(float64(w - j))
+		}
+	}
+	m, err := munkres.MarginalProbabilities(c, 0.1, 200, rand.New(rand.NewSource(1)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for w := range m {
+		if m[w][w] < 0.9 {
+			t.Errorf("want worker %d assigned its own job almost surely got %f", w, m[w][w])
+		}
+	}
+	if _, err := munkres.MarginalProbabilities(c, 1, 0, rand.New(rand.NewSource(1))); err != munkres.ErrorInvalidOption {
+		t.Errorf("want err = %s got %v", munkres.ErrorInvalidOption, err)
+	}
+}
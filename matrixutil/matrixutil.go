@@ -0,0 +1,132 @@
+/*
+Package matrixutil provides the transformations of cost matrices, as
+taken by NewHungarianAlgorithm of package munkres, which keep recurring
+around calls to the solver. Each returns a new matrix, leaving its
+arguments unmodified, and every matrix must be rectangular: a slice of
+rows of equal length.
+*/
+package matrixutil
+
+import "errors"
+
+var
+// A row or column index is out of range
+ErrorOutOfRange,
+	// The shapes of the matrices do not fit together
+	ErrorShapeMismatch error
+
+// return the number of rows and columns of m.
+func shape(m [][]float64) (int, int) {
+	if len(m) == 0 {
+		return 0, 0
+	}
+	return len(m), len(m[0])
+}
+
+// return a rows x cols matrix whose entries are f(i, j).
+func build(rows, cols int, f func(i, j int) float64) [][]float64 {
+	m := make([][]float64, rows)
+	for i := range m {
+		m[i] = make([]float64, cols)
+		for j := range m[i] {
+			m[i][j] = f(i, j)
+		}
+	}
+	return m
+}
+
+// Transpose returns the transpose of m, whose rows are the columns of m,
+// such as to exchange the roles of workers and jobs.
+func Transpose(m [][]float64) [][]float64 {
+	rows, cols := shape(m)
+	return build(cols, rows, func(i, j int) float64 { return m[j][i] })
+}
+
+// Select returns the sub-matrix of m with the given rows and columns, in
+// the order given, such as the workers and jobs still available. A nil
+// rows or cols selects every row or column.
+//
+// return ErrorOutOfRange if an index is not a row or column of m.
+func Select(m [][]float64, rows, cols []int) ([][]float64, error) {
+	r, c := shape(m)
+	var err error
+	if rows, err = indices(rows, r); err != nil {
+		return nil, err
+	}
+	if cols, err = indices(cols, c); err != nil {
+		return nil, err
+	}
+	return build(len(rows), len(cols), func(i, j int) float64 {
+		return m[rows[i]][cols[j]]
+	}), nil
+}
+
+// return the indices, or all n if they are nil, or ErrorOutOfRange if one
+// is not in [0, n).
+func indices(indices []int, n int) ([]int, error) {
+	if indices == nil {
+		indices = make([]int, n)
+		for i := range indices {
+			indices[i] = i
+		}
+	}
+	for _, i := range indices {
+		if i < 0 || i >= n {
+			return nil, ErrorOutOfRange
+		}
+	}
+	return indices, nil
+}
+
+// AddScalar returns m with x added to every entry, such as to make every
+// cost non-negative.
+func AddScalar(m [][]float64, x float64) [][]float64 {
+	rows, cols := shape(m)
+	return build(rows, cols, func(i, j int) float64 { return m[i][j] + x })
+}
+
+// Scale returns m with every entry multiplied by x, such as to convert
+// units or to negate profits into costs.
+func Scale(m [][]float64, x float64) [][]float64 {
+	rows, cols := shape(m)
+	return build(rows, cols, func(i, j int) float64 { return m[i][j] * x })
+}
+
+// Min returns the element-wise minimum of a and b, such as the cheaper of
+// two ways of doing each job.
+//
+// return ErrorShapeMismatch if a and b differ in shape.
+func Min(a, b [][]float64) ([][]float64, error) {
+	rows, cols := shape(a)
+	if r, c := shape(b); r != rows || c != cols {
+		return nil, ErrorShapeMismatch
+	}
+	return build(rows, cols, func(i, j int) float64 {
+		if b[i][j] < a[i][j] {
+			return b[i][j]
+		}
+		return a[i][j]
+	}), nil
+}
+
+// Pad returns m extended to rows x cols with fill in the new entries, such
+// as dummy workers or jobs at a fixed cost.
+//
+// return ErrorShapeMismatch if m has more rows or columns.
+func Pad(m [][]float64, rows, cols int, fill float64) ([][]float64, error) {
+	r, c := shape(m)
+	if r > rows || c > cols {
+		return nil, ErrorShapeMismatch
+	}
+	return build(rows, cols, func(i, j int) float64 {
+		if i < r && j < c {
+			return m[i][j]
+		}
+		return fill
+	}), nil
+}
+
+func init() {
+	ErrorOutOfRange = errors.New("Index out of range")
+	ErrorShapeMismatch = errors.New("Shape mismatch")
+}
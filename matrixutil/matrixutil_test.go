@@ -0,0 +1,76 @@
+package matrixutil_test
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/charles-haynes/munkres/matrixutil"
+)
+
+var m = [][]float64{{1, 2, 3}, {4, 5, 6}}
+
+func TestTranspose(t *testing.T) {
+	want := [][]float64{{1, 4}, {2, 5}, {3, 6}}
+	if got := matrixutil.Transpose(m); !reflect.DeepEqual(got, want) {
+		t.Errorf("want %v got %v", want, got)
+	}
+	if got := matrixutil.Transpose(nil); len(got) != 0 {
+		t.Errorf("want [] got %v", got)
+	}
+}
+
+func TestSelect(t *testing.T) {
+	for _, test := range []struct {
+		rows, cols []int
+		want       [][]float64
+		err        error
+	}{
+		{[]int{1}, []int{2, 0}, [][]float64{{6, 4}}, nil},
+		{nil, []int{1}, [][]float64{{2}, {5}}, nil},
+		{[]int{1, 0}, nil, [][]float64{{4, 5, 6}, {1, 2, 3}}, nil},
+		{[]int{2}, nil, nil, matrixutil.ErrorOutOfRange},
+		{nil, []int{-1}, nil, matrixutil.ErrorOutOfRange},
+	} {
+		got, err := matrixutil.Select(m, test.rows, test.cols)
+		if err != test.err || !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%v %v: want %v, %v got %v, %v", test.rows, test.cols,
+				test.want, test.err, got, err)
+		}
+	}
+}
+
+func TestAddScalarScale(t *testing.T) {
+	want := [][]float64{{0.5, 1.5, 2.5}, {3.5, 4.5, 5.5}}
+	if got := matrixutil.AddScalar(m, -0.5); !reflect.DeepEqual(got, want) {
+		t.Errorf("want %v got %v", want, got)
+	}
+	want = [][]float64{{-2, -4, -6}, {-8, -10, -12}}
+	if got := matrixutil.Scale(m, -2); !reflect.DeepEqual(got, want) {
+		t.Errorf("want %v got %v", want, got)
+	}
+	if m[0][0] != 1 {
+		t.Errorf("argument modified to %v", m)
+	}
+}
+
+func TestMin(t *testing.T) {
+	b := [][]float64{{0, 9, 3}, {math.Inf(1), -1, 7}}
+	want := [][]float64{{0, 2, 3}, {4, -1, 6}}
+	if got, err := matrixutil.Min(m, b); err != nil || !reflect.DeepEqual(got, want) {
+		t.Errorf("want %v got %v, %v", want, got, err)
+	}
+	if _, err := matrixutil.Min(m, b[:1]); err != matrixutil.ErrorShapeMismatch {
+		t.Errorf("want %v got %v", matrixutil.ErrorShapeMismatch, err)
+	}
+}
+
+func TestPad(t *testing.T) {
+	want := [][]float64{{1, 2, 3, 9}, {4, 5, 6, 9}, {9, 9, 9, 9}}
+	if got, err := matrixutil.Pad(m, 3, 4, 9); err != nil || !reflect.DeepEqual(got, want) {
+		t.Errorf("want %v got %v, %v", want, got, err)
+	}
+	if _, err := matrixutil.Pad(m, 3, 2, 0); err != matrixutil.ErrorShapeMismatch {
+		t.Errorf("want %v got %v", matrixutil.ErrorShapeMismatch, err)
+	}
+}
@@ -0,0 +1,29 @@
+package munkres
+
+// WithMaximize makes the entries of the cost matrix profits, such as the
+// bids of bidders for ad slots, so that Execute finds the assignment of
+// greatest total profit among those assigning as many workers as it
+// would otherwise, that is, a maximum weight matching of maximum
+// cardinality. Forbidden profits are -Inf, where forbidden costs are
+// allowed.
+//
+// The algorithm solves for the negated profits, so that the padding of a
+// rectangular matrix costs nothing whatever the signs of the profits,
+// and OriginalCost and Duals are those of the negated profits.
+func WithMaximize() Option {
+	return func(o *options) {
+		o.maximize = true
+	}
+}
+
+// return the costs of the profit matrix, their negations.
+func negate(profitMatrix [][]float64) [][]float64 {
+	costMatrix := make([][]float64, len(profitMatrix))
+	for w, row := range profitMatrix {
+		costMatrix[w] = make([]float64, len(row))
+		for j, p := range row {
+			costMatrix[w][j] = -p
+		}
+	}
+	return costMatrix
+}
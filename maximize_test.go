@@ -0,0 +1,55 @@
+package munkres_test
+
+import (
+	"math"
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/charles-haynes/munkres"
+)
+
+func TestMaximize(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 100; i++ {
+		rows, cols := 1+rng.Intn(6), 1+rng.Intn(6)
+		profits := randomMatrix(rng, rows, cols)
+		negated := make([][]float64, rows)
+		for w := range profits {
+			negated[w] = make([]float64, cols)
+			for j := range profits[w] {
+				// Profits of either sign.
+				profits[w][j] -= 50
+				negated[w][j] = -profits[w][j]
+			}
+		}
+		h, err := munkres.NewHungarianAlgorithm(profits, munkres.WithMaximize())
+		if err != nil {
+			t.Fatal(err)
+		}
+		a := munkres.Assignment(h.Execute())
+		least, _ := bruteForceCost(negated, func(w, j int) bool { return true })
+		assigned := rows
+		if cols < rows {
+			assigned = cols
+		}
+		if got := a.Cost(profits); math.Abs(got+least) > 1e-9 || count(a) != assigned {
+			t.Fatalf("%v: want profit %v got %v making %v", profits, -least, a, got)
+		}
+	}
+}
+
+func TestMaximizeForbidden(t *testing.T) {
+	profits := [][]float64{{3, math.Inf(-1), 1}, {math.Inf(-1), 2, 5}}
+	none := func(w, j int) bool { return false }
+	h, err := munkres.NewHungarianAlgorithm(profits, munkres.WithMaximize(), munkres.WithRejection(none))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a, err := h.Solve(); err != nil || !reflect.DeepEqual(a, []int{0, 2}) {
+		t.Errorf("want [0 2] got %v (%v)", a, err)
+	}
+	if _, err := munkres.NewHungarianAlgorithm(profits, munkres.WithMaximize()); err == nil {
+		t.Errorf("want an error for forbidden profits without forbidden costs allowed")
+	}
+}
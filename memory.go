@@ -0,0 +1,66 @@
+package munkres
+
+import "strconv"
+
+// Sizes in bytes of the values making up the state of the algorithm.
+const (
+	wordSize     = strconv.IntSize / 8
+	floatSize    = 8
+	sliceSize    = 3 * wordSize
+	backupSize   = 2*wordSize + floatSize
+	flowEdgeSize = wordSize + 2*floatSize
+)
+
+// EstimateMemory returns the number of bytes that NewHungarianAlgorithm
+// and Execute will allocate for a rows x cols cost matrix with the given
+// options, so that a service can reject problems too large to solve.
+//
+// The state of the algorithm is sized by the square matrix obtained by
+// padding the cost matrix, which is held in a single slice rather than
+// a slice of rows, so it grows with the square of the larger of rows and
+// cols. The estimate does not include the slack in slices grown
+// by appending, nor the allocations of the caller's cost matrix. With
+// WithWorkerGroups the estimate covers the flow network that is solved
+// instead, but not the working memory of its shortest path searches,
+// which depends on the costs.
+func EstimateMemory(rows, cols int, opts ...Option) uint64 {
+	o := newOptions(opts)
+	if rows == 0 {
+		return 0
+	}
+	dim := rows
+	if cols > dim {
+		dim = cols
+	}
+	n, r, c := uint64(dim), uint64(rows), uint64(cols)
+	// The padded cost matrix, a single slice in row-major order.
+	total := n * n * floatSize
+	// The labels, reductions and slack values; the slack workers,
+	// parents, uncommitted jobs, matching and greedy matching scratch;
+	// and the committed workers.
+	total += 5*n*floatSize + 6*n*wordSize + n
+	// The result.
+	total += r * wordSize
+	if o.sinkhorn.iterations > 0 {
+		// The potentials, and the terms of their sums.
+		total += 3 * n * floatSize
+	}
+	if o.backups {
+		total += r*backupSize + 2*n*floatSize + 2*n*wordSize
+	}
+	if o.workerGroups != nil {
+		groups := map[int]bool{}
+		for _, g := range o.workerGroups {
+			if g >= 0 {
+				groups[g] = true
+			}
+		}
+		g := uint64(len(groups))
+		nodes := 2 + g + r + c
+		edges := 2 * (g + r + r*c + c)
+		total += edges*(flowEdgeSize+wordSize) + nodes*sliceSize
+		total += 2*nodes*floatSize + nodes*wordSize
+		total += r*sliceSize + r*c*wordSize
+	}
+	return total
+}
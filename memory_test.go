@@ -0,0 +1,59 @@
+package munkres_test
+
+import (
+	"math/rand"
+	"runtime"
+	"strconv"
+	"testing"
+
+	"github.com/charles-haynes/munkres"
+)
+
+// return the bytes allocated by f.
+func allocated(f func()) uint64 {
+	var before, after runtime.MemStats
+	runtime.GC()
+	runtime.ReadMemStats(&before)
+	f()
+	runtime.ReadMemStats(&after)
+	return after.TotalAlloc - before.TotalAlloc
+}
+
+func TestEstimateMemory(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	groups := make([]int, 100)
+	for w := range groups {
+		groups[w] = w / 2
+	}
+	for _, test := range []struct {
+		rows, cols int
+		opts       []munkres.Option
+		exact      bool
+	}{
+		{200, 200, nil, true},
+		{100, 300, nil, true},
+		{300, 100, nil, true},
+		{200, 200, []munkres.Option{munkres.WithBackups()}, true},
+		{100, 100, []munkres.Option{munkres.WithWorkerGroups(groups)}, false},
+	} {
+		c := randomMatrix(rng, test.rows, test.cols)
+		estimate := munkres.EstimateMemory(test.rows, test.cols, test.opts...)
+		got := allocated(func() {
+			h, _ := munkres.NewHungarianAlgorithm(c, test.opts...)
+			h.Execute()
+		})
+		if test.exact && got > estimate*3/2 || estimate > got*3/2 {
+			t.Errorf("%dx%d: estimated %d bytes, allocated %d", test.rows, test.cols, estimate, got)
+		}
+	}
+	// The padded matrix is a single slice of 8 byte costs, with no slice
+	// header per row, beside 5 slices of costs, 6 of ints and 1 of bools
+	// of the padded size and the result.
+	word := uint64(strconv.IntSize / 8)
+	if got, want := munkres.EstimateMemory(2, 3), 3*3*8+5*3*8+6*3*word+3+2*word; got != want {
+		t.Errorf("want %d bytes got %d", want, got)
+	}
+	if got := munkres.EstimateMemory(0, 0); got != 0 {
+		t.Errorf("want 0 bytes got %d", got)
+	}
+}
@@ -0,0 +1,74 @@
+package munkres
+
+import (
+	"strconv"
+	"time"
+)
+
+// A Counter counts events, as a Prometheus counter does.
+type Counter interface {
+	Inc()
+}
+
+// A Histogram records the distribution of observed values, as a
+// Prometheus histogram does.
+type Histogram interface {
+	Observe(value float64)
+}
+
+// Metrics are the instruments to which solves report, so that services
+// embedding the solver get dashboards without depending on any metrics
+// library. Any of them may be nil.
+type Metrics struct {
+	// Solves counts the solves performed, whether or not they succeed.
+	Solves Counter
+	// Infeasible counts the solves which fail with ErrorInfeasible.
+	Infeasible Counter
+	// Latency returns the histogram of the seconds taken by solves of the
+	// given dimension bucket, as a Prometheus HistogramVec does for a
+	// label: "10", "100", "1000", "10000" or "+Inf", the least power of
+	// ten no less than the greater of the numbers of workers and jobs.
+	Latency func(bucket string) Histogram
+	// Phases observes the number of phases of each solve.
+	Phases Histogram
+}
+
+// WithMetrics makes Execute, Solve and ExecuteContext report each solve
+// to the instruments of m.
+func WithMetrics(m *Metrics) Option {
+	return func(o *options) {
+		o.metrics = m
+	}
+}
+
+// Report a solve which started at start and returned err to the
+// instruments set by WithMetrics.
+func (h *HungarianAlgorithm) observe(start time.Time, err error) {
+	m := h.opts.metrics
+	if m.Solves != nil {
+		m.Solves.Inc()
+	}
+	if m.Infeasible != nil && err == ErrorInfeasible {
+		m.Infeasible.Inc()
+	}
+	if m.Latency != nil {
+		dim := h.rows
+		if h.cols > dim {
+			dim = h.cols
+		}
+		m.Latency(dimensionBucket(dim)).Observe(time.Since(start).Seconds())
+	}
+	if m.Phases != nil {
+		m.Phases.Observe(float64(h.Snapshot().Phases))
+	}
+}
+
+// return the dimension bucket of Metrics.Latency for a dimension of n.
+func dimensionBucket(n int) string {
+	for bound := 10; bound <= 10000; bound *= 10 {
+		if n <= bound {
+			return strconv.Itoa(bound)
+		}
+	}
+	return "+Inf"
+}
@@ -0,0 +1,70 @@
+package munkres_test
+
+import (
+	"math"
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/charles-haynes/munkres"
+)
+
+type counter int
+
+func (c *counter) Inc() {
+	*c++
+}
+
+type histogram []float64
+
+func (h *histogram) Observe(value float64) {
+	*h = append(*h, value)
+}
+
+func TestWithMetrics(t *testing.T) {
+	var solves, infeasible counter
+	var phases histogram
+	latency := map[string]*histogram{}
+	m := &munkres.Metrics{
+		Solves:     &solves,
+		Infeasible: &infeasible,
+		Latency: func(bucket string) munkres.Histogram {
+			if latency[bucket] == nil {
+				latency[bucket] = &histogram{}
+			}
+			return latency[bucket]
+		},
+		Phases: &phases,
+	}
+	for _, c := range [][][]float64{
+		{{4, 1, 3}, {2, 0, 5}, {3, 2, 2}},
+		randomMatrix(rand.New(rand.NewSource(1)), 20, 20),
+	} {
+		h, _ := munkres.NewHungarianAlgorithm(c, munkres.WithMetrics(m))
+		h.Execute()
+	}
+	// Forbidding the only job of both workers leaves one unassigned.
+	h, _ := munkres.NewHungarianAlgorithm([][]float64{{1, 2}, {1, 2}},
+		munkres.WithMetrics(m), munkres.WithRejection(func(w, j int) bool { return j == 1 }))
+	if _, err := h.Solve(); err != munkres.ErrorInfeasible {
+		t.Fatalf("want %v got %v", munkres.ErrorInfeasible, err)
+	}
+	if solves != 3 || infeasible != 1 || len(phases) != 3 {
+		t.Errorf("want 3 solves, 1 infeasible and 3 phase counts got %d, %d and %v", solves, infeasible, phases)
+	}
+	if len(*latency["10"]) != 2 || len(*latency["100"]) != 1 {
+		t.Errorf("want 2 latencies for 10 and 1 for 100 got %v", latency)
+	}
+	for _, l := range latency {
+		for _, seconds := range *l {
+			if seconds < 0 || math.IsNaN(seconds) {
+				t.Errorf("want a latency got %f", seconds)
+			}
+		}
+	}
+	// Nil instruments are skipped.
+	h, _ = munkres.NewHungarianAlgorithm([][]float64{{1}}, munkres.WithMetrics(&munkres.Metrics{}))
+	if got := h.Execute(); !reflect.DeepEqual(got, []int{0}) {
+		t.Errorf("want [0] got %v", got)
+	}
+}
@@ -0,0 +1,125 @@
+package munkres
+
+import "math"
+
+// SolveMixedPrecision solves the assignment problem with the bulk of the
+// work done in float32 arithmetic, on a float32 copy of the cost matrix
+// which takes half the memory of NewHungarianAlgorithm's and half the
+// memory bandwidth to scan, then verifies and repairs the result in
+// float64 arithmetic on costMatrix itself, so that the assignment is
+// optimal just as Execute's is.
+//
+// The float32 labels are rounded, so some pairs of the float32 matching
+// may not be tight in float64. The labels of the workers are recomputed
+// from those of the jobs in float64, which makes them feasible, and the
+// workers whose pairs are then not tight are matched again by float64
+// phases, which are few when the costs are well represented in float32,
+// as integers up to 2^24 are exactly. A matrix with a cost too great in
+// magnitude for float32 is solved in float64 throughout.
+//
+// return the assignment as for Execute, or the errors of
+// NewHungarianAlgorithm for costMatrix.
+func SolveMixedPrecision(costMatrix [][]float64) (Assignment, error) {
+	if err := checkCostMatrix(costMatrix, false); err != nil {
+		return nil, err
+	}
+	rows, cols := len(costMatrix), 0
+	if rows > 0 {
+		cols = len(costMatrix[0])
+	}
+	if rows == 0 || cols == 0 {
+		return Assignment(unassigned(rows)), nil
+	}
+	n := rows
+	if cols > n {
+		n = cols
+	}
+	// Workers and jobs are numbered from 1, as by augmentRows, and the
+	// matrix is padded to be square with workers of zero costs, so that
+	// every job is matched and its label is then justified.
+	row := func(w int) ([]float64, error) {
+		if w < rows {
+			return costMatrix[w], nil
+		}
+		return nil, nil
+	}
+	labelW := make([]float64, n+1)
+	labelJ := make([]float64, n+1)
+	workerByJob := make([]int, n+1)
+	if costs, ok := toFloat32(costMatrix, rows, cols); ok {
+		_, labels, jobs, _ := augmentRows(n, n, float32(math.Inf(1)),
+			func(w int) ([]float32, error) {
+				if w < rows {
+					return costs[w*cols : (w+1)*cols], nil
+				}
+				return nil, nil
+			})
+		for j, label := range labels {
+			labelJ[j+1] = float64(label)
+		}
+		jobByWorker := make([]int, n+1)
+		for w, j := range jobs {
+			jobByWorker[w+1] = j + 1
+			workerByJob[j+1] = w + 1
+		}
+		// Make the labels feasible in float64, and unmatch the pairs
+		// which are not tight in it.
+		for w := 1; w <= n; w++ {
+			row, _ := row(w - 1)
+			labelW[w] = math.Inf(1)
+			for j := 1; j <= n; j++ {
+				labelW[w] = math.Min(labelW[w], costOrZero(row, j)-labelJ[j])
+			}
+			if j := jobByWorker[w]; costOrZero(row, j)-labelJ[j] != labelW[w] {
+				workerByJob[j] = 0
+			}
+		}
+	}
+	matched := make([]bool, n+1)
+	for j := 1; j <= n; j++ {
+		matched[workerByJob[j]] = true
+	}
+	parentJob := make([]int, n+1)
+	minSlack := make([]float64, n+1)
+	committed := make([]bool, n+1)
+	for w := 1; w <= n; w++ {
+		if matched[w] {
+			continue
+		}
+		if err := augmentPhase(w, n, math.Inf(1), row, labelW, labelJ, workerByJob, parentJob, minSlack, committed); err != nil {
+			return nil, err
+		}
+	}
+	result := Assignment(unassigned(rows))
+	for j := 1; j <= cols; j++ {
+		if w := workerByJob[j]; w != 0 && w <= rows {
+			result[w-1] = j - 1
+		}
+	}
+	return result, nil
+}
+
+// return the cost of job j, numbered from 1, in row, or 0 beyond its end.
+func costOrZero(row []float64, j int) float64 {
+	if j <= len(row) {
+		return row[j-1]
+	}
+	return 0
+}
+
+// return the rows x cols costs in row-major order as float32s, or false
+// if one is so great in magnitude that a label, bounded by the sum of
+// the costs along an augmenting path, might overflow a float32.
+func toFloat32(costMatrix [][]float64, rows, cols int) ([]float32, bool) {
+	limit := math.MaxFloat32 / float64(2*(rows+cols))
+	costs := make([]float32, rows*cols)
+	for w, row := range costMatrix {
+		for j, c := range row {
+			if math.Abs(c) > limit {
+				return nil, false
+			}
+			costs[w*cols+j] = float32(c)
+		}
+	}
+	return costs, true
+}
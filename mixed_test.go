@@ -0,0 +1,54 @@
+package munkres_test
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/charles-haynes/munkres"
+)
+
+func TestSolveMixedPrecision(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 200; i++ {
+		rows, cols := 1+rng.Intn(7), 1+rng.Intn(7)
+		c := randomMatrix(rng, rows, cols)
+		switch i % 3 {
+		case 1:
+			// Costs which float32 rounds to ties.
+			for w := range c {
+				for j := range c[w] {
+					c[w][j] = 1e6 + float64(rng.Intn(50))*1e-3
+				}
+			}
+		case 2:
+			// Costs too great for float32.
+			c[0][0] = 1e300
+		}
+		a, err := munkres.SolveMixedPrecision(c)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want, _ := bruteForceCost(c, func(w, j int) bool { return true })
+		assigned := rows
+		if cols < rows {
+			assigned = cols
+		}
+		if got := a.Cost(c); math.Abs(got-want) > 1e-9*math.Max(1, math.Abs(want)) || count(a) != assigned {
+			t.Fatalf("%v: want cost %v got %v costing %v", c, want, a, got)
+		}
+	}
+}
+
+func TestSolveMixedPrecisionErrors(t *testing.T) {
+	if a, err := munkres.SolveMixedPrecision([][]float64{{}, {}}); err != nil || len(a) != 2 || count(a) != 0 {
+		t.Errorf("want 2 unassigned workers got %v (%v)", a, err)
+	}
+	if _, err := munkres.SolveMixedPrecision([][]float64{{1, 2}, {3}}); !errors.Is(err, munkres.ErrorIrregularCostMatrix) {
+		t.Errorf("want ErrorIrregularCostMatrix got %v", err)
+	}
+	if _, err := munkres.SolveMixedPrecision([][]float64{{math.Inf(1)}}); err == nil {
+		t.Errorf("want an error for a forbidden cost")
+	}
+}
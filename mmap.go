@@ -0,0 +1,62 @@
+package munkres
+
+// A MappedMatrix is a cost matrix in a memory-mapped file, which is
+// solved in place, without copying the matrix into the heap, so that
+// very large precomputed matrices can be shared between processes.
+type MappedMatrix struct {
+	data       []byte
+	costs      []float64
+	rows, cols int
+}
+
+// NewFromMmap maps the file at path, which must hold exactly the rows x
+// cols costs of a matrix as float64s in row-major order and in the byte
+// order of the machine, such as the body of a FormatBinary file written
+// on a little-endian machine. The matrix is checked as for
+// NewHungarianAlgorithm, with an invalid row reported by a *ParseError.
+// The file must not be modified while it is mapped.
+//
+// return ErrorUnsupported on platforms without memory mapping.
+func NewFromMmap(path string, rows, cols int) (*MappedMatrix, error) {
+	// A product which overflows could wrap to the length of a smaller
+	// file.
+	if rows < 0 || cols < 0 || cols > 0 && rows > int(^uint(0)>>1)/cols {
+		return nil, ErrorDimensionMismatch
+	}
+	data, costs, err := mmap(path, rows*cols)
+	if err != nil {
+		return nil, err
+	}
+	m := &MappedMatrix{data: data, costs: costs, rows: rows, cols: cols}
+	for w := 0; w < rows; w++ {
+		row, _ := m.row(w)
+		if err := checkRow(w, row, false); err != nil {
+			m.Close()
+			return nil, &ParseError{Row: w, Err: err}
+		}
+	}
+	return m, nil
+}
+
+func (m *MappedMatrix) row(w int) ([]float64, error) {
+	return m.costs[w*m.cols : (w+1)*m.cols : (w+1)*m.cols], nil
+}
+
+// Execute solves the assignment problem by the shortest augmenting path
+// form of the algorithm, which reads the mapped matrix without modifying
+// it and needs only O(n) further memory.
+//
+// return the assignment as for the Execute of HungarianAlgorithm.
+func (m *MappedMatrix) Execute() ([]int, error) {
+	return solveRows(m.rows, m.cols, m)
+}
+
+// Close unmaps the file. The matrix must not be used afterwards.
+func (m *MappedMatrix) Close() error {
+	if m.data == nil {
+		return nil
+	}
+	data := m.data
+	m.data, m.costs = nil, nil
+	return munmap(data)
+}
@@ -0,0 +1,12 @@
+//go:build !aix && !darwin && !dragonfly && !freebsd && !linux && !netbsd && !openbsd && !solaris
+// +build !aix,!darwin,!dragonfly,!freebsd,!linux,!netbsd,!openbsd,!solaris
+
+package munkres
+
+func mmap(path string, n int) ([]byte, []float64, error) {
+	return nil, nil, ErrorUnsupported
+}
+
+func munmap(data []byte) error {
+	return nil
+}
@@ -0,0 +1,85 @@
+package munkres_test
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"unsafe"
+
+	"github.com/charles-haynes/munkres"
+)
+
+// Write the costs of the matrix to a file in row-major order, and return
+// its path.
+func writeRaw(t *testing.T, c [][]float64) string {
+	path := filepath.Join(t.TempDir(), "costs")
+	f, err := os.Create(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	for _, row := range c {
+		binary.Write(f, nativeEndian(), row)
+	}
+	return path
+}
+
+// return the byte order of the machine, in which NewFromMmap views the
+// costs of the file, as a float64 is viewed in memory.
+func nativeEndian() binary.ByteOrder {
+	x := uint16(1)
+	if *(*byte)(unsafe.Pointer(&x)) == 1 {
+		return binary.LittleEndian
+	}
+	return binary.BigEndian
+}
+
+func TestNewFromMmap(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 50; i++ {
+		rows, cols := 1+rng.Intn(12), 1+rng.Intn(12)
+		c := randomMatrix(rng, rows, cols)
+		m, err := munkres.NewFromMmap(writeRaw(t, c), rows, cols)
+		if err == munkres.ErrorUnsupported {
+			t.Skip(err)
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		a, err := m.Execute()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := m.Close(); err != nil {
+			t.Fatal(err)
+		}
+		h, _ := munkres.NewHungarianAlgorithm(c)
+		want, _ := munkres.ComputeCost(c, h.Execute())
+		if got, err := munkres.ComputeCost(c, a); err != nil || got != want {
+			t.Errorf("%v: want cost = %f got %f (%v)", c, want, got, err)
+		}
+	}
+}
+
+func TestNewFromMmapInvalid(t *testing.T) {
+	path := writeRaw(t, [][]float64{{1, 2}, {math.Inf(1), 4}})
+	_, err := munkres.NewFromMmap(path, 2, 2)
+	if err == munkres.ErrorUnsupported {
+		t.Skip(err)
+	}
+	var parseError *munkres.ParseError
+	if !errors.As(err, &parseError) || parseError.Row != 1 || !errors.Is(err, munkres.ErrorInfiniteCost) {
+		t.Errorf("want an infinite cost in row 1 got %v", err)
+	}
+	// The last product wraps to the 4 costs of the file.
+	for _, size := range [][2]int{{2, 3}, {-2, -2}, {1<<(strconv.IntSize-2) + 1, 4}} {
+		if _, err := munkres.NewFromMmap(path, size[0], size[1]); err != munkres.ErrorDimensionMismatch {
+			t.Errorf("%v: want err = %s got %v", size, munkres.ErrorDimensionMismatch, err)
+		}
+	}
+}
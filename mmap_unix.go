@@ -0,0 +1,49 @@
+//go:build aix || darwin || dragonfly || freebsd || linux || netbsd || openbsd || solaris
+// +build aix darwin dragonfly freebsd linux netbsd openbsd solaris
+
+package munkres
+
+import (
+	"os"
+	"syscall"
+	"unsafe"
+)
+
+// The greatest number of float64s viewed through an array pointer: 2^40
+// on 64-bit platforms and 2^27 on 32-bit ones.
+const maxMapped = 1 << (27 + 13*(^uint(0)>>63))
+
+// Map the file at path, which must hold exactly n float64s.
+//
+// return the mapping, and the float64s which it holds.
+func mmap(path string, n int) ([]byte, []float64, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		return nil, nil, err
+	}
+	if int64(n) != info.Size()/8 || info.Size()%8 != 0 {
+		return nil, nil, ErrorDimensionMismatch
+	}
+	if n == 0 {
+		return nil, nil, nil
+	}
+	if n > maxMapped {
+		return nil, nil, ErrorUnsupported
+	}
+	data, err := syscall.Mmap(int(f.Fd()), 0, 8*n, syscall.PROT_READ, syscall.MAP_SHARED)
+	if err != nil {
+		return nil, nil, err
+	}
+	// The mapping is page aligned, so it may be viewed as float64s.
+	costs := (*[maxMapped]float64)(unsafe.Pointer(&data[0]))[:n:n]
+	return data, costs, nil
+}
+
+func munmap(data []byte) error {
+	return syscall.Munmap(data)
+}
@@ -0,0 +1,96 @@
+package munkres
+
+// IsMonge returns whether a cost matrix satisfies the Monge condition,
+// that c[i][j] + c[i+1][j+1] <= c[i][j+1] + c[i+1][j] for every pair of
+// adjacent rows and columns, as costs which are convex functions of the
+// difference of positions on a line do. costMatrix is subject to the same
+// restrictions as for NewHungarianAlgorithm.
+func IsMonge(costMatrix [][]float64) (bool, error) {
+	if err := checkCostMatrix(costMatrix, false); err != nil {
+		return false, err
+	}
+	for i := 0; i+1 < len(costMatrix); i++ {
+		c, d := costMatrix[i], costMatrix[i+1]
+		for j := 0; j+1 < len(c); j++ {
+			if c[j]+d[j+1] > c[j+1]+d[j] {
+				return false, nil
+			}
+		}
+	}
+	return true, nil
+}
+
+// SolveMonge solves the assignment problem for a cost matrix, quickly if
+// it satisfies the Monge condition, as detected by IsMonge, and otherwise
+// by the Hungarian algorithm with the given options. A Monge matrix has
+// an optimal assignment in which the jobs increase with the workers, the
+// identity for a square matrix, which is found by dynamic programming in
+// time O(rows * cols) rather than O(n^3).
+//
+// costMatrix is subject to the same restrictions as for
+// NewHungarianAlgorithm.
+//
+// return the assignment as for Execute.
+func SolveMonge(costMatrix [][]float64, opts ...Option) ([]int, error) {
+	monge, err := IsMonge(costMatrix)
+	if err != nil {
+		return nil, err
+	}
+	if !monge {
+		h, err := NewHungarianAlgorithm(costMatrix, opts...)
+		if err != nil {
+			return nil, err
+		}
+		return h.Execute(), nil
+	}
+	rows, cols := len(costMatrix), 0
+	if rows > 0 {
+		cols = len(costMatrix[0])
+	}
+	if rows <= cols {
+		return monotone(rows, cols, func(w, j int) float64 { return costMatrix[w][j] }), nil
+	}
+	// The transpose of a Monge matrix is Monge.
+	workerByJob := monotone(cols, rows, func(j, w int) float64 { return costMatrix[w][j] })
+	result := unassigned(rows)
+	for j, w := range workerByJob {
+		result[w] = j
+	}
+	return result, nil
+}
+
+// return the least cost assignment of n workers to m >= n jobs in which
+// the jobs increase with the workers, where cost(i, k) is the cost of
+// assigning worker i to job k.
+func monotone(n, m int, cost func(i, k int) float64) []int {
+	// best[i] is the least cost of assigning the first i workers to the
+	// jobs considered so far, and took[i][k] whether that assigns worker
+	// i-1 to job k.
+	best := make([]float64, n+1)
+	took := make([][]bool, n+1)
+	for i := range took {
+		took[i] = make([]bool, m)
+	}
+	for k := 0; k < m; k++ {
+		// The first i workers fit in the first k+1 jobs only if i <= k+1.
+		hi := n
+		if k+1 < hi {
+			hi = k + 1
+		}
+		for i := hi; i >= 1; i-- {
+			c := best[i-1] + cost(i-1, k)
+			if i == k+1 || c < best[i] {
+				best[i] = c
+				took[i][k] = true
+			}
+		}
+	}
+	result := make([]int, n)
+	for i, k := n, m-1; i > 0; k-- {
+		if took[i][k] {
+			result[i-1] = k
+			i--
+		}
+	}
+	return result
+}
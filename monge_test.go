@@ -0,0 +1,66 @@
+package munkres_test
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/charles-haynes/munkres"
+)
+
+// return a Monge matrix of the squared distances between sorted random
+// points on a line.
+func mongeMatrix(rng *rand.Rand, rows, cols int) [][]float64 {
+	points := func(n int) []float64 {
+		p := make([]float64, n)
+		for i := range p {
+			p[i] = float64(rng.Intn(100))
+		}
+		sort.Float64s(p)
+		return p
+	}
+	x, y := points(rows), points(cols)
+	c := make([][]float64, rows)
+	for i := range c {
+		c[i] = make([]float64, cols)
+		for j := range c[i] {
+			c[i][j] = (x[i] - y[j]) * (x[i] - y[j])
+		}
+	}
+	return c
+}
+
+func TestIsMonge(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	if monge, err := munkres.IsMonge(mongeMatrix(rng, 5, 7)); err != nil || !monge {
+		t.Errorf("want Monge got %v (%v)", monge, err)
+	}
+	if monge, err := munkres.IsMonge([][]float64{{1, 0}, {0, 1}}); err != nil || monge {
+		t.Errorf("want not Monge got %v (%v)", monge, err)
+	}
+}
+
+func TestSolveMonge(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 200; i++ {
+		rows, cols := 1+rng.Intn(8), 1+rng.Intn(8)
+		c := mongeMatrix(rng, rows, cols)
+		if i%2 == 1 {
+			c = randomMatrix(rng, rows, cols)
+		}
+		h, _ := munkres.NewHungarianAlgorithm(c)
+		optimal := h.Execute()
+		want, _ := munkres.ComputeCost(c, optimal)
+		a, err := munkres.SolveMonge(c)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, err := munkres.ComputeCost(c, a); err != nil || math.Abs(got-want) > 1e-9 {
+			t.Errorf("%v: want cost %f got %f (%v)", c, want, got, err)
+		}
+		if count(a) != count(optimal) {
+			t.Errorf("%v: want %d assigned got %v", c, count(optimal), a)
+		}
+	}
+}
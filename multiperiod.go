@@ -0,0 +1,38 @@
+package munkres
+
+// SolveMultiPeriod solves a staffing problem over a horizon of time slots,
+// in which each job is done at most once across the whole horizon and each
+// worker does at most one job in each slot. As in the assignment problem,
+// as many jobs as possible are done: all of them if there are at least as
+// many worker-slots as jobs.
+//
+// costs[t][w][j] holds the cost of worker w doing job j in slot t. Every
+// slot must have the same number of workers and jobs.
+//
+// return the minimum cost schedule, where schedule[t][w] is the job done
+// by worker w in slot t, or -1 if the worker is idle in that slot.
+func SolveMultiPeriod(costs [][][]float64) ([][]int, error) {
+	if len(costs) == 0 {
+		return nil, nil
+	}
+	workers := len(costs[0])
+	// Stacking the slots gives one row for each worker in each slot, and
+	// the assignment problem then ensures each job is done only once.
+	var stacked [][]float64
+	for _, slot := range costs {
+		if len(slot) != workers {
+			return nil, ErrorDimensionMismatch
+		}
+		stacked = append(stacked, slot...)
+	}
+	h, err := NewHungarianAlgorithm(stacked)
+	if err != nil {
+		return nil, err
+	}
+	res := h.Execute()
+	schedule := make([][]int, len(costs))
+	for t := range schedule {
+		schedule[t] = res[t*workers : (t+1)*workers]
+	}
+	return schedule, nil
+}
@@ -0,0 +1,54 @@
+package munkres_test
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/charles-haynes/munkres"
+)
+
+func TestSolveMultiPeriod(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 50; i++ {
+		slots, workers, jobs := 1+rng.Intn(3), 1+rng.Intn(2), 1+rng.Intn(5)
+		costs := make([][][]float64, slots)
+		var stacked [][]float64
+		for s := range costs {
+			costs[s] = randomMatrix(rng, workers, jobs)
+			stacked = append(stacked, costs[s]...)
+		}
+		schedule, err := munkres.SolveMultiPeriod(costs)
+		if err != nil {
+			t.Fatal(err)
+		}
+		done := map[int]bool{}
+		cost := 0.0
+		for s := range schedule {
+			for w, j := range schedule[s] {
+				if j == -1 {
+					continue
+				}
+				if done[j] {
+					t.Fatalf("%v: job %d done twice", costs, j)
+				}
+				done[j] = true
+				cost += costs[s][w][j]
+			}
+		}
+		if want := slots * workers; len(done) != jobs && len(done) != want {
+			t.Errorf("%v: %d of %d jobs done", costs, len(done), jobs)
+		}
+		want, _ := bruteForceCost(stacked, func(w, j int) bool { return true })
+		if math.Abs(cost-want) > 1e-9 {
+			t.Errorf("%v: want cost = %f got %f", costs, want, cost)
+		}
+	}
+}
+
+func TestSolveMultiPeriodMismatch(t *testing.T) {
+	costs := [][][]float64{{{1, 2}}, {{1, 2}, {3, 4}}}
+	if _, err := munkres.SolveMultiPeriod(costs); err != munkres.ErrorDimensionMismatch {
+		t.Errorf("want err = %s got %s", munkres.ErrorDimensionMismatch, err)
+	}
+}
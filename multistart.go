@@ -0,0 +1,91 @@
+package munkres
+
+import (
+	"math/rand"
+	"sync"
+	"time"
+)
+
+// SolveMultiStart finds an approximate assignment within a time budget,
+// for problems where even the exact solver's worst case is too slow for
+// the latency allowed. It starts from the assignment of SolveGreedy and
+// then repeatedly constructs randomized greedy assignments, in which the
+// workers are taken in a random order and each takes its cheapest job not
+// yet taken, keeping the best found. At least one construction is made
+// whatever the budget, and the search stops early if the assignment is
+// shown to be optimal. The constructions run in parallel on the number of
+// goroutines set by WithParallelism, each drawing from its own source
+// seeded from rng.
+//
+// costMatrix is subject to the same restrictions as for
+// NewHungarianAlgorithm.
+//
+// return the assignment, which assigns as many workers as Execute would,
+// and its quality.
+func SolveMultiStart(costMatrix [][]float64, budget time.Duration, rng *rand.Rand, opts ...Option) (Assignment, Quality, error) {
+	deadline := time.Now().Add(budget)
+	best, q, err := SolveGreedy(costMatrix)
+	if err != nil {
+		return nil, Quality{}, err
+	}
+	rows, cols := len(costMatrix), 0
+	if rows > 0 {
+		cols = len(costMatrix[0])
+	}
+	o := newOptions(opts)
+	if err := o.check(rows, cols); err != nil {
+		return nil, Quality{}, err
+	}
+	goroutines := o.parallelism
+	if goroutines < 1 {
+		goroutines = 1
+	}
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	for g := 0; g < goroutines; g++ {
+		wg.Add(1)
+		go func(rng *rand.Rand) {
+			defer wg.Done()
+			for {
+				a := randomGreedy(costMatrix, rng)
+				cost := a.Cost(costMatrix)
+				mu.Lock()
+				if cost < q.Cost {
+					best, q.Cost, q.Gap = a, cost, cost-q.LowerBound
+				}
+				done := q.Gap <= 0
+				mu.Unlock()
+				if done || !time.Now().Before(deadline) {
+					return
+				}
+			}
+		}(rand.New(rand.NewSource(rng.Int63())))
+	}
+	wg.Wait()
+	return best, q, nil
+}
+
+// return a greedy assignment for the cost matrix taking the workers in
+// an order drawn from rng.
+func randomGreedy(costMatrix [][]float64, rng *rand.Rand) Assignment {
+	rows, cols := len(costMatrix), 0
+	if rows > 0 {
+		cols = len(costMatrix[0])
+	}
+	a := Assignment(unassigned(rows))
+	taken := make([]bool, cols)
+	for i, w := range rng.Perm(rows) {
+		if i == cols {
+			break
+		}
+		j := -1
+		for k, c := range costMatrix[w] {
+			if !taken[k] && (j == -1 || c < costMatrix[w][j]) {
+				j = k
+			}
+		}
+		a[w] = j
+		taken[j] = true
+	}
+	return a
+}
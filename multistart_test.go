@@ -0,0 +1,49 @@
+package munkres_test
+
+import (
+	"errors"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/charles-haynes/munkres"
+)
+
+func TestSolveMultiStart(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 100; i++ {
+		c := randomMatrix(rng, 1+rng.Intn(8), 1+rng.Intn(8))
+		a, q, err := munkres.SolveMultiStart(c, time.Millisecond, rng,
+			munkres.WithParallelism(1+i%4))
+		if err != nil {
+			t.Fatal(err)
+		}
+		checkApproximation(t, c, a, q)
+		_, greedy, _ := munkres.SolveGreedy(c)
+		if q.Cost > greedy.Cost {
+			t.Errorf("%v: cost %f worse than greedy %f", c, q.Cost, greedy.Cost)
+		}
+	}
+}
+
+func TestSolveMultiStartImproves(t *testing.T) {
+	// Greedy takes the cheap pair and is left with the expensive one.
+	c := [][]float64{{1, 2}, {2, 10}}
+	a, q, err := munkres.SolveMultiStart(c, time.Second, rand.New(rand.NewSource(1)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if q.Cost != 4 || a[0] != 1 || a[1] != 0 {
+		t.Errorf("want [1 0] cost 4 got %v cost %f", a, q.Cost)
+	}
+}
+
+func TestSolveMultiStartErrors(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	if _, _, err := munkres.SolveMultiStart([][]float64{{1, 2}, {3}}, 0, rng); !errors.Is(err, munkres.ErrorIrregularCostMatrix) {
+		t.Errorf("want %v got %v", munkres.ErrorIrregularCostMatrix, err)
+	}
+	if _, _, err := munkres.SolveMultiStart([][]float64{{1}}, 0, rng, munkres.WithParallelism(-1)); err != munkres.ErrorInvalidOption {
+		t.Errorf("want %v got %v", munkres.ErrorInvalidOption, err)
+	}
+}
@@ -46,8 +46,11 @@ https://github.com/KevinStern/software-and-algorithms/
 package munkres
 
 import (
+	"context"
 	"errors"
 	"math"
+	"sort"
+	"time"
 )
 
 var
@@ -56,17 +59,82 @@ ErrorIrregularCostMatrix,
 	// The cost matrix must not contain any infinities
 	ErrorInfiniteCost,
 	// The cost matrix must not contain any NaNs
-	ErrorNaNCost error
+	ErrorNaNCost,
+	// Per-worker or per-job quantities must have one entry for each
+	// worker or job of the cost matrix
+	ErrorDimensionMismatch,
+	// Capacities and demands must not be negative
+	ErrorNegativeQuantity,
+	// The constraints of the problem cannot all be satisfied
+	ErrorInfeasible,
+	// Each edge may only be given once
+	ErrorDuplicateEdge,
+	// The value given to an option is out of range
+	ErrorInvalidOption,
+	// The operation is not supported on this platform
+	ErrorUnsupported,
+	// The cost matrix of a general graph must be symmetric
+	ErrorAsymmetricCostMatrix,
+	// The lower bound of an interval must not exceed its upper bound
+	ErrorInvalidInterval,
+	// The solve was stopped before it completed
+	ErrorStopped,
+	// A resume token must come from an interrupted solve of the same
+	// problem
+	ErrorInvalidResumeToken,
+	// Under StrictValidation the cost matrix must not contain any
+	// negative costs
+	ErrorNegativeCost,
+	// The internal state of the algorithm broke one of its invariants,
+	// such as by overflowing to an infinite or NaN label
+	ErrorInconsistent,
+	// An assignment must not give a job to more than one worker
+	ErrorDuplicateJob,
+	// An assignment must not give a worker more than one job
+	ErrorDuplicateWorker,
+	// The assignment must assign as many workers as an optimal one
+	ErrorIncompleteAssignment,
+	// A solver's solution must satisfy the model it solves
+	ErrorInvalidSolution,
+	// A replayed solve must repeat its trace
+	ErrorReplayMismatch,
+	// Fixed-point costs must be small enough that their sums cannot
+	// overflow
+	ErrorCostOverflow,
+	// The costs of SolveCosts must not be nil
+	ErrorNilCost error
 
 type HungarianAlgorithm struct {
-	costMatrix                         [][]float64
+	// The padded square cost matrix, in row-major order.
+	costs                              []float64
 	rows, cols, dim                    int
 	labelByWorker, labelByJob          []float64
+	reductionByWorker, reductionByJob  []float64
+	scaleByWorker, scaleByJob          []float64
 	minSlackWorkerByJob                []int
 	minSlackValueByJob                 []float64
 	matchJobByWorker, matchWorkerByJob []int
 	parentWorkerByCommittedJob         []int
+	uncommittedJobs                    []int
 	committedWorkers                   []bool
+	resultByWorker                     []int
+	opts                               options
+	backups                            []Backup
+	progress                           *progress
+	// Whether a solve was stopped part way, leaving its state to be
+	// continued.
+	interrupted bool
+	// The number of label updates in the current phase, for PhaseInfo.
+	labelUpdates int
+	// The range of the label updates, for NumericalReport.
+	updates updateRange
+	// The slack within which a pair is taken as tight, set
+	// WithAutoEpsilon.
+	epsilon float64
+	// The workers and jobs marked by MarkDirty, or nil if none are.
+	dirtyWorkers, dirtyJobs []bool
+	// The subscriber set by WithSubscriber while solving, or nil.
+	events Subscriber
 }
 
 // Construct an instance of the algorithm.
@@ -74,89 +142,338 @@ type HungarianAlgorithm struct {
 // costMatrix is the cost matrix, where matrix[i][j] holds the cost of
 // assigning worker i to job j, for all i, j. The cost matrix must not
 // be irregular in the sense that all rows must be the same length; in
-// addition, all entries must be non-infinite numbers.
-func NewHungarianAlgorithm(costMatrix [][]float64) (HungarianAlgorithm, error) {
-	dim := len(costMatrix)
-	if dim == 0 {
-		return HungarianAlgorithm{}, nil
+// addition, all entries must be non-infinite numbers, unless Forbidden
+// entries are allowed WithForbidden.
+//
+// opts are optional settings, such as WithBackups, which change what the
+// algorithm computes in addition to the optimal assignment.
+func NewHungarianAlgorithm(costMatrix [][]float64, opts ...Option) (HungarianAlgorithm, error) {
+	return NewHungarianAlgorithmOf(costMatrix, opts...)
+}
+
+// Construct an instance of the algorithm for costMatrix with the options
+// o, as NewHungarianAlgorithm does.
+func newHungarianAlgorithmFor(costMatrix [][]float64, o options) (HungarianAlgorithm, error) {
+	if o.maximize {
+		costMatrix = negate(costMatrix)
 	}
-	if len(costMatrix[0]) > dim {
-		dim = len(costMatrix[0])
+	if o.sanitize != nil {
+		if !o.sanitize.valid() {
+			return HungarianAlgorithm{}, ErrorInvalidOption
+		}
+		costMatrix = o.sanitize.sanitize(costMatrix)
 	}
-	this := HungarianAlgorithm{
-		costMatrix:                 make([][]float64, dim),
-		rows:                       len(costMatrix),
-		cols:                       len(costMatrix[0]),
-		dim:                        dim,
-		labelByWorker:              make([]float64, dim),
-		labelByJob:                 make([]float64, dim),
-		minSlackWorkerByJob:        make([]int, dim),
-		minSlackValueByJob:         make([]float64, dim),
-		committedWorkers:           make([]bool, dim),
-		parentWorkerByCommittedJob: make([]int, dim),
-		matchJobByWorker:           make([]int, dim),
-		matchWorkerByJob:           make([]int, dim),
-	}
-	for w := 0; w < dim; w++ {
-		this.costMatrix[w] = make([]float64, dim)
-		if w >= len(costMatrix) {
-			continue
+	return newHungarianAlgorithmFrom(costMatrix, o)
+}
+
+// Construct an instance of the algorithm for costMatrix with the options
+// o, whose profits are already negated and whose entries are already
+// sanitized as o requires.
+func newHungarianAlgorithmFrom(costMatrix [][]float64, o options) (HungarianAlgorithm, error) {
+	if o.validation == PermissiveValidation {
+		costMatrix = o.permit(costMatrix)
+	}
+	if err := checkCostMatrix(costMatrix, o.forbidden); err != nil {
+		return HungarianAlgorithm{}, err
+	}
+	if o.validation == StrictValidation {
+		for w := range costMatrix {
+			if err := checkStrictRow(w, costMatrix[w]); err != nil {
+				return HungarianAlgorithm{}, err
+			}
 		}
-		if len(costMatrix[w]) != this.cols {
-			return this, ErrorIrregularCostMatrix
+	}
+	rows, cols := len(costMatrix), 0
+	if rows > 0 {
+		cols = len(costMatrix[0])
+	}
+	if err := o.check(rows, cols); err != nil {
+		return HungarianAlgorithm{}, err
+	}
+	if o.monotone {
+		if monge, _ := IsMonge(costMatrix); !monge {
+			return HungarianAlgorithm{}, ErrorInvalidOption
 		}
-		for j := range costMatrix[w] {
-			if math.IsInf(costMatrix[w][j], 0) {
-				return this, ErrorInfiniteCost
-			}
-			if math.IsNaN(costMatrix[w][j]) {
-				return this, ErrorNaNCost
-			}
+	}
+	this := newHungarianAlgorithm(rows, cols, o)
+	for w := range costMatrix {
+		copy(this.row(w), costMatrix[w])
+		if o.rankTransform {
+			rankRow(this.row(w), cols)
 		}
-		copy(this.costMatrix[w], costMatrix[w])
+	}
+	return this, nil
+}
+
+// Allocate an instance of the algorithm for a cost matrix with the given
+// number of rows and columns, padded with zeros to be square.
+func newHungarianAlgorithm(rows, cols int, o options) HungarianAlgorithm {
+	dim := rows
+	p := o.progress
+	if p == nil {
+		p = &progress{}
+	}
+	*p = progress{report: o.report}
+	if dim == 0 {
+		return HungarianAlgorithm{opts: o, progress: p}
+	}
+	if cols > dim {
+		dim = cols
+	}
+	a := o.allocator
+	this := HungarianAlgorithm{
+		costs:                      a.Float64s(dim * dim),
+		rows:                       rows,
+		cols:                       cols,
+		dim:                        dim,
+		labelByWorker:              a.Float64s(dim),
+		labelByJob:                 a.Float64s(dim),
+		reductionByWorker:          a.Float64s(dim),
+		reductionByJob:             a.Float64s(dim),
+		minSlackWorkerByJob:        a.Ints(dim),
+		minSlackValueByJob:         a.Float64s(dim),
+		committedWorkers:           a.Bools(dim),
+		parentWorkerByCommittedJob: a.Ints(dim),
+		uncommittedJobs:            a.Ints(dim)[:0],
+		matchJobByWorker:           a.Ints(dim),
+		matchWorkerByJob:           a.Ints(dim),
+		resultByWorker:             a.Ints(rows),
+		opts:                       o,
+		progress:                   p,
 	}
 	for i := 0; i < dim; i++ {
 		this.matchJobByWorker[i] = -1
 		this.matchWorkerByJob[i] = -1
 	}
-	return this, nil
+	return this
+}
+
+// return row w of the padded square cost matrix.
+func (h *HungarianAlgorithm) row(w int) []float64 {
+	return h.costs[w*h.dim : (w+1)*h.dim : (w+1)*h.dim]
+}
+
+// Check that the cost matrix is rectangular and that all of its entries
+// are non-infinite numbers, except that +Inf entries, which forbid the
+// assignment of the worker to the job, are allowed if forbidden is true.
+func checkCostMatrix(costMatrix [][]float64, forbidden bool) error {
+	for w := range costMatrix {
+		if len(costMatrix[w]) != len(costMatrix[0]) {
+			return &ShapeError{Row: w, Cols: len(costMatrix[w]), Want: len(costMatrix[0])}
+		}
+		if err := checkRow(w, costMatrix[w], forbidden); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Check the entries of row w of a cost matrix as for checkCostMatrix.
+func checkRow(w int, row []float64, forbidden bool) error {
+	for j, c := range row {
+		if err := checkCost(w, j, c, forbidden); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 // Compute an initial feasible solution by assigning zero labels to the
 // workers and by assigning to each job a label equal to the minimum cost
-// among its incident edges.
+// among its incident edges, or zero if they are all forbidden.
 func (h *HungarianAlgorithm) computeInitialFeasibleSolution() {
+	if h.monotone() {
+		for j := range h.labelByJob {
+			h.labelByJob[j] = 0
+		}
+		return
+	}
 	for j := range h.labelByJob {
 		h.labelByJob[j] = math.Inf(1)
 	}
-	for w := 0; w < h.dim; w++ {
-		for j := 0; j < h.dim; j++ {
-			if h.costMatrix[w][j] < h.labelByJob[j] {
-				h.labelByJob[j] = h.costMatrix[w][j]
-			}
+	h.parallel(h.dim, (*HungarianAlgorithm).labelJobs)
+	for j := range h.labelByJob {
+		if math.IsInf(h.labelByJob[j], 1) {
+			h.labelByJob[j] = 0
 		}
 	}
 }
 
+// Set the labels of jobs lo to hi to the minimum of their costs.
+func (h *HungarianAlgorithm) labelJobs(lo, hi int) {
+	for w := 0; w < h.dim; w++ {
+		minInto(h.labelByJob[lo:hi], h.row(w)[lo:hi])
+	}
+}
+
 // Execute the algorithm.
 //
 // return the minimum cost matching of workers to jobs based upon the
 // provided cost matrix. A matching value of -1 indicates that the
-// corresponding worker is unassigned.
+// corresponding worker is unassigned. If the solve is stopped by Stop,
+// or fails as reported by Solve, nil is returned.
+//
+// The matching is returned in a slice allocated with the algorithm, and
+// held by it, so that Execute allocates nothing for a matrix which is
+// not far from square, unless options such as WithBackups or
+// WithWorkerGroups need more. Each call returns the same slice.
 func (h *HungarianAlgorithm) Execute() []int {
-	// Heuristics to improve performance: Reduce rows and columns
-	// by their smallest element, compute an initial non-zero dual
-	// feasible solution and create a greedy matching from workers
-	// to jobs of the cost matrix.
-	h.reduce()
-	h.computeInitialFeasibleSolution()
-	h.greedyMatch()
+	result, _ := h.execute()
+	return result
+}
+
+// Solve executes the algorithm as Execute does, but reports why it
+// returns no assignment: ErrorStopped if it is stopped by Stop, or
+// ErrorInconsistent if the algorithm finds its own invariants broken, as
+// costs near the limits of float64 can do, rather than return a wrong
+// assignment.
+func (h *HungarianAlgorithm) Solve() ([]int, error) {
+	return h.execute()
+}
+
+// Execute the algorithm with the configured options.
+//
+// return the assignment, or ErrorInfeasible if forbidden assignments
+// leave no assignment of as many workers as Execute would assign.
+func (h *HungarianAlgorithm) execute() ([]int, error) {
+	return h.executeContext(context.Background())
+}
+
+// Execute the algorithm, stopping between phases if ctx is done or Stop
+// is called.
+func (h *HungarianAlgorithm) executeContext(ctx context.Context) (result []int, err error) {
+	if h.opts.metrics != nil {
+		defer func(start time.Time) { h.observe(start, err) }(time.Now())
+	}
+	if h.opts.partial {
+		defer func() {
+			if err == ErrorInfeasible {
+				result, err = h.executePartial(), nil
+			}
+		}()
+	}
+	if err := h.stopping(ctx); err != nil {
+		return nil, err
+	}
+	// A solved instance continues from its solution, rather than reducing
+	// its reduced costs again, which would lose the reductions recorded.
+	h.keepSolution()
+	if h.opts.workerGroups != nil {
+		return h.executeGroups(), nil
+	}
+	if h.opts.scipy {
+		if !h.executeScipy() {
+			return nil, ErrorInfeasible
+		}
+		return h.result(), nil
+	}
+	if h.rectangular() && !h.interrupted && !h.opts.debugChecks {
+		if err := h.executeRectangular(ctx); err != nil {
+			return nil, err
+		}
+		return h.result(), nil
+	}
+	if h.integral() {
+		if err := h.executeIntegers(ctx); err != nil {
+			return nil, err
+		}
+		return h.result(), nil
+	}
+	if err := h.run(ctx); err != nil {
+		return nil, err
+	}
+	if h.opts.backups {
+		h.computeBackups()
+	}
+	return h.result(), nil
+}
+
+// Run the algorithm to completion, leaving the optimal matching and a
+// feasible labeling in the internal state, or continue an interrupted
+// run.
+//
+// return ErrorInfeasible if some phase could not find an augmenting path,
+// which can only happen when the cost matrix contains forbidden (infinite)
+// entries, ErrorInconsistent if it fails otherwise or leaves a broken
+// matching, or the error from stopping if the run is interrupted.
+func (h *HungarianAlgorithm) run(ctx context.Context) error {
+	h.events = h.opts.subscriber
+	defer func() { h.events = nil }()
+	if !h.interrupted {
+		// Heuristics to improve performance: Reduce rows and
+		// columns by their smallest element, compute an initial
+		// non-zero dual feasible solution and create a greedy
+		// matching from workers to jobs of the cost matrix.
+		// Any earlier solve failed, so start afresh from its costs,
+		// whose reductions and scaling these add to.
+		for w := 0; w < h.dim; w++ {
+			h.labelByWorker[w] = 0
+			h.matchJobByWorker[w], h.matchWorkerByJob[w] = -1, -1
+		}
+		if h.opts.sinkhorn.iterations > 0 {
+			h.scale()
+		}
+		h.progress.start(h.dim)
+		h.reduce()
+		if h.opts.autoEpsilon {
+			h.epsilon = h.autoEpsilon()
+		}
+		h.computeInitialFeasibleSolution()
+		h.greedyMatch()
+		h.progress.setMatched(h.matched())
+		if h.opts.debugChecks {
+			if err := h.checkInvariants(0); err != nil {
+				return err
+			}
+		}
+	}
+	h.interrupted = false
 
 	for w := h.fetchUnmatchedWorker(); w < h.dim; w = h.fetchUnmatchedWorker() {
+		if err := h.stopping(ctx); err != nil {
+			h.interrupted = true
+			return err
+		}
 		h.initializePhase(w)
-		h.executePhase()
+		start := h.beginPhase(w)
+		ok := h.executePhase()
+		h.endPhase(w, start)
+		if !ok {
+			if !h.opts.forbidden {
+				return ErrorInconsistent
+			}
+			return ErrorInfeasible
+		}
+		h.progress.phase()
+		if h.opts.debugChecks {
+			if err := h.checkInvariants(h.Snapshot().Phases); err != nil {
+				return err
+			}
+		}
 	}
-	result := h.matchJobByWorker[:h.rows]
+	return h.checkMatching()
+}
+
+// return ErrorInconsistent unless the matching is a perfect matching of
+// the padded matrix and the labels are numbers.
+func (h *HungarianAlgorithm) checkMatching() error {
+	for w, j := range h.matchJobByWorker {
+		if j < 0 || j >= h.dim || h.matchWorkerByJob[j] != w {
+			return ErrorInconsistent
+		}
+		if math.IsNaN(h.labelByWorker[w]) || math.IsNaN(h.labelByJob[j]) {
+			return ErrorInconsistent
+		}
+	}
+	return nil
+}
+
+// return the current matching of the original workers, with workers
+// matched to padding jobs reported as unassigned, in a slice held by the
+// algorithm, so that returning it allocates nothing.
+func (h *HungarianAlgorithm) result() []int {
+	result := h.resultByWorker
+	copy(result, h.matchJobByWorker[:h.rows])
 	for w := range result {
 		if result[w] >= h.cols {
 			result[w] = -1
@@ -183,21 +500,51 @@ func (h *HungarianAlgorithm) Execute() []int {
 // accomplished in time O(n) by maintaining the minimum slack values
 // among non-committed jobs. When a phase completes, the matching will
 // have increased in size.
-func (h *HungarianAlgorithm) executePhase() {
+//
+// return false if no augmenting path exists because every remaining
+// slack is infinite.
+func (h *HungarianAlgorithm) executePhase() bool {
 	for {
-		minSlackWorker := -1
-		minSlackJob := -1
-		minSlackValue := math.Inf(1)
-		for j := 0; j < h.dim; j++ {
-			if h.parentWorkerByCommittedJob[j] == -1 {
-				if h.minSlackValueByJob[j] < minSlackValue {
-					minSlackValue = h.minSlackValueByJob[j]
-					minSlackWorker = h.minSlackWorkerByJob[j]
-					minSlackJob = j
+		var minSlackIndex, minSlackJob int
+		var minSlackValue float64
+		if h.dim >= maskedScanDim && 8*len(h.uncommittedJobs) >= 7*h.dim {
+			// While few jobs of a large matrix are committed, scan
+			// the slack of every job, masked by their parents, and
+			// find the position of the least in the list of
+			// uncommitted jobs, which is in order of job.
+			minSlackValue, minSlackJob = minIndexMasked(h.minSlackValueByJob,
+				h.parentWorkerByCommittedJob)
+			if minSlackJob == -1 {
+				return false
+			}
+			minSlackIndex = sort.SearchInts(h.uncommittedJobs, minSlackJob)
+		} else {
+			// Track only the position of the minimum among the
+			// uncommitted jobs, so that the loop body compiles
+			// to conditional moves rather than branches.
+			minSlackIndex = -1
+			minSlackValue = math.Inf(1)
+			for i, j := range h.uncommittedJobs {
+				if v := h.minSlackValueByJob[j]; v < minSlackValue {
+					minSlackValue = v
+					minSlackIndex = i
 				}
 			}
+			if minSlackIndex == -1 {
+				return false
+			}
+			minSlackJob = h.uncommittedJobs[minSlackIndex]
+		}
+		minSlackWorker := h.minSlackWorkerByJob[minSlackJob]
+		if h.rejected(minSlackWorker, minSlackJob) {
+			h.recomputeSlack(minSlackJob)
+			continue
 		}
-		if minSlackValue > 0 {
+		// Remove the job preserving order, so that ties are broken
+		// in favour of the lowest job.
+		h.uncommittedJobs = append(h.uncommittedJobs[:minSlackIndex],
+			h.uncommittedJobs[minSlackIndex+1:]...)
+		if minSlackValue > h.epsilon {
 			h.updateLabeling(minSlackValue)
 		}
 		h.parentWorkerByCommittedJob[minSlackJob] = minSlackWorker
@@ -214,21 +561,18 @@ func (h *HungarianAlgorithm) executePhase() {
 				}
 				parentWorker = h.parentWorkerByCommittedJob[committedJob]
 			}
-			return
+			return true
 		} else {
 			// Update slack values since we increased the
 			// size of the committed workers set.
 			worker := h.matchWorkerByJob[minSlackJob]
 			h.committedWorkers[worker] = true
-			for j := 0; j < h.dim; j++ {
-				if h.parentWorkerByCommittedJob[j] == -1 {
-					slack := h.costMatrix[worker][j] -
-						h.labelByWorker[worker] -
-						h.labelByJob[j]
-					if h.minSlackValueByJob[j] > slack {
-						h.minSlackValueByJob[j] = slack
-						h.minSlackWorkerByJob[j] = worker
-					}
+			row, label := h.row(worker), h.labelByWorker[worker]
+			for _, j := range h.uncommittedJobs {
+				slack := row[j] - label - h.labelByJob[j]
+				if h.minSlackValueByJob[j] > slack {
+					h.minSlackValueByJob[j] = slack
+					h.minSlackWorkerByJob[j] = worker
 				}
 			}
 		}
@@ -247,20 +591,43 @@ func (h *HungarianAlgorithm) fetchUnmatchedWorker() int {
 
 // Find a valid matching by greedily selecting among zero-cost
 // matchings. This is a heuristic to jump-start the augmentation
-// algorithm.
+// algorithm. The first zero-cost matching of each worker is found in
+// parallel; only if that job has already been taken is the rest of the
+// worker's row searched.
 func (h *HungarianAlgorithm) greedyMatch() {
+	// The slack arrays are not used until the first phase, so the
+	// first zeros are held in one of them.
+	firstZero := h.minSlackWorkerByJob
+	h.parallel(h.dim, (*HungarianAlgorithm).findFirstZeros)
 	for w := 0; w < h.dim; w++ {
-		for j := 0; j < h.dim; j++ {
-			if h.matchJobByWorker[w] == -1 &&
-				h.matchWorkerByJob[j] == -1 &&
-				h.costMatrix[w][j]-h.labelByWorker[w]-
-					h.labelByJob[j] == 0 {
+		for j := firstZero[w]; j < h.dim; j = h.nextZero(w, j+1) {
+			if h.matchWorkerByJob[j] == -1 && !h.rejected(w, j) {
 				h.match(w, j)
+				break
 			}
 		}
 	}
 }
 
+// Record the first job with zero slack for each of workers lo to hi, for
+// greedyMatch.
+func (h *HungarianAlgorithm) findFirstZeros(lo, hi int) {
+	for w := lo; w < hi; w++ {
+		h.minSlackWorkerByJob[w] = h.nextZero(w, 0)
+	}
+}
+
+// return the first job from j on with zero slack for worker w, or dim if
+// there is none.
+func (h *HungarianAlgorithm) nextZero(w, j int) int {
+	for ; j < h.dim; j++ {
+		if math.Abs(h.costs[w*h.dim+j]-h.labelByWorker[w]-h.labelByJob[j]) <= h.epsilon {
+			break
+		}
+	}
+	return j
+}
+
 // Initialize the next phase of the algorithm by clearing the
 // committed workers and jobs sets and by initializing the slack
 // arrays to the values corresponding to the specified root worker.
@@ -270,20 +637,24 @@ func (h *HungarianAlgorithm) initializePhase(w int) {
 	for i := range h.committedWorkers {
 		h.committedWorkers[i] = false
 	}
+	h.uncommittedJobs = h.uncommittedJobs[:0]
 	for i := range h.parentWorkerByCommittedJob {
 		h.parentWorkerByCommittedJob[i] = -1
+		h.uncommittedJobs = append(h.uncommittedJobs, i)
 	}
 	h.committedWorkers[w] = true
-	for j := 0; j < h.dim; j++ {
-		h.minSlackValueByJob[j] = h.costMatrix[w][j] -
-			h.labelByWorker[w] -
-			h.labelByJob[j]
+	slackInto(h.minSlackValueByJob, h.row(w), h.labelByWorker[w],
+		h.labelByJob)
+	for j := range h.minSlackWorkerByJob {
 		h.minSlackWorkerByJob[j] = w
 	}
 }
 
 // Helper method to record a matching between worker w and job j.
 func (h *HungarianAlgorithm) match(w, j int) {
+	if h.events != nil {
+		h.publishMatch(w, j)
+	}
 	h.matchJobByWorker[w] = j
 	h.matchWorkerByJob[j] = w
 }
@@ -291,34 +662,53 @@ func (h *HungarianAlgorithm) match(w, j int) {
 // Reduce the cost matrix by subtracting the smallest element of each row from
 // all elements of the row as well as the smallest element of each column from
 // all elements of the column. Note that an optimal assignment for a reduced
-// cost matrix is optimal for the original cost matrix.
+// cost matrix is optimal for the original cost matrix. The amounts
+// subtracted are recorded so that the original costs and duals can be
+// recovered.
 func (h *HungarianAlgorithm) reduce() {
-	for w := 0; w < h.dim; w++ {
-		min := math.Inf(1)
-		for j := 0; j < h.dim; j++ {
-			if h.costMatrix[w][j] < min {
-				min = h.costMatrix[w][j]
-			}
-		}
-		for j := 0; j < h.dim; j++ {
-			h.costMatrix[w][j] -= min
+	if h.monotone() {
+		h.reduceMonotone()
+		return
+	}
+	h.parallel(h.dim, (*HungarianAlgorithm).reduceRows)
+	h.parallel(h.dim, (*HungarianAlgorithm).reduceColumns)
+}
+
+// Reduce rows lo to hi by their smallest elements.
+func (h *HungarianAlgorithm) reduceRows(lo, hi int) {
+	for w := lo; w < hi; w++ {
+		min := minOf(h.row(w))
+		if math.IsInf(min, 1) {
+			// Every job is forbidden to this worker.
+			min = 0
 		}
+		subConst(h.row(w), min)
+		h.reductionByWorker[w] += min
 	}
-	min := make([]float64, h.dim)
-	for j := 0; j < h.dim; j++ {
+}
+
+// Reduce columns lo to hi by their smallest elements.
+func (h *HungarianAlgorithm) reduceColumns(lo, hi int) {
+	// The slack values are not used until the first phase, so the minima
+	// are held in them.
+	min := h.minSlackValueByJob
+	for j := lo; j < hi; j++ {
 		min[j] = math.Inf(1)
 	}
 	for w := 0; w < h.dim; w++ {
-		for j := 0; j < h.dim; j++ {
-			if h.costMatrix[w][j] < min[j] {
-				min[j] = h.costMatrix[w][j]
-			}
+		minInto(min[lo:hi], h.row(w)[lo:hi])
+	}
+	for j := lo; j < hi; j++ {
+		if math.IsInf(min[j], 1) {
+			// Every worker is forbidden this job.
+			min[j] = 0
 		}
 	}
 	for w := 0; w < h.dim; w++ {
-		for j := 0; j < h.dim; j++ {
-			h.costMatrix[w][j] -= min[j]
-		}
+		subInto(h.row(w)[lo:hi], min[lo:hi])
+	}
+	for j := lo; j < hi; j++ {
+		h.reductionByJob[j] += min[j]
 	}
 }
 
@@ -326,6 +716,11 @@ func (h *HungarianAlgorithm) reduce() {
 // committed workers and by subtracting the slack value for committed jobs. In
 // addition, update the minimum slack values appropriately.
 func (h *HungarianAlgorithm) updateLabeling(slack float64) {
+	h.labelUpdates++
+	h.updates.observe(slack)
+	if h.events != nil {
+		h.events.LabelsUpdated(slack)
+	}
 	for w := 0; w < h.dim; w++ {
 		if h.committedWorkers[w] {
 			h.labelByWorker[w] += slack
@@ -344,6 +739,25 @@ func init() {
 	ErrorIrregularCostMatrix = errors.New("Irregular cost matrix")
 	ErrorInfiniteCost = errors.New("Infinite cost")
 	ErrorNaNCost = errors.New("NaN cost")
+	ErrorDimensionMismatch = errors.New("Dimension mismatch")
+	ErrorNegativeQuantity = errors.New("Negative quantity")
+	ErrorInfeasible = errors.New("Infeasible problem")
+	ErrorDuplicateEdge = errors.New("Duplicate edge")
+	ErrorInvalidOption = errors.New("Invalid option")
+	ErrorUnsupported = errors.New("Unsupported on this platform")
+	ErrorAsymmetricCostMatrix = errors.New("Asymmetric cost matrix")
+	ErrorInvalidInterval = errors.New("Invalid interval")
+	ErrorStopped = errors.New("Stopped")
+	ErrorInvalidResumeToken = errors.New("Invalid resume token")
+	ErrorNegativeCost = errors.New("Negative cost")
+	ErrorInconsistent = errors.New("Internal inconsistency")
+	ErrorDuplicateJob = errors.New("Duplicate job")
+	ErrorDuplicateWorker = errors.New("Duplicate worker")
+	ErrorIncompleteAssignment = errors.New("Incomplete assignment")
+	ErrorInvalidSolution = errors.New("Invalid solution")
+	ErrorReplayMismatch = errors.New("Replay mismatch")
+	ErrorCostOverflow = errors.New("Cost overflow")
+	ErrorNilCost = errors.New("Nil cost")
 }
 
 /* Example
@@ -1,32 +1,19 @@
 package munkres_test
 
 import (
+	"errors"
 	"fmt"
 	"math"
+	"math/rand"
 	"reflect"
+	"strconv"
 	"testing"
+	"time"
 
 	"github.com/charles-haynes/munkres"
+	"github.com/charles-haynes/munkres/generate"
 )
 
-func computeCost(matrix [][]float64, match []int) (float64, error) {
-	result := 0.0
-	visited := map[int]int{}
-	for i := 0; i < len(matrix); i++ {
-		if match[i] == -1 {
-			continue
-		}
-		if _, ok := visited[match[i]]; ok {
-			return 0.0, fmt.Errorf(
-				"workers %d and %d have the same job",
-				match[i], i)
-		}
-		visited[match[i]] = i
-		result += matrix[i][match[i]]
-	}
-	return result, nil
-}
-
 type test struct {
 	name       string
 	costMatrix [][]float64
@@ -166,7 +153,7 @@ func TestAbs(t *testing.T) {
 	tests := append(tests, CreateTest(100))
 	for _, d := range tests {
 		h, err := munkres.NewHungarianAlgorithm(d.costMatrix)
-		if err != d.err {
+		if !errors.Is(err, d.err) {
 			t.Errorf("%s: want err = %s got %s",
 				d.name, d.err, err)
 		}
@@ -178,7 +165,7 @@ func TestAbs(t *testing.T) {
 			t.Errorf("%s: want res = %v got %v",
 				d.name, d.res, res)
 		}
-		cost, err := computeCost(d.costMatrix, res)
+		cost, err := munkres.ComputeCost(d.costMatrix, res)
 		if err != nil {
 			t.Errorf("%s: %s", d.name, err)
 		}
@@ -188,3 +175,134 @@ func TestAbs(t *testing.T) {
 		}
 	}
 }
+
+func TestSolve(t *testing.T) {
+	h, err := munkres.NewHungarianAlgorithm([][]float64{{4, 1}, {2, 3}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := h.Solve()
+	if err != nil || !reflect.DeepEqual(res, []int{1, 0}) {
+		t.Errorf("want [1 0] got %v, %v", res, err)
+	}
+	// Reducing costs this far apart overflows to NaN slacks, which
+	// must be reported rather than returned as an assignment.
+	m := math.MaxFloat64
+	h, err = munkres.NewHungarianAlgorithm([][]float64{{m, -m}, {m, -m}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res, err := h.Solve(); res != nil || err != munkres.ErrorInconsistent {
+		t.Errorf("want err = %s got %v, %v", munkres.ErrorInconsistent, res, err)
+	}
+}
+
+func TestExecuteAllocations(t *testing.T) {
+	c := benchmarkMatrix(50)
+	solvers := make([]munkres.HungarianAlgorithm, 101)
+	for i := range solvers {
+		solvers[i], _ = munkres.NewHungarianAlgorithm(c)
+	}
+	i := 0
+	if allocs := testing.AllocsPerRun(100, func() {
+		solvers[i].Execute()
+		i++
+	}); allocs != 0 {
+		t.Errorf("want no allocations got %v", allocs)
+	}
+}
+
+// return the minimum cost of any assignment for matrix, found by
+// enumerating every matching of the square padded matrix, among those
+// for which allowed(w, j) holds for every assigned pair; and false if
+// there is no such assignment.
+func bruteForceCost(matrix [][]float64, allowed func(w, j int) bool) (float64, bool) {
+	rows := len(matrix)
+	if rows == 0 {
+		return 0.0, true
+	}
+	cols := len(matrix[0])
+	dim := rows
+	if cols > dim {
+		dim = cols
+	}
+	best, found := math.Inf(1), false
+	used := make([]bool, dim)
+	var visit func(w int, cost float64)
+	visit = func(w int, cost float64) {
+		if w == dim {
+			if cost < best {
+				best = cost
+			}
+			found = true
+			return
+		}
+		for j := 0; j < dim; j++ {
+			if used[j] {
+				continue
+			}
+			c := 0.0
+			if w < rows && j < cols {
+				if !allowed(w, j) {
+					continue
+				}
+				c = matrix[w][j]
+			}
+			used[j] = true
+			visit(w+1, cost+c)
+			used[j] = false
+		}
+	}
+	visit(0, 0.0)
+	return best, found
+}
+
+// return a rows x cols matrix of small random integer costs, so that
+// ties are common.
+func randomMatrix(rng *rand.Rand, rows, cols int) [][]float64 {
+	matrix := make([][]float64, rows)
+	for w := range matrix {
+		matrix[w] = make([]float64, cols)
+		for j := range matrix[w] {
+			matrix[w][j] = float64(rng.Intn(10))
+		}
+	}
+	return matrix
+}
+
+// Each phase of the algorithm matches one more worker, so the time per
+// worker bounds the time per phase.
+func BenchmarkExecute(b *testing.B) {
+	for _, n := range []int{100, 300, 1000} {
+		c := benchmarkMatrix(n)
+		b.Run(strconv.Itoa(n), func(b *testing.B) {
+			b.ReportAllocs()
+			start := time.Now()
+			for i := 0; i < b.N; i++ {
+				h, _ := munkres.NewHungarianAlgorithm(c)
+				h.Execute()
+			}
+			b.ReportMetric(float64(time.Since(start).Nanoseconds())/float64(b.N*n), "ns/worker")
+		})
+	}
+}
+
+// Adversarial instances, so that regressions on them are caught here.
+func BenchmarkAdversarial(b *testing.B) {
+	rng := rand.New(rand.NewSource(1))
+	for _, test := range []struct {
+		name       string
+		costMatrix [][]float64
+	}{
+		{"MacholWien", generate.MacholWien(200)},
+		{"RandomMacholWien", generate.RandomMacholWien(rng, 200)},
+		{"Constant", generate.Constant(200, 200, 1)},
+	} {
+		b.Run(test.name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				h, _ := munkres.NewHungarianAlgorithm(test.costMatrix)
+				h.Execute()
+			}
+		})
+	}
+}
@@ -0,0 +1,129 @@
+package munkres
+
+import "math"
+
+// A NumericalReport describes the numerical health of a solve, so that
+// callers can detect when rounding may have affected the result.
+type NumericalReport struct {
+	// MaxViolation is the greatest amount by which the reduced cost of a
+	// pair, its cost less the labels of its worker and job, falls below
+	// zero, or that of an assigned pair differs from zero. It is zero
+	// in exact arithmetic.
+	MaxViolation float64
+	// MinLabelUpdate and MaxLabelUpdate are the least and greatest of
+	// the amounts by which the labels were updated, or zero if they
+	// were not, or if the smaller side of a matrix far from square was
+	// matched directly, which does not record them. MaxMagnitude is the
+	// greatest magnitude of a label or a cost as solved, after any
+	// reduction. The ratio of MaxMagnitude to MinLabelUpdate bounds the
+	// precision the updates needed.
+	MinLabelUpdate, MaxLabelUpdate, MaxMagnitude float64
+	// Tolerance is the bound on the rounding error accumulated by the
+	// labels: the dimension of the padded matrix times MaxMagnitude
+	// times the machine epsilon of float64.
+	Tolerance float64
+	// Epsilon is the slack within which pairs were taken as tight
+	// WithAutoEpsilon, or zero if they were not.
+	Epsilon float64
+	// NearTie is true if the reduced cost of some unassigned pair is not
+	// zero but within Tolerance of it, so that rounding may have decided
+	// whether the pair was tied with the assignment chosen.
+	NearTie bool
+}
+
+// The range of the magnitudes of the label updates of a solve.
+type updateRange struct {
+	least, greatest float64
+}
+
+// Record a label update by slack.
+func (r *updateRange) observe(slack float64) {
+	slack = math.Abs(slack)
+	if slack == 0 {
+		return
+	}
+	if r.least == 0 || slack < r.least {
+		r.least = slack
+	}
+	if slack > r.greatest {
+		r.greatest = slack
+	}
+}
+
+// NumericalReport returns the numerical health report of the solve, for
+// the workers and jobs of the cost matrix. It must be called after
+// Execute, and reports nothing WithWorkerGroups.
+func (h *HungarianAlgorithm) NumericalReport() NumericalReport {
+	r := NumericalReport{
+		MinLabelUpdate: h.updates.least,
+		MaxLabelUpdate: h.updates.greatest,
+		Epsilon:        h.epsilon,
+	}
+	if h.opts.workerGroups != nil {
+		return r
+	}
+	for w := 0; w < h.dim; w++ {
+		r.MaxMagnitude = math.Max(r.MaxMagnitude, math.Abs(h.labelByWorker[w]))
+		r.MaxMagnitude = math.Max(r.MaxMagnitude, math.Abs(h.labelByJob[w]))
+	}
+	for w := 0; w < h.rows; w++ {
+		for _, c := range h.row(w)[:h.cols] {
+			if !math.IsInf(c, 0) {
+				r.MaxMagnitude = math.Max(r.MaxMagnitude, math.Abs(c))
+			}
+		}
+	}
+	r.Tolerance = float64(h.dim) * r.MaxMagnitude * epsilon64
+	for w := 0; w < h.rows; w++ {
+		row := h.row(w)
+		for j := 0; j < h.cols; j++ {
+			if math.IsInf(row[j], 1) {
+				continue
+			}
+			reduced := row[j] - h.labelByWorker[w] - h.labelByJob[j]
+			if h.matchJobByWorker[w] == j {
+				r.MaxViolation = math.Max(r.MaxViolation, math.Abs(reduced))
+				continue
+			}
+			r.MaxViolation = math.Max(r.MaxViolation, -reduced)
+			if reduced != 0 && math.Abs(reduced) <= r.Tolerance {
+				r.NearTie = true
+			}
+		}
+	}
+	return r
+}
+
+// WithAutoEpsilon makes Execute take a pair as tight if its slack, its
+// cost less the labels of its worker and job, is within an epsilon of
+// zero rather than exactly zero, so that the rounding of costs with a
+// wide dynamic range does not hide ties or force needless label updates.
+// The epsilon is derived from the reduced costs as the Tolerance of
+// NumericalReport is, the dimension of the padded matrix times the
+// greatest magnitude of a reduced cost times the machine epsilon of
+// float64, the bound on the rounding error the labels accumulate, and is
+// reported as the Epsilon of NumericalReport.
+//
+// The assignment is then optimal to within that epsilon for each
+// assigned pair. The solvers for integer costs and for far from square
+// matrices, which do not round, do not use it, and ErrorInvalidOption is
+// returned WithScipyTieOrder.
+func WithAutoEpsilon() Option {
+	return func(o *options) {
+		o.autoEpsilon = true
+	}
+}
+
+// return the epsilon of WithAutoEpsilon for the reduced costs.
+func (h *HungarianAlgorithm) autoEpsilon() float64 {
+	magnitude := 0.0
+	for _, c := range h.costs {
+		if !math.IsInf(c, 1) {
+			magnitude = math.Max(magnitude, math.Abs(c))
+		}
+	}
+	return float64(h.dim) * magnitude * epsilon64
+}
+
+// The machine epsilon of float64, the gap between 1 and the next float64.
+const epsilon64 = 1.0 / (1 << 52)
@@ -0,0 +1,76 @@
+package munkres_test
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/charles-haynes/munkres"
+)
+
+func TestNumericalReport(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for _, opts := range [][]munkres.Option{nil, {munkres.WithIntegerCosts()}, {munkres.WithScipyTieOrder()}} {
+		for i := 0; i < 20; i++ {
+			c := randomMatrix(rng, 1+rng.Intn(8), 1+rng.Intn(8))
+			h, _ := munkres.NewHungarianAlgorithm(c, opts...)
+			h.Execute()
+			r := h.NumericalReport()
+			if r.MaxViolation > r.Tolerance || r.MaxMagnitude <= 0 || r.MinLabelUpdate > r.MaxLabelUpdate {
+				t.Fatalf("%v: want a healthy report got %+v", c, r)
+			}
+		}
+	}
+	// The second worker's jobs differ by far less than the magnitude of
+	// the costs can resolve.
+	c := [][]float64{{1e12, 0}, {1, 1 + 1e-15}}
+	h, _ := munkres.NewHungarianAlgorithm(c)
+	h.Execute()
+	if r := h.NumericalReport(); !r.NearTie || r.Tolerance <= 0 {
+		t.Errorf("want a near tie got %+v", r)
+	}
+	c = [][]float64{{1, 2, 3}, {2, 4, 6}, {3, 6, 9}}
+	h, _ = munkres.NewHungarianAlgorithm(c)
+	h.Execute()
+	if r := h.NumericalReport(); r.NearTie || r.MaxViolation != 0 || r.MinLabelUpdate != 1 {
+		t.Errorf("want an exact report got %+v", r)
+	}
+}
+
+func TestAutoEpsilon(t *testing.T) {
+	rng := rand.New(rand.NewSource(2))
+	positive := 0
+	for i := 0; i < 50; i++ {
+		n := 2 + rng.Intn(5)
+		c := randomMatrix(rng, n, n)
+		for w := range c {
+			// Costs of a wide dynamic range, with near ties.
+			c[w][rng.Intn(n)] = 1e9
+			c[w][rng.Intn(n)] += 1e-9
+		}
+		h, _ := munkres.NewHungarianAlgorithm(c, munkres.WithAutoEpsilon())
+		a := h.Execute()
+		r := h.NumericalReport()
+		if r.Epsilon > 0 {
+			positive++
+		}
+		if r.MinLabelUpdate != 0 && r.MinLabelUpdate <= r.Epsilon {
+			t.Fatalf("%v: want label updates beyond the epsilon got %+v", c, r)
+		}
+		want, _ := bruteForceCost(c, func(w, j int) bool { return true })
+		if got := munkres.Assignment(a).Cost(c); math.Abs(got-want) > float64(n)*r.Epsilon+1e-9 {
+			t.Fatalf("%v: want cost %v within %v got %v", c, want, float64(n)*r.Epsilon, got)
+		}
+	}
+	if positive == 0 {
+		t.Errorf("want a positive epsilon")
+	}
+	h, _ := munkres.NewHungarianAlgorithm([][]float64{{1, 2}, {2, 1}})
+	h.Execute()
+	if r := h.NumericalReport(); r.Epsilon != 0 {
+		t.Errorf("want no epsilon by default got %v", r.Epsilon)
+	}
+	if _, err := munkres.NewHungarianAlgorithm([][]float64{{1}}, munkres.WithAutoEpsilon(), munkres.WithScipyTieOrder()); err != munkres.ErrorInvalidOption {
+		t.Errorf("want ErrorInvalidOption with scipy tie order got %v", err)
+	}
+}
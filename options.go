@@ -0,0 +1,95 @@
+package munkres
+
+// An Option configures optional behaviour of the algorithm. Options are
+// passed to NewHungarianAlgorithm, and to those other solvers which
+// document the options they use.
+type Option func(*options)
+
+type options struct {
+	backups       bool
+	workerGroups  []int
+	forbidden     bool
+	epsilon       epsilonSchedule
+	parallelism   int
+	allocator     Allocator
+	rankTransform bool
+	sinkhorn      sinkhornSchedule
+	maxDimension  int
+	sanitize      *SanitizePolicy
+	validation    Validation
+	debugChecks   bool
+	integerCosts  bool
+	reject        func(w, j int) bool
+	beforePhase   func(PhaseInfo)
+	afterPhase    func(PhaseInfo)
+	subscriber    Subscriber
+	deterministic bool
+	monotone      bool
+	metrics       *Metrics
+	scipy         bool
+	autoEpsilon   bool
+	maximize      bool
+	partial       bool
+	// The progress reused across solves by a ReusableSolver, or nil.
+	progress *progress
+	report   func(Snapshot)
+}
+
+// allowForbidden permits +Inf entries in the cost matrix, which forbid the
+// assignment of the worker to the job.
+func allowForbidden() Option {
+	return func(o *options) {
+		o.forbidden = true
+	}
+}
+
+func newOptions(opts []Option) options {
+	o := options{allocator: heapAllocator{}}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
+// Check that the options are consistent with a cost matrix of the given
+// dimensions.
+func (o *options) check(rows, cols int) error {
+	if o.workerGroups != nil && len(o.workerGroups) != rows {
+		return ErrorDimensionMismatch
+	}
+	if o.workerGroups != nil && (o.reject != nil || o.subscriber != nil) {
+		return ErrorInvalidOption
+	}
+	if o.epsilon != (epsilonSchedule{}) && !o.epsilon.valid() {
+		return ErrorInvalidOption
+	}
+	if o.sinkhorn != (sinkhornSchedule{}) && (!o.sinkhorn.valid() || o.deterministic) {
+		return ErrorInvalidOption
+	}
+	if o.monotone && (o.rankTransform || o.sinkhorn != (sinkhornSchedule{})) {
+		return ErrorInvalidOption
+	}
+	if o.scipy && (o.autoEpsilon || o.workerGroups != nil || o.backups || o.sinkhorn != (sinkhornSchedule{}) ||
+		o.reject != nil || o.phaseHooks() || o.subscriber != nil) {
+		return ErrorInvalidOption
+	}
+	if o.sanitize != nil && !o.sanitize.valid() {
+		return ErrorInvalidOption
+	}
+	if !o.validation.valid() || o.parallelism < 0 || o.maxDimension < 0 {
+		return ErrorInvalidOption
+	}
+	return o.checkDimension(rows, cols)
+}
+
+// WithParallelism sets the number of goroutines used by those parts of the
+// solvers which run in parallel: the bidding of SolveAuction, the
+// constructions of SolveMultiStart, the blocks of SolveBlocks, and the
+// reduction and greedy initial matching of NewHungarianAlgorithm, unless
+// WithDeterministic. The default, and a value of 0 or 1, is to run
+// serially; a negative value is invalid.
+func WithParallelism(goroutines int) Option {
+	return func(o *options) {
+		o.parallelism = goroutines
+	}
+}
@@ -0,0 +1,38 @@
+package munkres
+
+import "math"
+
+// OriginalCost returns the cost of assigning worker w to job j as given
+// to the algorithm, which reduces its own copy of the cost matrix as it
+// runs. The cost is reconstructed from the reduced matrix and the amounts
+// subtracted from it, so it may differ from the cost given by rounding in
+// the last place. The costs are those the algorithm solved for, such as
+// the ranks WithRankTransform or the negated profits WithMaximize, and
+// forbidden assignments cost +Inf.
+//
+// return NaN if there is no worker w or job j.
+func (h *HungarianAlgorithm) OriginalCost(w, j int) float64 {
+	if w < 0 || w >= h.rows || j < 0 || j >= h.cols {
+		return math.NaN()
+	}
+	return h.originalCost(w, j)
+}
+
+// TotalOriginalCost returns the total cost, as given by OriginalCost, of
+// an assignment of the workers of the cost matrix such as that returned
+// by Execute, in which -1 marks an unassigned worker.
+//
+// return NaN if the assignment is not of the workers of the cost matrix
+// or assigns a job which is not in it.
+func (h *HungarianAlgorithm) TotalOriginalCost(assignment []int) float64 {
+	if len(assignment) != h.rows {
+		return math.NaN()
+	}
+	total := 0.0
+	for w, j := range assignment {
+		if j != -1 {
+			total += h.OriginalCost(w, j)
+		}
+	}
+	return total
+}
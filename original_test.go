@@ -0,0 +1,57 @@
+package munkres_test
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/charles-haynes/munkres"
+)
+
+func TestOriginalCost(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for _, opts := range [][]munkres.Option{
+		nil,
+		{munkres.WithSinkhornScaling(10, 1)},
+	} {
+		c := randomMatrix(rng, 4, 6)
+		h, err := munkres.NewHungarianAlgorithm(c, opts...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		res := h.Execute()
+		for w := range c {
+			for j := range c[w] {
+				if got := h.OriginalCost(w, j); math.Abs(got-c[w][j]) > 1e-9 {
+					t.Errorf("(%d, %d): want %v got %v", w, j, c[w][j], got)
+				}
+			}
+		}
+		want, _ := munkres.ComputeCost(c, res)
+		if got := h.TotalOriginalCost(res); math.Abs(got-want) > 1e-9 {
+			t.Errorf("want total %v got %v", want, got)
+		}
+	}
+}
+
+func TestOriginalCostOutOfRange(t *testing.T) {
+	h, err := munkres.NewHungarianAlgorithm([][]float64{{1, 2}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	h.Execute()
+	for _, p := range [][2]int{{-1, 0}, {1, 0}, {0, -1}, {0, 2}} {
+		if got := h.OriginalCost(p[0], p[1]); !math.IsNaN(got) {
+			t.Errorf("%v: want NaN got %v", p, got)
+		}
+	}
+	if got := h.TotalOriginalCost([]int{0, 1}); !math.IsNaN(got) {
+		t.Errorf("want NaN for too many workers got %v", got)
+	}
+	if got := h.TotalOriginalCost([]int{2}); !math.IsNaN(got) {
+		t.Errorf("want NaN for a job out of range got %v", got)
+	}
+	if got := h.TotalOriginalCost([]int{-1}); got != 0 {
+		t.Errorf("want 0 for no assignment got %v", got)
+	}
+}
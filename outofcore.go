@@ -0,0 +1,145 @@
+package munkres
+
+import (
+	"container/list"
+	"encoding/binary"
+	"io"
+	"math"
+	"os"
+)
+
+// The greatest size in bytes of the chunks of rows in which
+// SolveOutOfCore reads the cost matrix.
+const chunkSize = 1 << 20
+
+// SolveOutOfCore solves the assignment problem for a cost matrix stored
+// in FormatBinary in the file at path, holding at most about cacheSize
+// bytes of it in memory, so that matrices larger than memory can be
+// solved. This is experimental.
+//
+// The file is read in chunks of consecutive rows, which are cached and
+// evicted least recently used first. The algorithm reads the whole
+// matrix O(n) times, so it is far slower than NewHungarianAlgorithm
+// unless most of the matrix fits in the cache; beyond the cache it only
+// needs O(n) memory. The costs must all be finite, and an invalid row is
+// reported by a *ParseError when it is first read.
+//
+// return the assignment as for Execute.
+func SolveOutOfCore(path string, cacheSize int64) ([]int, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	var header [16]byte
+	if _, err := io.ReadFull(f, header[:]); err != nil {
+		return nil, err
+	}
+	rows := int(binary.LittleEndian.Uint64(header[:8]))
+	cols := int(binary.LittleEndian.Uint64(header[8:]))
+	if rows < 0 || cols < 0 {
+		return nil, ErrorDimensionMismatch
+	}
+	return solveRows(rows, cols, newChunkCache(f, len(header), rows, cols, cacheSize))
+}
+
+// A rowSource reading the rows of a cost matrix from a file in chunks,
+// with a least recently used cache of chunks.
+type chunkCache struct {
+	r            io.ReaderAt
+	offset       int64
+	rows, cols   int
+	rowsPerChunk int
+	maxChunks    int
+	chunks       map[int]*list.Element
+	// The cached chunks, most recently used first.
+	lru *list.List
+	buf []byte
+}
+
+type chunk struct {
+	index int
+	costs []float64
+}
+
+func newChunkCache(r io.ReaderAt, offset, rows, cols int, cacheSize int64) *chunkCache {
+	// Keep chunks small enough for the cache to hold several of them.
+	size := int64(chunkSize)
+	if cacheSize/8 < size {
+		size = cacheSize / 8
+	}
+	rowsPerChunk := 1
+	if cols > 0 && size/int64(8*cols) > 1 {
+		rowsPerChunk = int(size / int64(8*cols))
+	}
+	maxChunks := 1
+	if n := cacheSize / int64(8*rowsPerChunk*cols+1); n > 1 {
+		maxChunks = int(n)
+	}
+	return &chunkCache{
+		r:            r,
+		offset:       int64(offset),
+		rows:         rows,
+		cols:         cols,
+		rowsPerChunk: rowsPerChunk,
+		maxChunks:    maxChunks,
+		chunks:       map[int]*list.Element{},
+		lru:          list.New(),
+	}
+}
+
+func (c *chunkCache) row(w int) ([]float64, error) {
+	index := w / c.rowsPerChunk
+	e, ok := c.chunks[index]
+	if ok {
+		c.lru.MoveToFront(e)
+	} else {
+		var err error
+		if e, err = c.load(index); err != nil {
+			return nil, err
+		}
+	}
+	first := (w - index*c.rowsPerChunk) * c.cols
+	return e.Value.(*chunk).costs[first : first+c.cols], nil
+}
+
+// Read chunk index into the cache, evicting the least recently used
+// chunk if the cache is full.
+func (c *chunkCache) load(index int) (*list.Element, error) {
+	var costs []float64
+	if c.lru.Len() >= c.maxChunks {
+		e := c.lru.Back()
+		c.lru.Remove(e)
+		evicted := e.Value.(*chunk)
+		delete(c.chunks, evicted.index)
+		costs = evicted.costs
+	}
+	first := index * c.rowsPerChunk
+	n := c.rowsPerChunk
+	if first+n > c.rows {
+		n = c.rows - first
+	}
+	if cap(costs) < n*c.cols {
+		costs = make([]float64, c.rowsPerChunk*c.cols)
+	}
+	costs = costs[:n*c.cols]
+	if cap(c.buf) < 8*len(costs) {
+		c.buf = make([]byte, 8*c.rowsPerChunk*c.cols)
+	}
+	buf := c.buf[:8*len(costs)]
+	offset := c.offset + 8*int64(first)*int64(c.cols)
+	if read, err := c.r.ReadAt(buf, offset); err != nil {
+		return nil, &ParseError{Row: first + read/(8*c.cols), Err: err}
+	}
+	for i := range costs {
+		costs[i] = math.Float64frombits(binary.LittleEndian.Uint64(buf[8*i:]))
+	}
+	for w := 0; w < n; w++ {
+		if err := checkRow(first+w, costs[w*c.cols:(w+1)*c.cols], false); err != nil {
+			return nil, &ParseError{Row: first + w, Err: err}
+		}
+	}
+	e := c.lru.PushFront(&chunk{index: index, costs: costs})
+	c.chunks[index] = e
+	return e, nil
+}
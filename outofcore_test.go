@@ -0,0 +1,58 @@
+package munkres_test
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/charles-haynes/munkres"
+)
+
+// Write the cost matrix to a file in FormatBinary, and return its path.
+func writeBinary(t *testing.T, c [][]float64) string {
+	path := filepath.Join(t.TempDir(), "costs")
+	f, err := os.Create(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	cols := 0
+	if len(c) > 0 {
+		cols = len(c[0])
+	}
+	binary.Write(f, binary.LittleEndian, []uint64{uint64(len(c)), uint64(cols)})
+	for _, row := range c {
+		binary.Write(f, binary.LittleEndian, row)
+	}
+	return path
+}
+
+func TestSolveOutOfCore(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 50; i++ {
+		c := randomMatrix(rng, 1+rng.Intn(12), 1+rng.Intn(12))
+		h, _ := munkres.NewHungarianAlgorithm(c)
+		want, _ := munkres.ComputeCost(c, h.Execute())
+		// A cache of a few rows, so that chunks are evicted.
+		a, err := munkres.SolveOutOfCore(writeBinary(t, c), 300)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, err := munkres.ComputeCost(c, a); err != nil || got != want {
+			t.Errorf("%v: want cost = %f got %f (%v)", c, want, got, err)
+		}
+	}
+}
+
+func TestSolveOutOfCoreInvalid(t *testing.T) {
+	c := [][]float64{{1, 2}, {3, 4}, {5, math.NaN()}}
+	_, err := munkres.SolveOutOfCore(writeBinary(t, c), 1<<20)
+	var parseError *munkres.ParseError
+	if !errors.As(err, &parseError) || parseError.Row != 2 || !errors.Is(err, munkres.ErrorNaNCost) {
+		t.Errorf("want a NaN cost in row 2 got %v", err)
+	}
+}
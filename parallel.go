@@ -0,0 +1,30 @@
+package munkres
+
+import "sync"
+
+// Call f on consecutive ranges [lo, hi) which together cover [0, n), in
+// parallel using up to the number of goroutines set by WithParallelism,
+// or serially WithDeterministic. Each call must only write state
+// belonging to its own range. f is a method expression rather than a
+// closure, so that running serially allocates nothing.
+func (h *HungarianAlgorithm) parallel(n int, f func(h *HungarianAlgorithm, lo, hi int)) {
+	goroutines := h.opts.parallelism
+	if goroutines <= 1 || n < 2 || h.opts.deterministic {
+		f(h, 0, n)
+		return
+	}
+	chunk := (n + goroutines - 1) / goroutines
+	var wg sync.WaitGroup
+	for lo := 0; lo < n; lo += chunk {
+		hi := lo + chunk
+		if hi > n {
+			hi = n
+		}
+		wg.Add(1)
+		go func(lo, hi int) {
+			defer wg.Done()
+			f(h, lo, hi)
+		}(lo, hi)
+	}
+	wg.Wait()
+}
@@ -0,0 +1,28 @@
+package munkres_test
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/charles-haynes/munkres"
+)
+
+func TestParallelSetup(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 50; i++ {
+		c := randomMatrix(rng, 1+rng.Intn(12), 1+rng.Intn(12))
+		serial, err := munkres.NewHungarianAlgorithm(c)
+		if err != nil {
+			t.Fatal(err)
+		}
+		parallel, err := munkres.NewHungarianAlgorithm(c, munkres.WithParallelism(4))
+		if err != nil {
+			t.Fatal(err)
+		}
+		want, got := serial.Execute(), parallel.Execute()
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("%v: got %v, want %v", c, got, want)
+		}
+	}
+}
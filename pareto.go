@@ -0,0 +1,112 @@
+package munkres
+
+import "math"
+
+// A ParetoPoint is an assignment on the Pareto front of two objectives,
+// with its total cost under each.
+type ParetoPoint struct {
+	Assignment   Assignment
+	CostA, CostB float64
+}
+
+// ParetoFront returns the supported Pareto-optimal assignments for two
+// cost matrices of the same dimensions, such as money and time, so that
+// the trade-off between them can be decided by a person: those which
+// minimize some positively weighted sum of the two, in increasing order
+// of their costs under costA and so decreasing order under costB. No
+// other assignment is cheaper under one objective without being dearer
+// under the other.
+//
+// The front is found by dichotomic search: from the optima of costA and
+// of costB, each breaking ties by the other, the weights of each
+// combination solved are those of the line through two neighbouring
+// points found, so that a further point is found exactly where one lies
+// below that line. Each point takes a solve, as does each pair of
+// neighbouring points, to show that none lies between them.
+//
+// return the errors of NewHungarianAlgorithm for either matrix, or
+// ErrorDimensionMismatch if their dimensions differ.
+func ParetoFront(costA, costB [][]float64) ([]ParetoPoint, error) {
+	if err := checkCostMatrix(costA, false); err != nil {
+		return nil, err
+	}
+	if err := checkCostMatrix(costB, false); err != nil {
+		return nil, err
+	}
+	if len(costA) != len(costB) || len(costA) > 0 && len(costA[0]) != len(costB[0]) {
+		return nil, ErrorDimensionMismatch
+	}
+	point := func(a Assignment) ParetoPoint {
+		return ParetoPoint{Assignment: a, CostA: a.Cost(costA), CostB: a.Cost(costB)}
+	}
+	first, err := lexicographic(costA, costB)
+	if err != nil {
+		return nil, err
+	}
+	last, err := lexicographic(costB, costA)
+	if err != nil {
+		return nil, err
+	}
+	p, q := point(first), point(last)
+	if p.CostB <= q.CostB {
+		return []ParetoPoint{p}, nil
+	}
+	front := []ParetoPoint{p}
+	var search func(p, q ParetoPoint) error
+	search = func(p, q ParetoPoint) error {
+		wa, wb := p.CostB-q.CostB, q.CostA-p.CostA
+		weighted := make([][]float64, len(costA))
+		for w := range weighted {
+			weighted[w] = make([]float64, len(costA[w]))
+			for j := range weighted[w] {
+				weighted[w][j] = wa*costA[w][j] + wb*costB[w][j]
+			}
+		}
+		h, err := NewHungarianAlgorithm(weighted)
+		if err != nil {
+			return err
+		}
+		r := point(h.Execute())
+		line := wa*p.CostA + wb*p.CostB
+		if wa*r.CostA+wb*r.CostB >= line-pricingTolerance*math.Max(1, math.Abs(line)) {
+			return nil
+		}
+		if err := search(p, r); err != nil {
+			return err
+		}
+		front = append(front, r)
+		return search(r, q)
+	}
+	if err := search(p, q); err != nil {
+		return nil, err
+	}
+	return append(front, q), nil
+}
+
+// return an optimal assignment for costMatrix which, among those, is
+// optimal for tieBreak: the pairs whose reduced costs under the duals of
+// the first solve are positive are in no optimal assignment, so they are
+// forbidden in the second.
+func lexicographic(costMatrix, tieBreak [][]float64) (Assignment, error) {
+	h, err := NewHungarianAlgorithm(costMatrix)
+	if err != nil {
+		return nil, err
+	}
+	h.Execute()
+	workerDuals, jobDuals := h.Duals()
+	allowed := make([][]float64, len(costMatrix))
+	for w, row := range costMatrix {
+		allowed[w] = make([]float64, len(row))
+		for j, c := range row {
+			allowed[w][j] = tieBreak[w][j]
+			if c-workerDuals[w]-jobDuals[j] > pricingTolerance*math.Max(1, math.Abs(c)) {
+				allowed[w][j] = math.Inf(1)
+			}
+		}
+	}
+	h, err = NewHungarianAlgorithm(allowed, allowForbidden())
+	if err != nil {
+		return nil, err
+	}
+	return h.execute()
+}
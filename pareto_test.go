@@ -0,0 +1,72 @@
+package munkres_test
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/charles-haynes/munkres"
+)
+
+// return every permutation of n elements.
+func permutations(n int) [][]int {
+	if n == 0 {
+		return [][]int{{}}
+	}
+	var all [][]int
+	for _, p := range permutations(n - 1) {
+		for i := 0; i <= len(p); i++ {
+			q := append(append(append([]int(nil), p[:i]...), n-1), p[i:]...)
+			all = append(all, q)
+		}
+	}
+	return all
+}
+
+func TestParetoFront(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 30; i++ {
+		n := 1 + rng.Intn(5)
+		a, b := randomMatrix(rng, n, n), randomMatrix(rng, n, n)
+		for _, c := range [][][]float64{a, b} {
+			for _, row := range c {
+				for j := range row {
+					row[j] = math.Floor(row[j] * 10)
+				}
+			}
+		}
+		front, err := munkres.ParetoFront(a, b)
+		if err != nil {
+			t.Fatal(err)
+		}
+		all := permutations(n)
+		for k, p := range front {
+			if k > 0 && (p.CostA <= front[k-1].CostA || p.CostB >= front[k-1].CostB) {
+				t.Fatalf("want increasing costs under a got %+v", front)
+			}
+			for _, q := range all {
+				qa, qb := munkres.Assignment(q).Cost(a), munkres.Assignment(q).Cost(b)
+				if qa <= p.CostA && qb <= p.CostB && (qa < p.CostA || qb < p.CostB) {
+					t.Fatalf("%v, %v: %+v is dominated by %v", a, b, p, q)
+				}
+			}
+		}
+		// Every weighted sum is minimized by a point of the front.
+		for _, weight := range []float64{0.1, 0.5, 1, 2, 10} {
+			best := math.Inf(1)
+			for _, q := range all {
+				best = math.Min(best, weight*munkres.Assignment(q).Cost(a)+munkres.Assignment(q).Cost(b))
+			}
+			found := math.Inf(1)
+			for _, p := range front {
+				found = math.Min(found, weight*p.CostA+p.CostB)
+			}
+			if math.Abs(found-best) > 1e-9 {
+				t.Fatalf("%v, %v: want a point of weighted cost %f got %f in %+v", a, b, best, found, front)
+			}
+		}
+	}
+	if _, err := munkres.ParetoFront([][]float64{{1}}, [][]float64{{1, 2}}); err != munkres.ErrorDimensionMismatch {
+		t.Errorf("want %v got %v", munkres.ErrorDimensionMismatch, err)
+	}
+}
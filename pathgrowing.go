@@ -0,0 +1,107 @@
+package munkres
+
+import "math"
+
+// SolvePathGrowing finds an approximate assignment in time O(n^2), linear
+// in the number of pairs, for latency critical uses where even sorting
+// the pairs for SolveGreedy is too slow. It uses the path growing
+// algorithm of Drake and Hougardy, which grows paths by repeatedly
+// following the cheapest pair from the last vertex reached, alternately
+// adding the pairs to two matchings, and keeps the better matching. Taking
+// the weight of a pair to be the greatest cost less its cost, the matching
+// has at least half the weight of the heaviest one. Any workers and jobs
+// it leaves unmatched are then matched cheaply.
+//
+// costMatrix is subject to the same restrictions as for
+// NewHungarianAlgorithm.
+//
+// return the assignment, which assigns as many workers as Execute would,
+// and its quality.
+func SolvePathGrowing(costMatrix [][]float64) (Assignment, Quality, error) {
+	if err := checkCostMatrix(costMatrix, false); err != nil {
+		return nil, Quality{}, err
+	}
+	rows, cols := len(costMatrix), 0
+	if rows > 0 {
+		cols = len(costMatrix[0])
+	}
+	// Vertices are the workers followed by the jobs.
+	removed := make([]bool, rows+cols)
+	cost := func(x, y int) float64 {
+		if x < rows {
+			return costMatrix[x][y-rows]
+		}
+		return costMatrix[y][x-rows]
+	}
+	matchings := [2]Assignment{unassigned(rows), unassigned(rows)}
+	var costs, sizes [2]float64
+	for start := 0; start < rows+cols; start++ {
+		i := 0
+		for x := start; !removed[x]; i = 1 - i {
+			lo, hi := rows, rows+cols
+			if x >= rows {
+				lo, hi = 0, rows
+			}
+			y := -1
+			for z := lo; z < hi; z++ {
+				if !removed[z] && (y == -1 || cost(x, z) < cost(x, y)) {
+					y = z
+				}
+			}
+			removed[x] = true
+			if y == -1 {
+				break
+			}
+			w, j := x, y-rows
+			if x >= rows {
+				w, j = y, x-rows
+			}
+			matchings[i][w] = j
+			costs[i] += costMatrix[w][j]
+			sizes[i]++
+			x = y
+		}
+	}
+	// Compare the matchings by their weights, the greatest cost less
+	// the cost of each pair.
+	greatest := 0.0
+	for w := range costMatrix {
+		for _, c := range costMatrix[w] {
+			greatest = math.Max(greatest, c)
+		}
+	}
+	a := matchings[0]
+	if sizes[1]*greatest-costs[1] > sizes[0]*greatest-costs[0] {
+		a = matchings[1]
+	}
+	completeMatching(costMatrix, a)
+	return a, quality(costMatrix, a), nil
+}
+
+// Extend a matching to assign as many workers as there are workers or
+// jobs, whichever is fewer, by giving each unassigned worker in turn the
+// cheapest unassigned job.
+func completeMatching(costMatrix [][]float64, a Assignment) {
+	if len(costMatrix) == 0 {
+		return
+	}
+	taken := make([]bool, len(costMatrix[0]))
+	for _, j := range a {
+		if j != -1 {
+			taken[j] = true
+		}
+	}
+	for w, j := range a {
+		if j != -1 {
+			continue
+		}
+		for k, c := range costMatrix[w] {
+			if !taken[k] && (a[w] == -1 || c < costMatrix[w][a[w]]) {
+				a[w] = k
+			}
+		}
+		if a[w] != -1 {
+			taken[a[w]] = true
+		}
+	}
+}
@@ -0,0 +1,20 @@
+package munkres_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/charles-haynes/munkres"
+)
+
+func TestSolvePathGrowing(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 100; i++ {
+		c := randomMatrix(rng, 1+rng.Intn(8), 1+rng.Intn(8))
+		a, q, err := munkres.SolvePathGrowing(c)
+		if err != nil {
+			t.Fatal(err)
+		}
+		checkApproximation(t, c, a, q)
+	}
+}
@@ -0,0 +1,108 @@
+/*
+Package perm provides operations on assignments, as returned by the
+Execute of package munkres, viewed as partial permutations: a[i] is the
+position to which element i is mapped, or -1 if it is unmapped.
+*/
+package perm
+
+import (
+	"errors"
+	"reflect"
+)
+
+var
+// An entry is neither -1 nor a position in range
+ErrorOutOfRange,
+	// Two elements are mapped to the same position
+	ErrorDuplicate,
+	// The permutation does not map every element of a slice
+	ErrorIncomplete error
+
+// Validate checks that a is a partial permutation into n positions: that
+// every entry is -1 or in [0, n), and that no position is used twice.
+func Validate(a []int, n int) error {
+	used := make([]bool, n)
+	for _, j := range a {
+		if j == -1 {
+			continue
+		}
+		if j < 0 || j >= n {
+			return ErrorOutOfRange
+		}
+		if used[j] {
+			return ErrorDuplicate
+		}
+		used[j] = true
+	}
+	return nil
+}
+
+// Invert returns the inverse of the partial permutation a into n
+// positions, mapping each position to the element mapped to it, or -1 if
+// there is none; such as the worker of each job of an assignment. a must
+// be valid.
+func Invert(a []int, n int) []int {
+	inverse := make([]int, n)
+	for j := range inverse {
+		inverse[j] = -1
+	}
+	for i, j := range a {
+		if j != -1 {
+			inverse[j] = i
+		}
+	}
+	return inverse
+}
+
+// Compose returns the partial permutation which maps each element by a
+// and then by b, so that the result maps i to b[a[i]], or to -1 if either
+// leaves it unmapped. Every entry of a must be -1 or an index of b.
+func Compose(a, b []int) []int {
+	c := make([]int, len(a))
+	for i, j := range a {
+		c[i] = -1
+		if j != -1 {
+			c[i] = b[j]
+		}
+	}
+	return c
+}
+
+// Apply reorders slice in place, moving element i to position a[i], in
+// linear time. a must be a complete permutation of the indices of slice,
+// which must be a slice of any type.
+func Apply(a []int, slice interface{}) error {
+	n := reflect.ValueOf(slice).Len()
+	if len(a) != n {
+		return ErrorIncomplete
+	}
+	if err := Validate(a, n); err != nil {
+		return err
+	}
+	for _, j := range a {
+		if j == -1 {
+			return ErrorIncomplete
+		}
+	}
+	swap := reflect.Swapper(slice)
+	// Follow each cycle, swapping the element at its start into place
+	// until the start holds the element which belongs there.
+	done := make([]bool, n)
+	for i := range a {
+		if done[i] {
+			continue
+		}
+		for j := a[i]; j != i; j = a[j] {
+			swap(i, j)
+			done[j] = true
+		}
+		done[i] = true
+	}
+	return nil
+}
+
+func init() {
+	ErrorOutOfRange = errors.New("Position out of range")
+	ErrorDuplicate = errors.New("Duplicate position")
+	ErrorIncomplete = errors.New("Incomplete permutation")
+}
@@ -0,0 +1,61 @@
+package perm_test
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/charles-haynes/munkres/perm"
+)
+
+func TestValidate(t *testing.T) {
+	for _, test := range []struct {
+		a   []int
+		n   int
+		err error
+	}{
+		{[]int{1, 0, -1}, 2, nil},
+		{[]int{1, 2}, 2, perm.ErrorOutOfRange},
+		{[]int{-2}, 2, perm.ErrorOutOfRange},
+		{[]int{1, 1}, 2, perm.ErrorDuplicate},
+	} {
+		if err := perm.Validate(test.a, test.n); err != test.err {
+			t.Errorf("%v: want err = %v got %v", test.a, test.err, err)
+		}
+	}
+}
+
+func TestInvertCompose(t *testing.T) {
+	a := []int{2, -1, 0}
+	if got, want := perm.Invert(a, 4), []int{2, -1, 0, -1}; !reflect.DeepEqual(got, want) {
+		t.Errorf("want %v got %v", want, got)
+	}
+	b := []int{1, -1, 3, 0}
+	if got, want := perm.Compose(a, b), []int{3, -1, 1}; !reflect.DeepEqual(got, want) {
+		t.Errorf("want %v got %v", want, got)
+	}
+}
+
+func TestApply(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 20; i++ {
+		a := rng.Perm(1 + rng.Intn(10))
+		s := make([]string, len(a))
+		for i := range s {
+			s[i] = string(rune('a' + i))
+		}
+		want := make([]string, len(a))
+		for i, j := range a {
+			want[j] = s[i]
+		}
+		if err := perm.Apply(a, s); err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(s, want) {
+			t.Errorf("%v: want %v got %v", a, want, s)
+		}
+	}
+	if err := perm.Apply([]int{0, -1}, []int{1, 2}); err != perm.ErrorIncomplete {
+		t.Errorf("want err = %v got %v", perm.ErrorIncomplete, err)
+	}
+}
@@ -0,0 +1,66 @@
+package munkres
+
+import "time"
+
+// PhaseInfo describes a phase of Execute, each of which matches one more
+// worker of the padded square matrix, for the hooks set by
+// WithPhaseHooks.
+type PhaseInfo struct {
+	// Phase is the number of the phase, counting from 1.
+	Phase int
+	// Worker is the worker at which the phase is rooted, the one it
+	// matches. Workers from the number of rows on are padding.
+	Worker int
+	// Duration is the time the phase took, or zero before it.
+	Duration time.Duration
+	// LabelUpdates is the number of times the phase changed the labels
+	// to make more pairs tight, or zero before it.
+	LabelUpdates int
+}
+
+// WithPhaseHooks calls before at the start and after at the end of each
+// phase of Execute, either of which may be nil, so that an application
+// can time, count or log the phases, or call Stop from them to enforce
+// its own timeouts. The hooks are called on the goroutine running
+// Execute, which waits for them to return. The phases of the faster
+// solver for far from square matrices are not observable, so it is not
+// used.
+func WithPhaseHooks(before, after func(PhaseInfo)) Option {
+	return func(o *options) {
+		o.beforePhase = before
+		o.afterPhase = after
+	}
+}
+
+// Report whether phase hooks are set.
+func (o *options) phaseHooks() bool {
+	return o.beforePhase != nil || o.afterPhase != nil
+}
+
+// Call the hook before the phase rooted at worker w, if set.
+//
+// return the time at which the phase started, if a hook is set.
+func (h *HungarianAlgorithm) beginPhase(w int) time.Time {
+	if !h.opts.phaseHooks() {
+		return time.Time{}
+	}
+	h.labelUpdates = 0
+	if h.opts.beforePhase != nil {
+		h.opts.beforePhase(PhaseInfo{Phase: h.Snapshot().Phases + 1, Worker: w})
+	}
+	return time.Now()
+}
+
+// Call the hook after the phase rooted at worker w which started at
+// start, if set.
+func (h *HungarianAlgorithm) endPhase(w int, start time.Time) {
+	if h.opts.afterPhase == nil {
+		return
+	}
+	h.opts.afterPhase(PhaseInfo{
+		Phase:        h.Snapshot().Phases + 1,
+		Worker:       w,
+		Duration:     time.Since(start),
+		LabelUpdates: h.labelUpdates,
+	})
+}
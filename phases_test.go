@@ -0,0 +1,55 @@
+package munkres_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/charles-haynes/munkres"
+)
+
+func TestWithPhaseHooks(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for _, opt := range []munkres.Option{munkres.WithBackups(), munkres.WithIntegerCosts()} {
+		c := make([][]float64, 20)
+		for w := range c {
+			c[w] = make([]float64, 30)
+			for j := range c[w] {
+				c[w][j] = float64(rng.Intn(1000))
+			}
+		}
+		var before, after []munkres.PhaseInfo
+		h, _ := munkres.NewHungarianAlgorithm(c, opt, munkres.WithPhaseHooks(
+			func(p munkres.PhaseInfo) { before = append(before, p) },
+			func(p munkres.PhaseInfo) { after = append(after, p) }))
+		h.Execute()
+		phases := h.Snapshot().Phases
+		if phases == 0 || len(before) != phases || len(after) != phases {
+			t.Fatalf("want %d phases got %d before and %d after",
+				phases, len(before), len(after))
+		}
+		updates := 0
+		for i := range before {
+			b, a := before[i], after[i]
+			if b.Phase != i+1 || a.Phase != i+1 || a.Worker != b.Worker ||
+				b.Duration != 0 || b.LabelUpdates != 0 || a.Duration < 0 {
+				t.Errorf("phase %d: before %+v after %+v", i+1, b, a)
+			}
+			updates += a.LabelUpdates
+		}
+		if updates == 0 {
+			t.Errorf("want some label updates got none")
+		}
+	}
+}
+
+func TestWithPhaseHooksStop(t *testing.T) {
+	var h munkres.HungarianAlgorithm
+	h, _ = munkres.NewHungarianAlgorithm(randomMatrix(rand.New(rand.NewSource(1)), 30, 30),
+		munkres.WithPhaseHooks(nil, func(munkres.PhaseInfo) { h.Stop() }))
+	if _, err := h.Solve(); err != munkres.ErrorStopped {
+		t.Errorf("want %v got %v", munkres.ErrorStopped, err)
+	}
+	if got := h.Snapshot().Phases; got != 1 {
+		t.Errorf("want 1 phase got %d", got)
+	}
+}
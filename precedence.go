@@ -0,0 +1,179 @@
+package munkres
+
+import "math"
+
+// SolvePrecedence solves the assignment problem subject to precedence
+// constraints between jobs, which the assignment problem itself cannot
+// express: each pair in precedes is a job a and a job b which may only be
+// assigned if a is. Such constraints only bind when there are more jobs
+// than workers, since otherwise every job is assigned.
+//
+// The problem is solved by depth-first branch and bound over the
+// assignment problem without the constraints: a solution breaking a
+// constraint is excluded by two subproblems, one in which b may not be
+// assigned and one in which a must be, each bounded below by its own
+// assignment problem. At most maxNodes assignment problems are solved; if
+// the search finishes within them the result is optimal, otherwise it is
+// the best found, which the depth-first order makes likely to exist.
+//
+// costMatrix is subject to the same restrictions as for
+// NewHungarianAlgorithm, the jobs must be columns of it, and maxNodes must
+// be positive, or ErrorInvalidOption is returned.
+//
+// return the assignment, which assigns as many workers as Execute would,
+// and whether it is known to be optimal; or ErrorInfeasible if no
+// assignment satisfying the constraints was found.
+func SolvePrecedence(costMatrix [][]float64, precedes [][2]int, maxNodes int) (Assignment, bool, error) {
+	if err := checkCostMatrix(costMatrix, false); err != nil {
+		return nil, false, err
+	}
+	rows, cols := len(costMatrix), 0
+	if rows > 0 {
+		cols = len(costMatrix[0])
+	}
+	for _, p := range precedes {
+		if p[0] < 0 || p[0] >= cols || p[1] < 0 || p[1] >= cols {
+			return nil, false, ErrorInvalidOption
+		}
+	}
+	if maxNodes <= 0 {
+		return nil, false, ErrorInvalidOption
+	}
+	s := &precedenceSearch{costMatrix: costMatrix, rows: rows, cols: cols, precedes: precedes}
+	if rows >= cols {
+		// Every job is assigned, so no constraint binds.
+		s.precedes = nil
+	}
+	var stack []precedenceNode
+	if root, ok := s.solve(precedenceNode{}); ok {
+		stack = append(stack, root)
+	}
+	nodes := 1
+	var best Assignment
+	bestCost := math.Inf(1)
+	result := func(exact bool) (Assignment, bool, error) {
+		if best == nil {
+			return nil, exact, ErrorInfeasible
+		}
+		return best, exact, nil
+	}
+	for len(stack) > 0 {
+		node := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if node.cost >= bestCost {
+			continue
+		}
+		a, b, broken := s.broken(node.assignment)
+		if !broken {
+			best, bestCost = node.assignment, node.cost
+			continue
+		}
+		if nodes+2 > maxNodes {
+			return result(false)
+		}
+		nodes += 2
+		without := node.with(b, false)
+		with := node.with(a, true)
+		var children []precedenceNode
+		for _, child := range []precedenceNode{without, with} {
+			if child, ok := s.solve(child); ok && child.cost < bestCost {
+				children = append(children, child)
+			}
+		}
+		// Explore the cheaper child first.
+		if len(children) == 2 && children[0].cost < children[1].cost {
+			children[0], children[1] = children[1], children[0]
+		}
+		stack = append(stack, children...)
+	}
+	return result(true)
+}
+
+// The state of the branch and bound search of SolvePrecedence.
+type precedenceSearch struct {
+	costMatrix [][]float64
+	rows, cols int
+	precedes   [][2]int
+}
+
+// A subproblem of SolvePrecedence: the jobs which must and which must not
+// be assigned, and the optimal assignment of the subproblem, ignoring the
+// constraints, and its cost.
+type precedenceNode struct {
+	required, excluded []int
+	assignment         Assignment
+	cost               float64
+}
+
+// return a subproblem of n in which job j must be assigned, if required
+// is true, or must not be otherwise.
+func (n precedenceNode) with(j int, required bool) precedenceNode {
+	child := precedenceNode{required: n.required, excluded: n.excluded}
+	if required {
+		child.required = append(append([]int(nil), n.required...), j)
+	} else {
+		child.excluded = append(append([]int(nil), n.excluded...), j)
+	}
+	return child
+}
+
+// return the subproblem n with its assignment, and false if it has none.
+// The cost matrix is padded to be square with rows for the unassigned
+// jobs, which a job must not take if it is required, and which are the
+// only ones an excluded job may take.
+func (s *precedenceSearch) solve(n precedenceNode) (precedenceNode, bool) {
+	dim := s.rows
+	if s.cols > dim {
+		dim = s.cols
+	}
+	m := make([][]float64, dim)
+	for w := range m {
+		m[w] = make([]float64, dim)
+		if w < s.rows {
+			copy(m[w], s.costMatrix[w])
+		}
+	}
+	for _, j := range n.required {
+		for w := s.rows; w < dim; w++ {
+			m[w][j] = math.Inf(1)
+		}
+	}
+	for _, j := range n.excluded {
+		for w := 0; w < s.rows; w++ {
+			m[w][j] = math.Inf(1)
+		}
+	}
+	h, err := NewHungarianAlgorithm(m, allowForbidden())
+	if err != nil {
+		return n, false
+	}
+	perm, err := h.execute()
+	if err != nil {
+		return n, false
+	}
+	n.assignment = append(make(Assignment, 0, s.rows), perm[:s.rows]...)
+	for w, j := range n.assignment {
+		if j >= s.cols {
+			n.assignment[w] = -1
+		}
+	}
+	n.cost = n.assignment.Cost(s.costMatrix)
+	return n, true
+}
+
+// return the first constraint that job b may only be assigned if job a
+// is which the assignment breaks, and whether there is one.
+func (s *precedenceSearch) broken(assignment Assignment) (a, b int, ok bool) {
+	assigned := make([]bool, s.cols)
+	for _, j := range assignment {
+		if j != -1 {
+			assigned[j] = true
+		}
+	}
+	for _, p := range s.precedes {
+		if assigned[p[1]] && !assigned[p[0]] {
+			return p[0], p[1], true
+		}
+	}
+	return 0, 0, false
+}
@@ -0,0 +1,96 @@
+package munkres_test
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/charles-haynes/munkres"
+)
+
+// return the least cost of an assignment of every worker of c, which has
+// at least as many jobs as workers, satisfying the precedence
+// constraints, and false if there is none.
+func bruteForcePrecedence(c [][]float64, precedes [][2]int) (float64, bool) {
+	best, found := math.Inf(1), false
+	a := make([]int, len(c))
+	used := make([]bool, len(c[0]))
+	var visit func(w int, cost float64)
+	visit = func(w int, cost float64) {
+		if w == len(c) {
+			for _, p := range precedes {
+				if used[p[1]] && !used[p[0]] {
+					return
+				}
+			}
+			if cost < best {
+				best, found = cost, true
+			}
+			return
+		}
+		for j := range used {
+			if !used[j] {
+				used[j], a[w] = true, j
+				visit(w+1, cost+c[w][j])
+				used[j] = false
+			}
+		}
+	}
+	visit(0, 0)
+	return best, found
+}
+
+func TestSolvePrecedence(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 200; i++ {
+		cols := 2 + rng.Intn(5)
+		rows := 1 + rng.Intn(cols)
+		c := randomMatrix(rng, rows, cols)
+		var precedes [][2]int
+		for k := rng.Intn(4); k > 0; k-- {
+			precedes = append(precedes, [2]int{rng.Intn(cols), rng.Intn(cols)})
+		}
+		want, ok := bruteForcePrecedence(c, precedes)
+		a, exact, err := munkres.SolvePrecedence(c, precedes, 1000)
+		if !exact {
+			t.Fatalf("%v %v: search not finished", c, precedes)
+		}
+		if !ok {
+			if err != munkres.ErrorInfeasible {
+				t.Errorf("%v %v: want %v got %v", c, precedes, munkres.ErrorInfeasible, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%v %v: %v", c, precedes, err)
+		}
+		if got := a.Cost(c); math.Abs(got-want) > 1e-9 || count(a) != rows {
+			t.Errorf("%v %v: want cost %f got %f from %v", c, precedes, want, got, a)
+		}
+	}
+}
+
+func TestSolvePrecedenceNodes(t *testing.T) {
+	// Job 1 is the cheapest for the only worker, but needs job 0.
+	c := [][]float64{{5, 1, 3}}
+	precedes := [][2]int{{0, 1}}
+	if _, exact, err := munkres.SolvePrecedence(c, precedes, 1); exact || err != munkres.ErrorInfeasible {
+		t.Errorf("want an unfinished search got %v, %v", exact, err)
+	}
+	a, exact, err := munkres.SolvePrecedence(c, precedes, 3)
+	if err != nil || !exact || a[0] != 2 {
+		t.Errorf("want job 2 got %v, %v, %v", a, exact, err)
+	}
+	for _, test := range []struct {
+		precedes [][2]int
+		nodes    int
+	}{
+		{[][2]int{{0, 3}}, 10},
+		{[][2]int{{-1, 0}}, 10},
+		{nil, 0},
+	} {
+		if _, _, err := munkres.SolvePrecedence(c, test.precedes, test.nodes); err != munkres.ErrorInvalidOption {
+			t.Errorf("%v %d: want %v got %v", test.precedes, test.nodes, munkres.ErrorInvalidOption, err)
+		}
+	}
+}
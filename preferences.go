@@ -0,0 +1,112 @@
+package munkres
+
+import "math"
+
+// A RankScheme converts the rank of a preference, from 0 for the most
+// preferred, into a cost for NewFromPreferences.
+type RankScheme int
+
+const (
+	// LinearRank costs each preference its rank, so that every step
+	// down a list costs the same.
+	LinearRank RankScheme = iota
+	// BordaRank costs each preference minus its Borda score, the number
+	// of alternatives ranked below it and itself, so that the
+	// preferences of a longer list weigh more.
+	BordaRank
+	// ExponentialRank costs each preference 2^rank - 1, so that a first
+	// choice is worth more than all lower choices together.
+	ExponentialRank
+)
+
+// return the cost of rank in a list of length n under the scheme.
+func (s RankScheme) cost(rank, n int) float64 {
+	switch s {
+	case BordaRank:
+		return -float64(n - rank)
+	case ExponentialRank:
+		return math.Exp2(float64(rank)) - 1
+	}
+	return float64(rank)
+}
+
+// NewFromPreferences creates an assignment problem of workers to jobs
+// from ordered preference lists, such as those of students for courses.
+// workerPrefs[w] lists the jobs acceptable to worker w, most preferred
+// first, and jobPrefs, if it is not nil, lists the workers acceptable to
+// each job likewise. The cost of a pair is the sum of the costs of its
+// ranks in the lists of its worker and its job, under the scheme.
+//
+// A pair which is missing from a list is forbidden if forbidUnranked is
+// true, and otherwise costs as the rank just past the end of that list,
+// more than any it ranks.
+//
+// return ErrorDimensionMismatch if jobPrefs is not nil and does not have
+// a list for each of the jobs, or if a list holds a job or worker out of
+// range, ErrorDuplicateJob or ErrorDuplicateWorker if a list holds one
+// twice, or ErrorInvalidOption for an unknown scheme.
+func NewFromPreferences(workerPrefs, jobPrefs [][]int, jobs int, scheme RankScheme, forbidUnranked bool) (*Bipartite, error) {
+	if scheme < LinearRank || scheme > ExponentialRank {
+		return nil, ErrorInvalidOption
+	}
+	workers := len(workerPrefs)
+	if jobs < 0 || jobPrefs != nil && len(jobPrefs) != jobs {
+		return nil, ErrorDimensionMismatch
+	}
+	workerRanks, err := preferenceRanks(workerPrefs, jobs, ErrorDuplicateJob)
+	if err != nil {
+		return nil, err
+	}
+	jobRanks, err := preferenceRanks(jobPrefs, workers, ErrorDuplicateWorker)
+	if err != nil {
+		return nil, err
+	}
+	costMatrix := make([][]float64, workers)
+	for w := range costMatrix {
+		costMatrix[w] = make([]float64, jobs)
+		for j := range costMatrix[w] {
+			rank := workerRanks[w][j]
+			if rank == -1 && forbidUnranked {
+				costMatrix[w][j] = math.Inf(1)
+				continue
+			}
+			if rank == -1 {
+				rank = len(workerPrefs[w])
+			}
+			costMatrix[w][j] = scheme.cost(rank, len(workerPrefs[w]))
+			if jobPrefs == nil {
+				continue
+			}
+			rank = jobRanks[j][w]
+			if rank == -1 && forbidUnranked {
+				costMatrix[w][j] = math.Inf(1)
+				continue
+			}
+			if rank == -1 {
+				rank = len(jobPrefs[j])
+			}
+			costMatrix[w][j] += scheme.cost(rank, len(jobPrefs[j]))
+		}
+	}
+	return &Bipartite{costMatrix: costMatrix, jobs: jobs}, nil
+}
+
+// return, for each list of preferences among n alternatives, the rank of
+// each alternative in it, or -1 if it is unranked, or the error of a
+// list holding an alternative out of range or, as duplicate, twice.
+func preferenceRanks(prefs [][]int, n int, duplicate error) ([][]int, error) {
+	ranks := make([][]int, len(prefs))
+	for i, list := range prefs {
+		ranks[i] = unassigned(n)
+		for rank, k := range list {
+			if k < 0 || k >= n {
+				return nil, ErrorDimensionMismatch
+			}
+			if ranks[i][k] != -1 {
+				return nil, duplicate
+			}
+			ranks[i][k] = rank
+		}
+	}
+	return ranks, nil
+}
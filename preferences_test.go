@@ -0,0 +1,53 @@
+package munkres_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/charles-haynes/munkres"
+)
+
+func TestNewFromPreferences(t *testing.T) {
+	// Workers 0 and 1 both prefer job 0, which prefers worker 1.
+	workerPrefs := [][]int{{0, 1}, {0, 2}, {2}}
+	jobPrefs := [][]int{{1, 0}, {0}, {2, 1}}
+	for _, test := range []struct {
+		jobPrefs       [][]int
+		scheme         munkres.RankScheme
+		forbidUnranked bool
+		want           []int
+		err            error
+	}{
+		{nil, munkres.LinearRank, true, []int{1, 0, 2}, nil},
+		{jobPrefs, munkres.LinearRank, true, []int{1, 0, 2}, nil},
+		{jobPrefs, munkres.BordaRank, false, []int{1, 0, 2}, nil},
+		{jobPrefs, munkres.ExponentialRank, false, []int{1, 0, 2}, nil},
+		// Job 1 accepts only worker 0, and job 0 prefers worker 1, so
+		// forbidding the unranked pairs leaves worker 2 out.
+		{[][]int{{1, 0}, {0}, {1}}, munkres.LinearRank, true, nil, munkres.ErrorInfeasible},
+	} {
+		b, err := munkres.NewFromPreferences(workerPrefs, test.jobPrefs, 3, test.scheme, test.forbidUnranked)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := b.Solve()
+		if err != test.err || !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%v, %d: want %v, %v got %v, %v", test.jobPrefs, test.scheme, test.want, test.err, got, err)
+		}
+	}
+	for _, test := range []struct {
+		workerPrefs, jobPrefs [][]int
+		scheme                munkres.RankScheme
+		err                   error
+	}{
+		{[][]int{{0, 0}}, nil, munkres.LinearRank, munkres.ErrorDuplicateJob},
+		{[][]int{{0}}, [][]int{{0, 0}}, munkres.LinearRank, munkres.ErrorDuplicateWorker},
+		{[][]int{{1}}, nil, munkres.LinearRank, munkres.ErrorDimensionMismatch},
+		{[][]int{{0}}, [][]int{}, munkres.LinearRank, munkres.ErrorDimensionMismatch},
+		{[][]int{{0}}, nil, munkres.RankScheme(7), munkres.ErrorInvalidOption},
+	} {
+		if _, err := munkres.NewFromPreferences(test.workerPrefs, test.jobPrefs, 1, test.scheme, false); err != test.err {
+			t.Errorf("%v, %v: want %v got %v", test.workerPrefs, test.jobPrefs, test.err, err)
+		}
+	}
+}
@@ -0,0 +1,83 @@
+package munkres
+
+import "sync/atomic"
+
+// A Snapshot reports the progress of Execute.
+type Snapshot struct {
+	// Matched is the number matched so far of the Total which must be
+	// matched: the workers of the padded square matrix, or, when the
+	// smaller side is matched directly, the workers or jobs of that
+	// side.
+	Matched, Total int
+	// Phases is the number of phases of the algorithm completed, each
+	// of which matches one more.
+	Phases int
+}
+
+// The progress of Execute, and whether it has been asked to stop, accessed
+// atomically so that they can be shared with other goroutines. It is held
+// by pointer, so that the 64-bit counters are aligned for atomic access on
+// 32-bit platforms.
+type progress struct {
+	matched, total, phases int64
+	stop                   int32
+	// The callback set WithProgress, or nil.
+	report func(Snapshot)
+}
+
+// WithProgress calls report with the progress of Execute after each of its
+// phases, so that a caller can surface the progress of a long solve, or
+// Stop it, without polling Snapshot from another goroutine. report is
+// called on the goroutine running Execute, which waits for it to return.
+// Solves WithWorkerGroups have no phases, so are not reported.
+func WithProgress(report func(Snapshot)) Option {
+	return func(o *options) {
+		o.report = report
+	}
+}
+
+// Snapshot returns the progress of Execute. It may be called from another
+// goroutine while Execute runs, such as to observe a slow solve, and
+// reports zero progress before Execute starts. Solves WithWorkerGroups
+// report their progress only once complete.
+func (h *HungarianAlgorithm) Snapshot() Snapshot {
+	if h.progress == nil {
+		return Snapshot{}
+	}
+	return h.progress.snapshot()
+}
+
+func (p *progress) snapshot() Snapshot {
+	return Snapshot{
+		Matched: int(atomic.LoadInt64(&p.matched)),
+		Total:   int(atomic.LoadInt64(&p.total)),
+		Phases:  int(atomic.LoadInt64(&p.phases)),
+	}
+}
+
+// Record the start of a solve which must match total.
+func (p *progress) start(total int) {
+	atomic.StoreInt64(&p.phases, 0)
+	atomic.StoreInt64(&p.matched, 0)
+	atomic.StoreInt64(&p.total, int64(total))
+}
+
+// Record that the solve must match total, as the problem has been
+// resized.
+func (p *progress) setTotal(total int) {
+	atomic.StoreInt64(&p.total, int64(total))
+}
+
+// Record that matched are matched, without a phase.
+func (p *progress) setMatched(matched int) {
+	atomic.StoreInt64(&p.matched, int64(matched))
+}
+
+// Record the completion of a phase, which matches one more.
+func (p *progress) phase() {
+	atomic.AddInt64(&p.phases, 1)
+	atomic.AddInt64(&p.matched, 1)
+	if p.report != nil {
+		p.report(p.snapshot())
+	}
+}
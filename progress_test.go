@@ -0,0 +1,106 @@
+package munkres_test
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+
+	"github.com/charles-haynes/munkres"
+	"github.com/charles-haynes/munkres/generate"
+)
+
+func TestSnapshot(t *testing.T) {
+	for _, tc := range []struct {
+		rows, cols, total int
+	}{
+		{50, 50, 50},
+		{10, 40, 10},
+		{40, 10, 10},
+		{0, 0, 0},
+	} {
+		c := generate.Uniform(rand.New(rand.NewSource(1)), tc.rows, tc.cols, 1000)
+		h, err := munkres.NewHungarianAlgorithm(c)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if s := h.Snapshot(); s != (munkres.Snapshot{}) {
+			t.Errorf("want no progress before Execute got %+v", s)
+		}
+		h.Execute()
+		s := h.Snapshot()
+		if s.Matched != tc.total || s.Total != tc.total || s.Phases > tc.total {
+			t.Errorf("%dx%d: want %d of %d matched got %+v", tc.rows, tc.cols, tc.total, tc.total, s)
+		}
+	}
+}
+
+func TestSnapshotConcurrent(t *testing.T) {
+	c := generate.Uniform(rand.New(rand.NewSource(1)), 300, 300, 1000000)
+	h, err := munkres.NewHungarianAlgorithm(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	done := make(chan struct{})
+	go func() {
+		h.Execute()
+		close(done)
+	}()
+	last := 0
+	for running := true; running; {
+		select {
+		case <-done:
+			running = false
+		default:
+		}
+		s := h.Snapshot()
+		if s.Matched < last || s.Matched > 300 {
+			t.Fatalf("want progress to grow up to 300 got %d after %d", s.Matched, last)
+		}
+		last = s.Matched
+	}
+	if s := h.Snapshot(); s.Matched != 300 {
+		t.Errorf("want 300 matched got %+v", s)
+	}
+}
+
+func TestWithProgress(t *testing.T) {
+	for _, tc := range []struct{ rows, cols int }{{60, 60}, {10, 40}, {40, 10}} {
+		c := generate.Uniform(rand.New(rand.NewSource(1)), tc.rows, tc.cols, 1000)
+		var snapshots []munkres.Snapshot
+		h, _ := munkres.NewHungarianAlgorithm(c, munkres.WithProgress(func(s munkres.Snapshot) {
+			snapshots = append(snapshots, s)
+		}))
+		h.Execute()
+		if len(snapshots) == 0 {
+			t.Fatalf("%dx%d: no progress reported", tc.rows, tc.cols)
+		}
+		for i, s := range snapshots {
+			if s.Phases != i+1 || s.Matched > s.Total {
+				t.Errorf("%dx%d: report %d is %+v", tc.rows, tc.cols, i, s)
+			}
+		}
+		if last := snapshots[len(snapshots)-1]; last != h.Snapshot() || last.Matched != last.Total {
+			t.Errorf("%dx%d: want the last report complete got %+v", tc.rows, tc.cols, last)
+		}
+	}
+}
+
+func TestWithProgressCancel(t *testing.T) {
+	c := generate.Uniform(rand.New(rand.NewSource(2)), 200, 200, 1000000)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	h, _ := munkres.NewHungarianAlgorithm(c, munkres.WithProgress(func(s munkres.Snapshot) {
+		if s.Matched*2 >= s.Total {
+			cancel()
+		}
+	}))
+	if _, err := h.ExecuteContext(ctx); err != context.Canceled {
+		t.Fatalf("want context.Canceled got %v", err)
+	}
+	if s := h.Snapshot(); s.Matched == s.Total {
+		t.Errorf("want an unfinished solve got %+v", s)
+	}
+	if _, err := h.ExecuteContext(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+}
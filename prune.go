@@ -0,0 +1,121 @@
+package munkres
+
+import "math"
+
+// Prune finds the entries of a cost matrix which cannot be in any optimal
+// assignment, so that they can be dropped before solving. The least cost
+// of each worker and the least remaining cost of each job are dual
+// prices, as for the lower bound of Quality, and an assignment using an
+// entry costs at least that bound plus the entry's reduced cost, its cost
+// less the prices of its worker and job. If that exceeds the cost of the
+// assignment found by SolveGreedy, the entry is dominated. Pruning takes
+// time O(n^2 log n), and removes most entries of structured problems in
+// which greedy assignment is nearly optimal.
+//
+// costMatrix is subject to the same restrictions as for
+// NewHungarianAlgorithm.
+//
+// return a copy of costMatrix with the dominated entries replaced by +Inf,
+// as taken by SolveBlocks, and the number of them.
+func Prune(costMatrix [][]float64) ([][]float64, int, error) {
+	a, q, err := SolveGreedy(costMatrix)
+	if err != nil {
+		return nil, 0, err
+	}
+	rows, cols := len(costMatrix), 0
+	if rows > 0 {
+		cols = len(costMatrix[0])
+	}
+	// Price the workers if every worker is assigned, otherwise the jobs,
+	// and the other side too if both are.
+	var reduced func(w, j int) float64
+	if rows <= cols {
+		reduced = reducedCosts(rows, cols, rows == cols,
+			func(w, j int) float64 { return costMatrix[w][j] })
+	} else {
+		byJob := reducedCosts(cols, rows, false,
+			func(j, w int) float64 { return costMatrix[w][j] })
+		reduced = func(w, j int) float64 { return byJob(j, w) }
+	}
+	lower := 0.0
+	for w, j := range a {
+		if j != -1 {
+			lower += costMatrix[w][j] - reduced(w, j)
+		}
+	}
+	limit := q.Cost + pricingTolerance*math.Max(1, math.Abs(q.Cost))
+	pruned := make([][]float64, rows)
+	n := 0
+	for w := range costMatrix {
+		pruned[w] = append([]float64(nil), costMatrix[w]...)
+		for j := range pruned[w] {
+			if lower+reduced(w, j) > limit {
+				pruned[w][j] = math.Inf(1)
+				n++
+			}
+		}
+	}
+	return pruned, n, nil
+}
+
+// return the reduced costs of an n by m matrix, with n <= m, after
+// subtracting the least cost of each row and, if both is true, the least
+// remaining cost of each column.
+func reducedCosts(n, m int, both bool, cost func(i, k int) float64) func(i, k int) float64 {
+	least := make([]float64, n)
+	for i := range least {
+		least[i] = math.Inf(1)
+		for k := 0; k < m; k++ {
+			least[i] = math.Min(least[i], cost(i, k))
+		}
+	}
+	remaining := make([]float64, m)
+	for k := 0; k < m && both; k++ {
+		remaining[k] = math.Inf(1)
+		for i := 0; i < n; i++ {
+			remaining[k] = math.Min(remaining[k], cost(i, k)-least[i])
+		}
+	}
+	return func(i, k int) float64 {
+		return cost(i, k) - least[i] - remaining[k]
+	}
+}
+
+// SolvePruned solves the assignment problem by dropping the entries found
+// by Prune and solving the rest as a sparse problem, in time and memory
+// proportional to the number of entries left rather than to the size of
+// the cost matrix. The result is optimal, as no optimal assignment uses a
+// dropped entry; should rounding leave the rest without a complete
+// assignment, the full problem is solved instead.
+//
+// costMatrix is subject to the same restrictions as for
+// NewHungarianAlgorithm.
+//
+// return the assignment as for Execute.
+func SolvePruned(costMatrix [][]float64) ([]int, error) {
+	pruned, _, err := Prune(costMatrix)
+	if err != nil {
+		return nil, err
+	}
+	rows, cols := len(costMatrix), 0
+	if rows > 0 {
+		cols = len(costMatrix[0])
+	}
+	var edges []sparseEdge
+	for w := range pruned {
+		for j, c := range pruned[w] {
+			if !math.IsInf(c, 1) {
+				edges = append(edges, sparseEdge{w, j, c})
+			}
+		}
+	}
+	result, _, _, err := solveSparse(rows, cols, edges)
+	if err == ErrorInfeasible {
+		h, err := NewHungarianAlgorithm(costMatrix)
+		if err != nil {
+			return nil, err
+		}
+		return h.Execute(), nil
+	}
+	return result, err
+}
@@ -0,0 +1,72 @@
+package munkres_test
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/charles-haynes/munkres"
+)
+
+func TestPrune(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 200; i++ {
+		c := randomMatrix(rng, 1+rng.Intn(8), 1+rng.Intn(8))
+		pruned, n, err := munkres.Prune(c)
+		if err != nil {
+			t.Fatal(err)
+		}
+		h, _ := munkres.NewHungarianAlgorithm(c)
+		for w, j := range h.Execute() {
+			if j != -1 && math.IsInf(pruned[w][j], 1) {
+				t.Errorf("%v: optimal pair (%d, %d) pruned", c, w, j)
+			}
+		}
+		infinite := 0
+		for w := range pruned {
+			for j := range pruned[w] {
+				if math.IsInf(pruned[w][j], 1) {
+					infinite++
+				} else if pruned[w][j] != c[w][j] {
+					t.Errorf("%v: cost (%d, %d) changed to %f", c, w, j, pruned[w][j])
+				}
+			}
+		}
+		if infinite != n {
+			t.Errorf("%v: want %d pruned got %d", c, infinite, n)
+		}
+	}
+}
+
+func TestPruneStructured(t *testing.T) {
+	// Costs growing steeply away from the diagonal, which greedy
+	// assignment solves, leave only the entries near it.
+	rng := rand.New(rand.NewSource(1))
+	const n = 20
+	c := make([][]float64, n)
+	for w := range c {
+		c[w] = make([]float64, n)
+		for j := range c[w] {
+			c[w][j] = 10*math.Abs(float64(w-j)) + rng.Float64()
+		}
+	}
+	if _, pruned, err := munkres.Prune(c); err != nil || pruned < n*n/2 {
+		t.Errorf("want at least %d entries pruned got %d (%v)", n*n/2, pruned, err)
+	}
+}
+
+func TestSolvePruned(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 200; i++ {
+		c := randomMatrix(rng, 1+rng.Intn(8), 1+rng.Intn(8))
+		h, _ := munkres.NewHungarianAlgorithm(c)
+		want, _ := munkres.ComputeCost(c, h.Execute())
+		a, err := munkres.SolvePruned(c)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, err := munkres.ComputeCost(c, a); err != nil || math.Abs(got-want) > 1e-9 {
+			t.Errorf("%v: want cost %f got %f (%v)", c, want, got, err)
+		}
+	}
+}
@@ -0,0 +1,88 @@
+package munkres
+
+import "math"
+
+// SolveQuantized approximately solves the assignment problem with the
+// costs quantized to 16 bits, for very large problems, such as tracking,
+// whose costs tolerate it. The solver's copy of the matrix takes a
+// quarter of the memory of NewHungarianAlgorithm's, and its labels are
+// integers.
+//
+// Each cost c is stored as the nearest of 65536 levels spaced evenly
+// from the least cost to the greatest, so it is off by at most half the
+// spacing s = (max - min) / 65535. The assignment is optimal for the
+// quantized costs, so it costs at most k*s more than an optimal one,
+// where k is the lesser of the numbers of workers and jobs. The Quality
+// reports a lower bound derived from the quantized optimum, with a Gap
+// which is within that bound.
+func SolveQuantized(costMatrix [][]float64) (Assignment, Quality, error) {
+	if err := checkCostMatrix(costMatrix, false); err != nil {
+		return nil, Quality{}, err
+	}
+	rows, cols := len(costMatrix), 0
+	if rows > 0 {
+		cols = len(costMatrix[0])
+	}
+	if rows == 0 || cols == 0 {
+		a := Assignment(unassigned(rows))
+		return a, Quality{}, nil
+	}
+	min, max := math.Inf(1), math.Inf(-1)
+	for _, row := range costMatrix {
+		for _, c := range row {
+			min = math.Min(min, c)
+			max = math.Max(max, c)
+		}
+	}
+	spacing := (max - min) / math.MaxUint16
+	if spacing == 0 {
+		spacing = 1
+	}
+	costs := make([]uint16, rows*cols)
+	for w, row := range costMatrix {
+		for j, c := range row {
+			costs[w*cols+j] = uint16(math.Round((c - min) / spacing))
+		}
+	}
+	a, total := solveQuantized(rows, cols, costs)
+	k := float64(rows)
+	if cols < rows {
+		k = float64(cols)
+	}
+	q := Quality{
+		Cost:       a.Cost(costMatrix),
+		LowerBound: k*min + spacing*(float64(total)-k/2),
+	}
+	q.Gap = q.Cost - q.LowerBound
+	return a, q, nil
+}
+
+// Solve the assignment problem for the rows x cols quantized costs in
+// row-major order by the shortest augmenting path form of the algorithm,
+// as for solveRows, in integer arithmetic.
+//
+// return the assignment, and its total quantized cost.
+func solveQuantized(rows, cols int, costs []uint16) (Assignment, int64) {
+	n := rows
+	if cols > n {
+		n = cols
+	}
+	// The matrix is padded to be square with workers of zero costs, as
+	// the padded form of the algorithm pads it.
+	_, _, jobByWorker, _ := augmentRows(n, n, int64(math.MaxInt64),
+		func(w int) ([]uint16, error) {
+			if w < rows {
+				return costs[w*cols : (w+1)*cols], nil
+			}
+			return nil, nil
+		})
+	result := Assignment(unassigned(rows))
+	total := int64(0)
+	for w, j := range jobByWorker[:rows] {
+		if j < cols {
+			result[w] = j
+			total += int64(costs[w*cols+j])
+		}
+	}
+	return result, total
+}
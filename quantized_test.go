@@ -0,0 +1,56 @@
+package munkres_test
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/charles-haynes/munkres"
+)
+
+func TestSolveQuantized(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 50; i++ {
+		rows, cols := 1+rng.Intn(12), 1+rng.Intn(12)
+		c := make([][]float64, rows)
+		for w := range c {
+			c[w] = make([]float64, cols)
+			for j := range c[w] {
+				c[w][j] = rng.NormFloat64() * 1000
+			}
+		}
+		h, _ := munkres.NewHungarianAlgorithm(c)
+		optimum, _ := munkres.ComputeCost(c, h.Execute())
+		a, q, err := munkres.SolveQuantized(c)
+		if err != nil {
+			t.Fatal(err)
+		}
+		cost, err := munkres.ComputeCost(c, a)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if cost != q.Cost {
+			t.Errorf("%v: want cost = %f got %f", c, cost, q.Cost)
+		}
+		if q.LowerBound > optimum+1e-9 || cost < optimum-1e-9 {
+			t.Errorf("%v: want %f <= %f <= %f", c, q.LowerBound, optimum, cost)
+		}
+		min, max := math.Inf(1), math.Inf(-1)
+		for _, row := range c {
+			for _, x := range row {
+				min, max = math.Min(min, x), math.Max(max, x)
+			}
+		}
+		k := math.Min(float64(rows), float64(cols))
+		if bound := k * (max - min) / math.MaxUint16; q.Gap > bound+1e-9 {
+			t.Errorf("%v: want gap <= %f got %f", c, bound, q.Gap)
+		}
+	}
+}
+
+func TestSolveQuantizedEmpty(t *testing.T) {
+	a, _, err := munkres.SolveQuantized([][]float64{{}, {}})
+	if err != nil || len(a) != 2 || a[0] != -1 || a[1] != -1 {
+		t.Errorf("want [-1 -1] got %v (%v)", a, err)
+	}
+}
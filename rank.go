@@ -0,0 +1,44 @@
+package munkres
+
+import (
+	"math"
+	"sort"
+)
+
+// WithRankTransform makes NewHungarianAlgorithm replace the costs of each
+// worker by their ranks among that worker's costs, from 1 for its least
+// cost to the number of jobs for its greatest, before solving. Tied costs
+// share the mean of their ranks, and forbidden assignments stay
+// forbidden. The assignment is then unaffected by outliers and by any
+// increasing distortion of each worker's costs, such as when the costs
+// of different workers come from differently scaled scoring models.
+//
+// Costs derived from the matrix, such as backup switch costs and duals,
+// are in terms of the ranks.
+func WithRankTransform() Option {
+	return func(o *options) {
+		o.rankTransform = true
+	}
+}
+
+// Replace each of the first n entries of row by its rank among them.
+func rankRow(row []float64, n int) {
+	order := make([]int, n)
+	for j := range order {
+		order[j] = j
+	}
+	sort.SliceStable(order, func(a, b int) bool { return row[order[a]] < row[order[b]] })
+	for first := 0; first < n; {
+		last := first + 1
+		for last < n && row[order[last]] == row[order[first]] {
+			last++
+		}
+		rank := float64(first+last+1) / 2
+		for _, j := range order[first:last] {
+			if !math.IsInf(row[j], 1) {
+				row[j] = rank
+			}
+		}
+		first = last
+	}
+}
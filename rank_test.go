@@ -0,0 +1,51 @@
+package munkres_test
+
+import (
+	"math"
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/charles-haynes/munkres"
+)
+
+func TestRankTransform(t *testing.T) {
+	c := [][]float64{
+		{3, 1, 3, 1e9},
+		{0, 500, 1000, 2},
+		{7, 8, 9, 10},
+	}
+	ranks := [][]float64{
+		{2.5, 1, 2.5, 4},
+		{1, 3, 4, 2},
+		{1, 2, 3, 4},
+	}
+	h, _ := munkres.NewHungarianAlgorithm(ranks)
+	want, _ := munkres.ComputeCost(ranks, h.Execute())
+	h, _ = munkres.NewHungarianAlgorithm(c, munkres.WithRankTransform())
+	if got, _ := munkres.ComputeCost(ranks, h.Execute()); got != want {
+		t.Errorf("want rank cost = %f got %f", want, got)
+	}
+}
+
+func TestRankTransformInvariance(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 50; i++ {
+		c := randomMatrix(rng, 1+rng.Intn(8), 1+rng.Intn(8))
+		// An increasing distortion of each row, differing by row.
+		d := make([][]float64, len(c))
+		for w := range c {
+			scale := 1 + rng.Float64()*100
+			d[w] = make([]float64, len(c[w]))
+			for j, x := range c[w] {
+				d[w][j] = scale * math.Exp(x)
+			}
+		}
+		h, _ := munkres.NewHungarianAlgorithm(c, munkres.WithRankTransform())
+		want := h.Execute()
+		h, _ = munkres.NewHungarianAlgorithm(d, munkres.WithRankTransform())
+		if got := h.Execute(); !reflect.DeepEqual(got, want) {
+			t.Errorf("%v: want %v got %v", d, want, got)
+		}
+	}
+}
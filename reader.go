@@ -0,0 +1,180 @@
+package munkres
+
+import (
+	"bufio"
+	"encoding/binary"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+// A Format is an encoding of a cost matrix read by NewFromReader.
+type Format int
+
+const (
+	// FormatCSV is one row of the cost matrix per line, with the
+	// costs separated by commas.
+	FormatCSV Format = iota
+	// FormatBinary is the number of rows and of columns, each as a
+	// little-endian uint64, followed by the costs as little-endian
+	// IEEE 754 float64s in row-major order.
+	FormatBinary
+)
+
+// A ParseError is returned by NewFromReader, and by the other functions
+// which read a cost matrix, for a row of the matrix which cannot be read
+// or which is invalid.
+type ParseError struct {
+	// Row is the index of the row, from 0.
+	Row int
+	// Err is the reason, such as ErrorIrregularCostMatrix or an
+	// error from decoding the row.
+	Err error
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("Row %d: %v", e.Row, e.Err)
+}
+
+// Unwrap returns the reason for a ParseError.
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// NewFromReader constructs an instance of the algorithm from a cost
+// matrix read from r in the given format. The matrix is decoded and
+// checked one row at a time, so the input is never held in memory in its
+// encoded form, and an invalid matrix is reported by a *ParseError as
+// soon as its first invalid row has been read.
+func NewFromReader(r io.Reader, format Format, opts ...Option) (HungarianAlgorithm, error) {
+	switch format {
+	case FormatCSV:
+		return newFromCSV(r, opts)
+	case FormatBinary:
+		return newFromBinary(r, opts)
+	}
+	return HungarianAlgorithm{}, ErrorInvalidOption
+}
+
+func newFromCSV(r io.Reader, opts []Option) (HungarianAlgorithm, error) {
+	o := newOptions(opts)
+	if o.sanitize != nil && !o.sanitize.valid() {
+		return HungarianAlgorithm{}, ErrorInvalidOption
+	}
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	cr.ReuseRecord = true
+	cr.TrimLeadingSpace = true
+	var costMatrix [][]float64
+	for w := 0; ; w++ {
+		record, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return HungarianAlgorithm{}, &ParseError{Row: w, Err: err}
+		}
+		if w > 0 && len(record) != len(costMatrix[0]) && o.validation != PermissiveValidation {
+			return HungarianAlgorithm{}, &ParseError{Row: w, Err: &ShapeError{Row: w, Cols: len(record), Want: len(costMatrix[0])}}
+		}
+		if err := o.checkDimension(w+1, len(record)); err != nil {
+			return HungarianAlgorithm{}, err
+		}
+		row := make([]float64, len(record))
+		for j, field := range record {
+			if row[j], err = strconv.ParseFloat(field, 64); err != nil {
+				return HungarianAlgorithm{}, &ParseError{Row: w, Err: err}
+			}
+		}
+		if err := o.prepareRow(w, row); err != nil {
+			return HungarianAlgorithm{}, err
+		}
+		costMatrix = append(costMatrix, row)
+	}
+	return newHungarianAlgorithmFrom(costMatrix, o)
+}
+
+// Negate, sanitize and check row w of a cost matrix in place, as
+// newHungarianAlgorithmFor would, so that an invalid row is reported by
+// a *ParseError as soon as it is read.
+func (o *options) prepareRow(w int, row []float64) error {
+	if o.maximize {
+		for j, p := range row {
+			row[j] = -p
+		}
+	}
+	if o.sanitize != nil {
+		o.sanitize.sanitizeRow(row)
+	}
+	if o.validation == PermissiveValidation {
+		o.clampRow(row)
+	}
+	if err := checkRow(w, row, o.forbidden); err != nil {
+		return &ParseError{Row: w, Err: err}
+	}
+	if o.validation == StrictValidation {
+		if err := checkStrictRow(w, row); err != nil {
+			return &ParseError{Row: w, Err: err}
+		}
+	}
+	return nil
+}
+
+// The number of costs of FormatBinary read at a time, so that the memory
+// taken grows with the costs the stream holds rather than with those its
+// header claims.
+const binaryChunk = 4096
+
+func newFromBinary(r io.Reader, opts []Option) (HungarianAlgorithm, error) {
+	o := newOptions(opts)
+	if o.sanitize != nil && !o.sanitize.valid() {
+		return HungarianAlgorithm{}, ErrorInvalidOption
+	}
+	br := bufio.NewReader(r)
+	var size [2]uint64
+	if err := binary.Read(br, binary.LittleEndian, &size); err != nil {
+		return HungarianAlgorithm{}, err
+	}
+	rows, cols := int(size[0]), int(size[1])
+	if uint64(rows) != size[0] || uint64(cols) != size[1] || rows < 0 || cols < 0 {
+		return HungarianAlgorithm{}, ErrorDimensionMismatch
+	}
+	dim := rows
+	if cols > dim {
+		dim = cols
+	}
+	if dim > 0 && dim > int(^uint(0)>>1)/dim {
+		// The padded matrix could not be allocated.
+		return HungarianAlgorithm{}, ErrorDimensionMismatch
+	}
+	if err := o.check(rows, cols); err != nil {
+		return HungarianAlgorithm{}, err
+	}
+	// Read and check every cost before allocating the padded matrix,
+	// so that a header claiming more than the stream holds is a
+	// *ParseError rather than an allocation which exhausts memory.
+	var data []float64
+	chunk := make([]float64, binaryChunk)
+	for w := 0; w < rows; w++ {
+		for left := cols; left > 0; {
+			n := left
+			if n > len(chunk) {
+				n = len(chunk)
+			}
+			if err := binary.Read(br, binary.LittleEndian, chunk[:n]); err != nil {
+				return HungarianAlgorithm{}, &ParseError{Row: w, Err: err}
+			}
+			data = append(data, chunk[:n]...)
+			left -= n
+		}
+		if err := o.prepareRow(w, data[w*cols:]); err != nil {
+			return HungarianAlgorithm{}, err
+		}
+	}
+	costMatrix := make([][]float64, rows)
+	for w := range costMatrix {
+		costMatrix[w] = data[w*cols : (w+1)*cols]
+	}
+	return newHungarianAlgorithmFrom(costMatrix, o)
+}
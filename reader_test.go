@@ -0,0 +1,108 @@
+package munkres_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/charles-haynes/munkres"
+)
+
+func TestNewFromReaderCSV(t *testing.T) {
+	h, err := munkres.NewFromReader(strings.NewReader("4, 1, 3\n2, 0, 5\n3, 2, 2\n"), munkres.FormatCSV)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := h.Execute(), []int{1, 0, 2}; !reflect.DeepEqual(got, want) {
+		t.Errorf("want %v got %v", want, got)
+	}
+	for _, test := range []struct {
+		input string
+		row   int
+		err   error
+	}{
+		{"1,2\n3\n", 1, munkres.ErrorIrregularCostMatrix},
+		{"1,2\n3,4\n5,NaN\n", 2, munkres.ErrorNaNCost},
+		{"1,Inf\n", 0, munkres.ErrorInfiniteCost},
+		{"1,2\nx,4\n", 1, nil},
+	} {
+		_, err := munkres.NewFromReader(strings.NewReader(test.input), munkres.FormatCSV)
+		var parseError *munkres.ParseError
+		if !errors.As(err, &parseError) {
+			t.Errorf("%q: want a *ParseError got %v", test.input, err)
+			continue
+		}
+		if parseError.Row != test.row {
+			t.Errorf("%q: want row %d got %d", test.input, test.row, parseError.Row)
+		}
+		if test.err != nil && !errors.Is(err, test.err) {
+			t.Errorf("%q: want err = %s got %v", test.input, test.err, err)
+		}
+	}
+}
+
+func TestNewFromReaderBinary(t *testing.T) {
+	encode := func(rows, cols uint64, costs ...float64) *bytes.Buffer {
+		var b bytes.Buffer
+		binary.Write(&b, binary.LittleEndian, []uint64{rows, cols})
+		binary.Write(&b, binary.LittleEndian, costs)
+		return &b
+	}
+	h, err := munkres.NewFromReader(encode(2, 3, 4, 1, 6, 2, 0, 5), munkres.FormatBinary)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := h.Execute(), []int{1, 0}; !reflect.DeepEqual(got, want) {
+		t.Errorf("want %v got %v", want, got)
+	}
+	_, err = munkres.NewFromReader(encode(2, 2, 1, 2, 3, math.NaN()), munkres.FormatBinary)
+	var parseError *munkres.ParseError
+	if !errors.As(err, &parseError) || parseError.Row != 1 || !errors.Is(err, munkres.ErrorNaNCost) {
+		t.Errorf("want a NaN cost in row 1 got %v", err)
+	}
+	_, err = munkres.NewFromReader(encode(2, 2, 1, 2, 3), munkres.FormatBinary)
+	if !errors.As(err, &parseError) || parseError.Row != 1 {
+		t.Errorf("want a truncated row 1 got %v", err)
+	}
+	for _, format := range []munkres.Format{munkres.FormatCSV, munkres.FormatBinary} {
+		r := io.Reader(strings.NewReader("1,5\n4,2\n"))
+		if format == munkres.FormatBinary {
+			r = encode(2, 2, 1, 5, 4, 2)
+		}
+		h, err := munkres.NewFromReader(r, format, munkres.WithMaximize())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, want := h.Execute(), []int{1, 0}; !reflect.DeepEqual(got, want) {
+			t.Errorf("%v: want %v got %v", format, want, got)
+		}
+	}
+	if _, err := munkres.NewFromReader(strings.NewReader(""), munkres.Format(-1)); err != munkres.ErrorInvalidOption {
+		t.Errorf("want err = %s got %v", munkres.ErrorInvalidOption, err)
+	}
+}
+
+// A header claiming more costs than the stream holds is an error, not an
+// allocation of the matrix it claims.
+func TestNewFromReaderBinaryHeader(t *testing.T) {
+	for _, size := range [][2]uint64{{1 << 32, 1}, {1, 1 << 40}, {1 << 30, 1 << 30}, {1 << 62, 1 << 62}} {
+		var b bytes.Buffer
+		binary.Write(&b, binary.LittleEndian, size[:])
+		binary.Write(&b, binary.LittleEndian, []float64{1, 2})
+		if _, err := munkres.NewFromReader(&b, munkres.FormatBinary); err == nil {
+			t.Errorf("%v: want an error", size)
+		}
+	}
+	var b bytes.Buffer
+	binary.Write(&b, binary.LittleEndian, []uint64{1 << 20, 1 << 20})
+	_, err := munkres.NewFromReader(&b, munkres.FormatBinary, munkres.WithMaxDimension(1000))
+	var limit *munkres.DimensionLimitError
+	if !errors.As(err, &limit) {
+		t.Errorf("want a *DimensionLimitError got %v", err)
+	}
+}
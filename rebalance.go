@@ -0,0 +1,164 @@
+package munkres
+
+import (
+	"context"
+	"sort"
+	"time"
+)
+
+// A Rebalancer keeps an assignment close to optimal as its costs drift in
+// a long-running system while limiting churn. Each rebalance solves the
+// current costs, but applies at most maxMoves reassignments towards the
+// optimal assignment, taking the independent changes which improve the
+// cost most for the reassignments they make, so that the assignment
+// converges over several cycles rather than being reshuffled at once.
+//
+// The changes are the chains and cycles in which the optimal assignment
+// differs from the current one: moving a worker to its optimal job
+// displaces the worker holding that job, and so on, so each is applied
+// whole or not at all. A change larger than maxMoves is never applied.
+type Rebalancer struct {
+	current   Assignment
+	maxMoves  int
+	threshold float64
+	opts      []Option
+}
+
+// NewRebalancer creates a rebalancer of the current assignment, which
+// applies at most maxMoves reassignments per rebalance and, for Update,
+// only rebalances when the current assignment costs more than threshold
+// above an optimal one. The options are those of NewHungarianAlgorithm.
+//
+// return ErrorInvalidOption if maxMoves or threshold is negative.
+func NewRebalancer(current []int, maxMoves int, threshold float64, opts ...Option) (*Rebalancer, error) {
+	if maxMoves < 0 || threshold < 0 {
+		return nil, ErrorInvalidOption
+	}
+	return &Rebalancer{
+		current:   append(Assignment(nil), current...),
+		maxMoves:  maxMoves,
+		threshold: threshold,
+		opts:      opts,
+	}, nil
+}
+
+// Assignment returns the current assignment.
+func (r *Rebalancer) Assignment() Assignment {
+	return append(Assignment(nil), r.current...)
+}
+
+// Update rebalances the assignment for the cost matrix, as Rebalance
+// does, if its drift, the amount by which it costs more than an optimal
+// assignment, exceeds the threshold, and otherwise leaves it unchanged.
+//
+// return the new assignment and the number of workers reassigned, or the
+// errors of Rebalance.
+func (r *Rebalancer) Update(costMatrix [][]float64) (Assignment, int, error) {
+	return r.rebalance(costMatrix, false)
+}
+
+// Rebalance the assignment for the cost matrix, applying the changes
+// towards an optimal assignment which improve the cost most per worker
+// reassigned, while they fit within maxMoves reassignments.
+//
+// return the new assignment and the number of workers reassigned, or the
+// errors of ComputeCost if the current assignment does not fit the
+// matrix, or of NewHungarianAlgorithm.
+func (r *Rebalancer) Rebalance(costMatrix [][]float64) (Assignment, int, error) {
+	return r.rebalance(costMatrix, true)
+}
+
+// Run rebalances the assignment every interval, with the cost matrix
+// returned by costs, until the context is done, calling apply with the
+// new assignment whenever a rebalance reassigns any worker.
+//
+// return the error of the context, or the first error of costs or of
+// Rebalance.
+func (r *Rebalancer) Run(ctx context.Context, interval time.Duration, costs func() ([][]float64, error), apply func(Assignment)) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+		c, err := costs()
+		if err != nil {
+			return err
+		}
+		a, moves, err := r.Rebalance(c)
+		if err != nil {
+			return err
+		}
+		if moves > 0 {
+			apply(a)
+		}
+	}
+}
+
+// Rebalance the assignment for the cost matrix if force is true or its
+// drift exceeds the threshold.
+func (r *Rebalancer) rebalance(costMatrix [][]float64, force bool) (Assignment, int, error) {
+	cost, err := ComputeCost(costMatrix, r.current)
+	if err != nil {
+		return nil, 0, err
+	}
+	h, err := NewHungarianAlgorithm(costMatrix, r.opts...)
+	if err != nil {
+		return nil, 0, err
+	}
+	optimal := Assignment(h.Execute())
+	if !force && cost-optimal.Cost(costMatrix) <= r.threshold {
+		return r.Assignment(), 0, nil
+	}
+	rows, cols := len(costMatrix), 0
+	if rows > 0 {
+		cols = len(costMatrix[0])
+	}
+	// Each change links the workers it moves to the jobs they leave and
+	// take, so the changes are the components of those links.
+	changes := components(rows, cols, func(link func(w, j int)) {
+		for w, j := range r.current {
+			if j == optimal[w] {
+				continue
+			}
+			if j != -1 {
+				link(w, j)
+			}
+			if optimal[w] != -1 {
+				link(w, optimal[w])
+			}
+		}
+	})
+	gain := make([]float64, len(changes))
+	for i, b := range changes {
+		for _, w := range b.Rows {
+			if j := r.current[w]; j != -1 {
+				gain[i] += costMatrix[w][j]
+			}
+			if j := optimal[w]; j != -1 {
+				gain[i] -= costMatrix[w][j]
+			}
+		}
+	}
+	order := make([]int, len(changes))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		return gain[order[a]]/float64(len(changes[order[a]].Rows)) >
+			gain[order[b]]/float64(len(changes[order[b]].Rows))
+	})
+	moves := 0
+	for _, i := range order {
+		if gain[i] <= 0 || moves+len(changes[i].Rows) > r.maxMoves {
+			continue
+		}
+		for _, w := range changes[i].Rows {
+			r.current[w] = optimal[w]
+		}
+		moves += len(changes[i].Rows)
+	}
+	return r.Assignment(), moves, nil
+}
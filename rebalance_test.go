@@ -0,0 +1,61 @@
+package munkres_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/charles-haynes/munkres"
+)
+
+func TestRebalancer(t *testing.T) {
+	c := [][]float64{
+		{10, 1, 100, 100},
+		{1, 10, 100, 100},
+		{100, 100, 10, 5},
+		{100, 100, 5, 10},
+	}
+	r, err := munkres.NewRebalancer([]int{0, 1, 2, 3}, 2, 20)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The drift of 28 exceeds the threshold, and the larger gain fits.
+	a, moves, err := r.Update(c)
+	if err != nil || moves != 2 || !reflect.DeepEqual(a, munkres.Assignment{1, 0, 2, 3}) {
+		t.Fatalf("want [1 0 2 3] and 2 moves got %v, %d, %v", a, moves, err)
+	}
+	// The remaining drift of 10 is within the threshold.
+	if a, moves, err = r.Update(c); err != nil || moves != 0 || !reflect.DeepEqual(a, munkres.Assignment{1, 0, 2, 3}) {
+		t.Errorf("want [1 0 2 3] and no moves got %v, %d, %v", a, moves, err)
+	}
+	if a, moves, err = r.Rebalance(c); err != nil || moves != 2 || !reflect.DeepEqual(a, munkres.Assignment{1, 0, 3, 2}) {
+		t.Errorf("want [1 0 3 2] and 2 moves got %v, %d, %v", a, moves, err)
+	}
+	// A cycle of three reassignments never fits.
+	r, _ = munkres.NewRebalancer([]int{0, 1, 2}, 2, 0)
+	if a, moves, err = r.Rebalance([][]float64{{5, 1, 5}, {5, 5, 1}, {1, 5, 5}}); err != nil || moves != 0 || !reflect.DeepEqual(a, munkres.Assignment{0, 1, 2}) {
+		t.Errorf("want [0 1 2] and no moves got %v, %d, %v", a, moves, err)
+	}
+	if _, _, err = r.Rebalance(c); err != munkres.ErrorDimensionMismatch {
+		t.Errorf("want %v got %v", munkres.ErrorDimensionMismatch, err)
+	}
+	if _, err = munkres.NewRebalancer(nil, -1, 0); err != munkres.ErrorInvalidOption {
+		t.Errorf("want %v got %v", munkres.ErrorInvalidOption, err)
+	}
+}
+
+func TestRebalancerRun(t *testing.T) {
+	c := [][]float64{{10, 1}, {1, 10}}
+	r, _ := munkres.NewRebalancer([]int{0, 1}, 2, 0)
+	ctx, cancel := context.WithCancel(context.Background())
+	var applied munkres.Assignment
+	err := r.Run(ctx, time.Millisecond, func() ([][]float64, error) { return c, nil },
+		func(a munkres.Assignment) {
+			applied = a
+			cancel()
+		})
+	if err != context.Canceled || !reflect.DeepEqual(applied, munkres.Assignment{1, 0}) {
+		t.Errorf("want [1 0] and %v got %v and %v", context.Canceled, applied, err)
+	}
+}
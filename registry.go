@@ -0,0 +1,123 @@
+package munkres
+
+import (
+	"sort"
+	"sync"
+)
+
+// A Solver solves the assignment problem for a cost matrix, returning a
+// minimum cost assignment as Execute does.
+type Solver func(costMatrix [][]float64) ([]int, error)
+
+var (
+	backendsMu sync.RWMutex
+	backends   = map[string]Solver{}
+)
+
+// RegisterBackend makes a solver available by name, so that tools such as
+// the bench command of cmd/munkres can run and compare every backend. It
+// panics if the solver is nil or if the name is already registered.
+func RegisterBackend(name string, solve Solver) {
+	backendsMu.Lock()
+	defer backendsMu.Unlock()
+	if solve == nil {
+		panic("munkres: RegisterBackend solver is nil")
+	}
+	if _, dup := backends[name]; dup {
+		panic("munkres: RegisterBackend called twice for backend " + name)
+	}
+	backends[name] = solve
+}
+
+// Backends returns the names of the registered backends in sorted order.
+func Backends() []string {
+	backendsMu.RLock()
+	defer backendsMu.RUnlock()
+	names := make([]string, 0, len(backends))
+	for name := range backends {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// LookupBackend returns the solver registered by name, if any.
+func LookupBackend(name string) (Solver, bool) {
+	backendsMu.RLock()
+	defer backendsMu.RUnlock()
+	solve, ok := backends[name]
+	return solve, ok
+}
+
+// The backends of this package. The auction algorithm is exact for
+// integer costs, and otherwise within a negligible fraction of the range
+// of costs of the optimum.
+func init() {
+	RegisterBackend("hungarian", func(costMatrix [][]float64) ([]int, error) {
+		h, err := NewHungarianAlgorithm(costMatrix)
+		if err != nil {
+			return nil, err
+		}
+		return h.execute()
+	})
+	RegisterBackend("auction", func(costMatrix [][]float64) ([]int, error) {
+		return SolveAuction(costMatrix)
+	})
+	RegisterBackend("mixed", func(costMatrix [][]float64) ([]int, error) {
+		return SolveMixedPrecision(costMatrix)
+	})
+	RegisterBackend("rows", func(costMatrix [][]float64) ([]int, error) {
+		if err := checkCostMatrix(costMatrix, false); err != nil {
+			return nil, err
+		}
+		cols := 0
+		if len(costMatrix) > 0 {
+			cols = len(costMatrix[0])
+		}
+		return solveRows(len(costMatrix), cols, denseRows(costMatrix))
+	})
+	RegisterBackend("sparse", func(costMatrix [][]float64) ([]int, error) {
+		if err := checkCostMatrix(costMatrix, false); err != nil {
+			return nil, err
+		}
+		cols := 0
+		if len(costMatrix) > 0 {
+			cols = len(costMatrix[0])
+		}
+		edges := make([]Edge, 0, len(costMatrix)*cols)
+		for w, row := range costMatrix {
+			for j, c := range row {
+				edges = append(edges, Edge{w, j, c})
+			}
+		}
+		s, err := NewSparseAssignment(len(costMatrix), cols, edges)
+		if err != nil {
+			return nil, err
+		}
+		return s.Solve()
+	})
+	RegisterBackend("flow", func(costMatrix [][]float64) ([]int, error) {
+		if err := checkCostMatrix(costMatrix, false); err != nil {
+			return nil, err
+		}
+		cols := 0
+		if len(costMatrix) > 0 {
+			cols = len(costMatrix[0])
+		}
+		edges := make([]sparseEdge, 0, len(costMatrix)*cols)
+		for w, row := range costMatrix {
+			for j, c := range row {
+				edges = append(edges, sparseEdge{w, j, c})
+			}
+		}
+		a, _, _, err := solveSparse(len(costMatrix), cols, edges)
+		return a, err
+	})
+}
+
+// A rowSource over a cost matrix in memory.
+type denseRows [][]float64
+
+func (d denseRows) row(w int) ([]float64, error) {
+	return d[w], nil
+}
@@ -0,0 +1,43 @@
+package munkres_test
+
+import (
+	"math"
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/charles-haynes/munkres"
+)
+
+func TestBackends(t *testing.T) {
+	if got, want := munkres.Backends(), []string{"auction", "flow", "hungarian", "mixed", "rows", "sparse"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("want %v got %v", want, got)
+	}
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 20; i++ {
+		c := randomMatrix(rng, 1+rng.Intn(8), 1+rng.Intn(8))
+		want, _ := bruteForceCost(c, func(int, int) bool { return true })
+		for _, name := range munkres.Backends() {
+			solve, _ := munkres.LookupBackend(name)
+			a, err := solve(c)
+			if err != nil {
+				t.Fatalf("%s: %v", name, err)
+			}
+			if got, err := munkres.ComputeCost(c, a); err != nil || math.Abs(got-want) > 1e-9 {
+				t.Errorf("%s %v: want cost = %f got %f (%v)", name, c, want, got, err)
+			}
+		}
+	}
+	if _, ok := munkres.LookupBackend("none"); ok {
+		t.Errorf("want no backend named none")
+	}
+}
+
+func TestRegisterBackendTwice(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("want a panic registering hungarian twice")
+		}
+	}()
+	munkres.RegisterBackend("hungarian", func([][]float64) ([]int, error) { return nil, nil })
+}
@@ -0,0 +1,32 @@
+package munkres
+
+import "math"
+
+// Regrets returns, for each worker, the cost of its job in the optimal
+// assignment less the cost of its best alternative, the least cost of
+// any other job, to surface unhappy assignments. A positive regret is
+// how much more the worker's job costs than a job it was denied for the
+// sake of the others; a negative one is the margin by which its job is
+// its best. A worker with no alternative has a regret of -Inf, and an
+// unassigned worker has none. Regrets must be called after Execute, and
+// returns nil WithWorkerGroups, for which it is not available.
+func (h *HungarianAlgorithm) Regrets() []float64 {
+	if h.opts.workerGroups != nil || h.rows == 0 {
+		return nil
+	}
+	regrets := make([]float64, h.rows)
+	for w := range regrets {
+		job := h.matchJobByWorker[w]
+		if job == -1 || job >= h.cols {
+			continue
+		}
+		alternative := math.Inf(1)
+		for j := 0; j < h.cols; j++ {
+			if j != job {
+				alternative = math.Min(alternative, h.originalCost(w, j))
+			}
+		}
+		regrets[w] = h.originalCost(w, job) - alternative
+	}
+	return regrets
+}
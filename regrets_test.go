@@ -0,0 +1,33 @@
+package munkres_test
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/charles-haynes/munkres"
+)
+
+func TestRegrets(t *testing.T) {
+	c := [][]float64{
+		{1, 2, 9},
+		{1, 5, 9},
+		{9, 9, 9},
+		{5, 5, 4},
+	}
+	h, _ := munkres.NewHungarianAlgorithm(c)
+	res := h.Execute()
+	// Worker 1 gets job 0 and worker 0 gives it up for job 1.
+	if want := []int{1, 0, -1, 2}; !reflect.DeepEqual(res, want) {
+		t.Fatalf("want %v got %v", want, res)
+	}
+	if got, want := h.Regrets(), []float64{1, -4, 0, -1}; !reflect.DeepEqual(got, want) {
+		t.Errorf("want %v got %v", want, got)
+	}
+
+	h, _ = munkres.NewHungarianAlgorithm([][]float64{{3}})
+	h.Execute()
+	if got := h.Regrets(); !math.IsInf(got[0], -1) {
+		t.Errorf("want -Inf got %v", got)
+	}
+}
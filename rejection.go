@@ -0,0 +1,51 @@
+package munkres
+
+import "math"
+
+// WithRejection consults reject before each pair of a worker and a job is
+// used in the matching, and forbids the pair if reject returns true, for
+// business rules which are costly to evaluate for every pair up front or
+// which are only known when a pair is considered. Forbidden (+Inf) costs
+// are then also allowed in the cost matrix.
+//
+// Only the pairs which the algorithm would otherwise use are consulted,
+// and a pair may be consulted more than once, so reject must give the same
+// answer for a pair each time it is called. The assignment is then
+// optimal among those using no rejected pair, just as if every rejected
+// pair had been forbidden in the cost matrix, and Solve returns
+// ErrorInfeasible if the rejections leave no assignment of as many
+// workers as Execute would assign. The faster solvers for integer costs
+// and for far from square matrices are not used, and WithWorkerGroups is
+// not supported.
+func WithRejection(reject func(w, j int) bool) Option {
+	return func(o *options) {
+		o.reject = reject
+		o.forbidden = true
+	}
+}
+
+// Report whether the pair of worker w and job j is rejected by the option
+// set by WithRejection, forbidding it if so.
+func (h *HungarianAlgorithm) rejected(w, j int) bool {
+	if h.opts.reject == nil || w >= h.rows || j >= h.cols || !h.opts.reject(w, j) {
+		return false
+	}
+	h.costs[w*h.dim+j] = math.Inf(1)
+	return true
+}
+
+// Recompute the minimum slack of uncommitted job j over the committed
+// workers, after the cost of one of its pairs has been raised.
+func (h *HungarianAlgorithm) recomputeSlack(j int) {
+	h.minSlackValueByJob[j] = math.Inf(1)
+	for w, committed := range h.committedWorkers {
+		if !committed {
+			continue
+		}
+		slack := h.costs[w*h.dim+j] - h.labelByWorker[w] - h.labelByJob[j]
+		if slack < h.minSlackValueByJob[j] {
+			h.minSlackValueByJob[j] = slack
+			h.minSlackWorkerByJob[j] = w
+		}
+	}
+}
@@ -0,0 +1,71 @@
+package munkres_test
+
+import (
+	"errors"
+	"math/rand"
+	"testing"
+
+	"github.com/charles-haynes/munkres"
+)
+
+func TestWithRejection(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 200; i++ {
+		c := randomMatrix(rng, 1+rng.Intn(6), 1+rng.Intn(6))
+		vetoed := map[[2]int]bool{}
+		for w := range c {
+			for j := range c[w] {
+				vetoed[[2]int{w, j}] = rng.Intn(4) == 0
+			}
+		}
+		h, err := munkres.NewHungarianAlgorithm(c, munkres.WithRejection(
+			func(w, j int) bool { return vetoed[[2]int{w, j}] }))
+		if err != nil {
+			t.Fatal(err)
+		}
+		a, err := h.Solve()
+		want, feasible := bruteForceCost(c, func(w, j int) bool {
+			return !vetoed[[2]int{w, j}]
+		})
+		if !feasible {
+			if !errors.Is(err, munkres.ErrorInfeasible) {
+				t.Errorf("%v %v: want %v got %v", c, vetoed, munkres.ErrorInfeasible, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%v %v: %v", c, vetoed, err)
+		}
+		for w, j := range a {
+			if j != -1 && vetoed[[2]int{w, j}] {
+				t.Errorf("%v %v: %v uses rejected pair (%d, %d)", c, vetoed, a, w, j)
+			}
+		}
+		if got, _ := munkres.ComputeCost(c, a); got != want {
+			t.Errorf("%v %v: want %f got %f", c, vetoed, want, got)
+		}
+	}
+}
+
+func TestWithRejectionConsultsUsedPairs(t *testing.T) {
+	c := [][]float64{{1, 2, 3}, {2, 4, 6}, {3, 6, 9}}
+	consulted := 0
+	h, _ := munkres.NewHungarianAlgorithm(c, munkres.WithRejection(
+		func(w, j int) bool {
+			consulted++
+			return false
+		}))
+	h.Execute()
+	if consulted == 0 || consulted >= 9 {
+		t.Errorf("want some but not all of 9 pairs consulted got %d", consulted)
+	}
+}
+
+func TestWithRejectionGroups(t *testing.T) {
+	_, err := munkres.NewHungarianAlgorithm([][]float64{{1}},
+		munkres.WithRejection(func(w, j int) bool { return false }),
+		munkres.WithWorkerGroups([]int{0}))
+	if err != munkres.ErrorInvalidOption {
+		t.Errorf("want %v got %v", munkres.ErrorInvalidOption, err)
+	}
+}
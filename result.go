@@ -0,0 +1,65 @@
+package munkres
+
+// A Result is the outcome of ExecuteResult: the assignment in both
+// directions, with its cost and the duals which prove it optimal.
+type Result struct {
+	// JobByWorker gives the job of each worker, as Execute does, and
+	// WorkerByJob the worker of each job, with -1 for those unassigned.
+	JobByWorker Assignment
+	WorkerByJob []int
+	// UnassignedWorkers and UnassignedJobs list those left unassigned,
+	// in increasing order.
+	UnassignedWorkers, UnassignedJobs []int
+	// Cost is the total cost of the assignment, as TotalOriginalCost, or
+	// its total profit WithMaximize.
+	Cost float64
+	// WorkerDuals and JobDuals are the prices of the workers and jobs of
+	// the cost matrix, as Duals, for sensitivity analysis and warm
+	// starts.
+	WorkerDuals, JobDuals []float64
+}
+
+// ExecuteResult executes the algorithm as Solve does, returning the
+// assignment with what callers otherwise compute from it, so that they
+// need not walk the cost matrix again.
+func (h *HungarianAlgorithm) ExecuteResult() (Result, error) {
+	a, err := h.execute()
+	if err != nil {
+		return Result{}, err
+	}
+	cost := h.TotalOriginalCost(a)
+	if h.opts.maximize {
+		cost = -cost
+	}
+	r := newResult(append(Assignment{}, a...), h.cols, cost)
+	workerDuals, jobDuals := h.Duals()
+	if h.dim > 0 {
+		r.WorkerDuals, r.JobDuals = workerDuals[:h.rows], jobDuals[:h.cols]
+	}
+	return r, nil
+}
+
+// return the Result of the assignment a of a problem with cols jobs,
+// costing cost, without duals.
+func newResult(a Assignment, cols int, cost float64) Result {
+	r := Result{
+		JobByWorker:       a,
+		WorkerByJob:       unassigned(cols),
+		UnassignedWorkers: []int{},
+		UnassignedJobs:    []int{},
+		Cost:              cost,
+	}
+	for w, j := range a {
+		if j == -1 {
+			r.UnassignedWorkers = append(r.UnassignedWorkers, w)
+			continue
+		}
+		r.WorkerByJob[j] = w
+	}
+	for j, w := range r.WorkerByJob {
+		if w == -1 {
+			r.UnassignedJobs = append(r.UnassignedJobs, j)
+		}
+	}
+	return r
+}
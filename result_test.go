@@ -0,0 +1,54 @@
+package munkres_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/charles-haynes/munkres"
+)
+
+func TestExecuteResult(t *testing.T) {
+	c := [][]float64{{4, 1, 3, 2}, {2, 0, 5, 3}, {3, 2, 2, 4}}
+	h, _ := munkres.NewHungarianAlgorithm(c)
+	r, err := h.ExecuteResult()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := munkres.Result{
+		JobByWorker:       munkres.Assignment{3, 1, 2},
+		WorkerByJob:       []int{-1, 1, 2, 0},
+		UnassignedWorkers: []int{},
+		UnassignedJobs:    []int{0},
+		Cost:              4,
+		WorkerDuals:       r.WorkerDuals,
+		JobDuals:          r.JobDuals,
+	}
+	if !reflect.DeepEqual(r, want) {
+		t.Errorf("want %+v got %+v", want, r)
+	}
+	if len(r.WorkerDuals) != 3 || len(r.JobDuals) != 4 {
+		t.Fatalf("want 3 worker and 4 job duals got %v %v", r.WorkerDuals, r.JobDuals)
+	}
+	total := 0.0
+	for w, j := range r.JobByWorker {
+		if d := c[w][j] - r.WorkerDuals[w] - r.JobDuals[j]; d != 0 {
+			t.Errorf("want assigned pair (%d, %d) tight got reduced cost %v", w, j, d)
+		}
+		total += r.WorkerDuals[w]
+	}
+	for _, d := range r.JobDuals {
+		total += d
+	}
+	if total != r.Cost {
+		t.Errorf("want duals summing to %v got %v", r.Cost, total)
+	}
+
+	h, _ = munkres.NewHungarianAlgorithm([][]float64{{4, 1}, {2, 0}, {3, 2}}, munkres.WithMaximize())
+	if r, _ := h.ExecuteResult(); r.Cost != 6 || !reflect.DeepEqual(r.UnassignedWorkers, []int{1}) {
+		t.Errorf("want profit 6 leaving worker 1 unassigned got %+v", r)
+	}
+	h, _ = munkres.NewHungarianAlgorithm([][]float64{{munkres.Forbidden}}, munkres.WithForbidden())
+	if _, err := h.ExecuteResult(); err != munkres.ErrorInfeasible {
+		t.Errorf("want ErrorInfeasible got %v", err)
+	}
+}
@@ -0,0 +1,128 @@
+package munkres
+
+import "math"
+
+// Problems with at most this many workers and jobs, whichever is greater,
+// are solved exactly by SolveMinMaxRegret.
+const exactRegretLimit = 7
+
+// SolveMinMaxRegret solves the assignment problem when the costs are
+// uncertain, known only to lie in the intervals [lo[w][j], hi[w][j]], by
+// minimizing the maximum regret: the greatest amount, over every possible
+// realization of the costs, by which the assignment could cost more than
+// the optimum for that realization. The worst realization for an
+// assignment sets its own pairs to their upper bounds and every other pair
+// to its lower bound, so the regret of each assignment takes one solve to
+// find.
+//
+// The problem is NP-hard. Problems with at most 7 workers and jobs are
+// solved exactly by enumerating the assignments; larger ones take the best
+// of the assignments optimal for the lower bounds, the upper bounds and the
+// midpoints of the intervals, the last of which has at most twice the
+// optimal regret.
+//
+// lo and hi must have the same dimensions and are subject to the same
+// restrictions as for NewHungarianAlgorithm, and no lower bound may exceed
+// its upper bound.
+//
+// return the assignment, as for Execute, and its maximum regret.
+func SolveMinMaxRegret(lo, hi [][]float64) (Assignment, float64, error) {
+	if err := checkCostMatrix(lo, false); err != nil {
+		return nil, 0, err
+	}
+	if err := checkCostMatrix(hi, false); err != nil {
+		return nil, 0, err
+	}
+	if len(lo) != len(hi) {
+		return nil, 0, ErrorDimensionMismatch
+	}
+	rows, cols := len(lo), 0
+	if rows > 0 {
+		cols = len(lo[0])
+	}
+	mid := make([][]float64, rows)
+	for w := range lo {
+		if len(hi[w]) != cols {
+			return nil, 0, ErrorDimensionMismatch
+		}
+		mid[w] = make([]float64, cols)
+		for j := range lo[w] {
+			if lo[w][j] > hi[w][j] {
+				return nil, 0, ErrorInvalidInterval
+			}
+			mid[w][j] = (lo[w][j] + hi[w][j]) / 2
+		}
+	}
+
+	var candidates []Assignment
+	if rows <= exactRegretLimit && cols <= exactRegretLimit {
+		candidates = allAssignments(rows, cols)
+	} else {
+		for _, c := range [][][]float64{mid, lo, hi} {
+			h, err := NewHungarianAlgorithm(c)
+			if err != nil {
+				return nil, 0, err
+			}
+			candidates = append(candidates, h.Execute())
+		}
+	}
+	var best Assignment
+	bestRegret := math.Inf(1)
+	for _, a := range candidates {
+		if regret, err := maxRegret(lo, hi, a); err != nil {
+			return nil, 0, err
+		} else if regret < bestRegret {
+			best, bestRegret = a, regret
+		}
+	}
+	return best, bestRegret, nil
+}
+
+// return the maximum regret of assignment a, as for SolveMinMaxRegret.
+func maxRegret(lo, hi [][]float64, a Assignment) (float64, error) {
+	worst := make([][]float64, len(lo))
+	for w := range lo {
+		worst[w] = append([]float64(nil), lo[w]...)
+		if j := a[w]; j != -1 {
+			worst[w][j] = hi[w][j]
+		}
+	}
+	h, err := NewHungarianAlgorithm(worst)
+	if err != nil {
+		return 0, err
+	}
+	return a.Cost(hi) - Assignment(h.Execute()).Cost(worst), nil
+}
+
+// return every assignment of rows workers to cols jobs in which as many
+// workers are assigned as there are workers or jobs, whichever is fewer.
+func allAssignments(rows, cols int) []Assignment {
+	var all []Assignment
+	a := Assignment(unassigned(rows))
+	used := make([]bool, cols)
+	var visit func(w, free int)
+	visit = func(w, free int) {
+		// free is the number of workers which may yet be left
+		// unassigned.
+		if w == rows {
+			all = append(all, append(Assignment(nil), a...))
+			return
+		}
+		for j := range used {
+			if !used[j] {
+				used[j], a[w] = true, j
+				visit(w+1, free)
+				used[j], a[w] = false, -1
+			}
+		}
+		if free > 0 {
+			visit(w+1, free-1)
+		}
+	}
+	free := 0
+	if rows > cols {
+		free = rows - cols
+	}
+	visit(0, free)
+	return all
+}
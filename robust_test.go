@@ -0,0 +1,103 @@
+package munkres_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/charles-haynes/munkres"
+)
+
+// return random intervals of costs, as lower and upper bounds.
+func randomIntervals(rng *rand.Rand, rows, cols int) ([][]float64, [][]float64) {
+	lo, width := randomMatrix(rng, rows, cols), randomMatrix(rng, rows, cols)
+	hi := make([][]float64, rows)
+	for w := range lo {
+		hi[w] = make([]float64, cols)
+		for j := range lo[w] {
+			hi[w][j] = lo[w][j] + width[w][j]
+		}
+	}
+	return lo, hi
+}
+
+// return the maximum regret of a by brute force on its worst realization.
+func bruteRegret(lo, hi [][]float64, a munkres.Assignment) float64 {
+	worst := make([][]float64, len(lo))
+	for w := range lo {
+		worst[w] = append([]float64(nil), lo[w]...)
+		if j := a[w]; j != -1 {
+			worst[w][j] = hi[w][j]
+		}
+	}
+	optimum, _ := bruteForceCost(worst, func(w, j int) bool { return true })
+	return a.Cost(hi) - optimum
+}
+
+func TestSolveMinMaxRegret(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 30; i++ {
+		n := 1 + rng.Intn(4)
+		lo, hi := randomIntervals(rng, n, n)
+		a, regret, err := munkres.SolveMinMaxRegret(lo, hi)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := bruteRegret(lo, hi, a); got != regret {
+			t.Errorf("want regret = %f got %f", got, regret)
+		}
+		// No permutation has a lesser regret.
+		perm := make(munkres.Assignment, n)
+		for k := range perm {
+			perm[k] = k
+		}
+		var visit func(k int)
+		visit = func(k int) {
+			if k == n {
+				if r := bruteRegret(lo, hi, perm); r < regret {
+					t.Errorf("%v has regret %f below %f", perm, r, regret)
+				}
+				return
+			}
+			for l := k; l < n; l++ {
+				perm[k], perm[l] = perm[l], perm[k]
+				visit(k + 1)
+				perm[k], perm[l] = perm[l], perm[k]
+			}
+		}
+		visit(0)
+	}
+}
+
+func TestSolveMinMaxRegretHeuristic(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	lo, hi := randomIntervals(rng, 8, 8)
+	a, regret, err := munkres.SolveMinMaxRegret(lo, hi)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := bruteRegret(lo, hi, a); got != regret {
+		t.Errorf("want regret = %f got %f", got, regret)
+	}
+}
+
+func TestSolveMinMaxRegretRectangular(t *testing.T) {
+	lo := [][]float64{{1}, {2}, {3}}
+	hi := [][]float64{{5}, {2}, {3}}
+	// Worker 1 is certain to cost less than worker 2.
+	a, regret, err := munkres.SolveMinMaxRegret(lo, hi)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a[1] != 0 || regret != 1 {
+		t.Errorf("want worker 1 assigned with regret 1 got %v with %f", a, regret)
+	}
+}
+
+func TestSolveMinMaxRegretErrors(t *testing.T) {
+	if _, _, err := munkres.SolveMinMaxRegret([][]float64{{2}}, [][]float64{{1}}); err != munkres.ErrorInvalidInterval {
+		t.Errorf("want err = %s got %v", munkres.ErrorInvalidInterval, err)
+	}
+	if _, _, err := munkres.SolveMinMaxRegret([][]float64{{1}}, [][]float64{{1, 2}}); err != munkres.ErrorDimensionMismatch {
+		t.Errorf("want err = %s got %v", munkres.ErrorDimensionMismatch, err)
+	}
+}
@@ -0,0 +1,122 @@
+package munkres
+
+// A RollingHorizon maintains a multi-period schedule over a sliding window
+// of time slots. The schedule is re-solved with SolveMultiPeriod whenever
+// the costs change, and the imminent slot is committed once it is due, so
+// that its jobs are never scheduled again.
+//
+// Workers and jobs are identified by their indices in the cost matrices,
+// which must be stable across updates. To limit churn, re-solving adds a
+// switching penalty to the cost of giving a worker a different job in a
+// slot than the current schedule gives it.
+type RollingHorizon struct {
+	switchPenalty float64
+	slot          int
+	done          map[int]bool
+	schedule      [][]int
+}
+
+// NewRollingHorizon creates a rolling horizon whose first slot is slot 0.
+// switchPenalty is the penalty for each change to a worker's job in a slot
+// that is already scheduled.
+func NewRollingHorizon(switchPenalty float64) *RollingHorizon {
+	return &RollingHorizon{
+		switchPenalty: switchPenalty,
+		done:          map[int]bool{},
+	}
+}
+
+// Update re-solves the schedule for the horizon. costs[t][w][j] holds the
+// cost of worker w doing job j in the t'th slot from the imminent one, as
+// for SolveMultiPeriod, so every slot must have the same numbers of
+// workers and jobs. Jobs done in committed slots are not scheduled.
+//
+// return the new schedule, where schedule[t][w] is the job of worker w in
+// the t'th slot from the imminent one, or -1 if it is idle.
+func (r *RollingHorizon) Update(costs [][][]float64) ([][]int, error) {
+	// Every slot has the workers of the first, as for SolveMultiPeriod,
+	// so that their jobs may be counted from its first worker.
+	for t := range costs {
+		if len(costs[t]) != len(costs[0]) {
+			return nil, ErrorDimensionMismatch
+		}
+	}
+	var jobs []int
+	if len(costs) > 0 && len(costs[0]) > 0 {
+		for j := range costs[0][0] {
+			if !r.done[j] {
+				jobs = append(jobs, j)
+			}
+		}
+	}
+	penalized := make([][][]float64, len(costs))
+	for t := range costs {
+		penalized[t] = make([][]float64, len(costs[t]))
+		for w := range costs[t] {
+			if len(costs[t][w]) != len(costs[0][0]) {
+				return nil, ErrorIrregularCostMatrix
+			}
+			penalized[t][w] = make([]float64, len(jobs))
+			for k, j := range jobs {
+				penalized[t][w][k] = costs[t][w][j] +
+					r.penalty(t, w, j)
+			}
+		}
+	}
+	schedule, err := SolveMultiPeriod(penalized)
+	if err != nil {
+		return nil, err
+	}
+	for t := range schedule {
+		for w, k := range schedule[t] {
+			if k != -1 {
+				schedule[t][w] = jobs[k]
+			}
+		}
+	}
+	r.schedule = schedule
+	return schedule, nil
+}
+
+// return the switching penalty for worker w doing job j in the t'th slot
+// from the imminent one.
+func (r *RollingHorizon) penalty(t, w, j int) float64 {
+	if t >= len(r.schedule) || w >= len(r.schedule[t]) {
+		return 0
+	}
+	if planned := r.schedule[t][w]; planned != -1 && planned != j {
+		return r.switchPenalty
+	}
+	return 0
+}
+
+// Commit the imminent slot of the current schedule and advance the horizon
+// by one slot.
+//
+// return the committed assignment of workers to jobs, or nil if nothing is
+// scheduled.
+func (r *RollingHorizon) Commit() []int {
+	r.slot++
+	if len(r.schedule) == 0 {
+		return nil
+	}
+	committed := r.schedule[0]
+	for _, j := range committed {
+		if j != -1 {
+			r.done[j] = true
+		}
+	}
+	r.schedule = r.schedule[1:]
+	return committed
+}
+
+// Slot returns the index of the imminent slot, which is the number of
+// slots committed so far.
+func (r *RollingHorizon) Slot() int {
+	return r.slot
+}
+
+// Done returns true if job j was done in a committed slot.
+func (r *RollingHorizon) Done(j int) bool {
+	return r.done[j]
+}
@@ -0,0 +1,82 @@
+package munkres_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/charles-haynes/munkres"
+)
+
+func TestRollingHorizon(t *testing.T) {
+	r := munkres.NewRollingHorizon(2)
+	schedule, err := r.Update([][][]float64{
+		{{1, 5, 5}, {5, 1, 5}},
+		{{5, 5, 1}, {5, 5, 5}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := [][]int{{0, 1}, {2, -1}}
+	if !reflect.DeepEqual(schedule, want) {
+		t.Fatalf("want schedule = %v got %v", want, schedule)
+	}
+
+	// A small improvement does not outweigh the switching penalty...
+	schedule, err = r.Update([][][]float64{
+		{{2, 5, 5}, {1, 5, 5}},
+		{{5, 5, 1}, {5, 5, 5}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(schedule, want) {
+		t.Fatalf("want schedule = %v got %v", want, schedule)
+	}
+
+	// ...but a large one does.
+	schedule, err = r.Update([][][]float64{
+		{{9, 5, 5}, {1, 5, 5}},
+		{{5, 5, 1}, {9, 9, 9}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want = [][]int{{1, 0}, {2, -1}}
+	if !reflect.DeepEqual(schedule, want) {
+		t.Fatalf("want schedule = %v got %v", want, schedule)
+	}
+
+	if committed := r.Commit(); !reflect.DeepEqual(committed, []int{1, 0}) {
+		t.Fatalf("want committed = %v got %v", []int{1, 0}, committed)
+	}
+	if r.Slot() != 1 || !r.Done(0) || !r.Done(1) || r.Done(2) {
+		t.Fatalf("wrong state after commit")
+	}
+
+	// Committed jobs are never scheduled again.
+	schedule, err = r.Update([][][]float64{
+		{{0, 0, 1}, {0, 0, 3}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want = [][]int{{2, -1}}
+	if !reflect.DeepEqual(schedule, want) {
+		t.Fatalf("want schedule = %v got %v", want, schedule)
+	}
+}
+
+// Slots with different numbers of workers are refused before their jobs
+// are counted.
+func TestRollingHorizonEmptySlot(t *testing.T) {
+	r := munkres.NewRollingHorizon(1)
+	if _, err := r.Update([][][]float64{{}, {{3, 1}}}); err != munkres.ErrorDimensionMismatch {
+		t.Errorf("want %v got %v", munkres.ErrorDimensionMismatch, err)
+	}
+	if _, err := r.Update([][][]float64{{{3, 1}}, {{1}}}); err != munkres.ErrorIrregularCostMatrix {
+		t.Errorf("want %v got %v", munkres.ErrorIrregularCostMatrix, err)
+	}
+	if schedule, err := r.Update([][][]float64{{}, {}}); err != nil || len(schedule) != 2 {
+		t.Errorf("want an empty schedule got %v, %v", schedule, err)
+	}
+}
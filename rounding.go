@@ -0,0 +1,138 @@
+package munkres
+
+import (
+	"math"
+	"math/rand"
+)
+
+// A Decomposition expresses a fractional assignment as a probability
+// distribution over integral assignments, as returned by
+// DecomposeFractional.
+type Decomposition struct {
+	// Assignments are the integral assignments, as for Execute.
+	Assignments []Assignment
+	// Weights are the probabilities of the assignments, which sum to 1.
+	Weights []float64
+}
+
+// Entries of a fractional assignment within this of zero are treated as
+// zero, absorbing rounding errors in the decomposition.
+const roundingTolerance = 1e-9
+
+// DecomposeFractional decomposes a fractional assignment, such as the
+// output of Sinkhorn scaling or of a linear program, into a distribution
+// over integral assignments under which each worker w is assigned job j
+// with probability fractional[w][j], by the Birkhoff-von Neumann theorem.
+// Sampling from it rounds the fractional assignment at random while
+// matching its marginals in expectation, for randomized scheduling and
+// A/B-style allocation.
+//
+// The entries of fractional must be finite and non-negative, and each row
+// and column must sum to at most 1; the shortfall of a row or column is
+// the probability that its worker or job is left unassigned. The
+// decomposition has at most (m+n)^2 assignments, where m and n are the
+// numbers of workers and jobs, each found in time O((m+n)^3).
+func DecomposeFractional(fractional [][]float64) (Decomposition, error) {
+	if err := checkCostMatrix(fractional, false); err != nil {
+		return Decomposition{}, err
+	}
+	rows, cols := len(fractional), 0
+	if rows > 0 {
+		cols = len(fractional[0])
+	}
+	// Pad the matrix to a doubly stochastic one of dimension rows+cols,
+	// with the shortfalls of the rows and columns on the diagonals of
+	// the off-diagonal blocks and the transpose in the opposite block.
+	dim := rows + cols
+	padded := make([][]float64, dim)
+	for i := range padded {
+		padded[i] = make([]float64, dim)
+	}
+	colSums := make([]float64, cols)
+	for w, row := range fractional {
+		rowSum := 0.0
+		for j, x := range row {
+			if x < 0 {
+				return Decomposition{}, ErrorNegativeQuantity
+			}
+			padded[w][j], padded[rows+j][cols+w] = x, x
+			rowSum += x
+			colSums[j] += x
+		}
+		if rowSum > 1+roundingTolerance {
+			return Decomposition{}, ErrorInfeasible
+		}
+		padded[w][cols+w] = math.Max(0, 1-rowSum)
+	}
+	for j, colSum := range colSums {
+		if colSum > 1+roundingTolerance {
+			return Decomposition{}, ErrorInfeasible
+		}
+		padded[rows+j][j] = math.Max(0, 1-colSum)
+	}
+
+	var d Decomposition
+	if dim == 0 {
+		d.Assignments, d.Weights = []Assignment{{}}, []float64{1}
+		return d, nil
+	}
+	support := make([][]float64, dim)
+	for i := range support {
+		support[i] = make([]float64, dim)
+	}
+	total := 0.0
+	for total < 1-roundingTolerance {
+		// Find a permutation within the support of what remains,
+		// preferring its larger entries.
+		for i := range padded {
+			for j, x := range padded[i] {
+				support[i][j] = math.Inf(1)
+				if x > roundingTolerance {
+					support[i][j] = -x
+				}
+			}
+		}
+		h, err := NewHungarianAlgorithm(support, allowForbidden())
+		if err != nil {
+			return Decomposition{}, err
+		}
+		perm, err := h.execute()
+		if err != nil {
+			// Only rounding errors remain.
+			break
+		}
+		weight := math.Inf(1)
+		for i, j := range perm {
+			weight = math.Min(weight, padded[i][j])
+		}
+		a := make(Assignment, rows)
+		for w := range a {
+			a[w] = perm[w]
+			if a[w] >= cols {
+				a[w] = -1
+			}
+		}
+		for i, j := range perm {
+			padded[i][j] -= weight
+		}
+		d.Assignments = append(d.Assignments, a)
+		d.Weights = append(d.Weights, weight)
+		total += weight
+	}
+	for k := range d.Weights {
+		d.Weights[k] /= total
+	}
+	return d, nil
+}
+
+// Sample returns one of the assignments of the decomposition, chosen at
+// random according to their weights.
+func (d Decomposition) Sample(rng *rand.Rand) Assignment {
+	u := rng.Float64()
+	for k, weight := range d.Weights {
+		if u -= weight; u < 0 {
+			return d.Assignments[k]
+		}
+	}
+	return d.Assignments[len(d.Assignments)-1]
+}
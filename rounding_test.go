@@ -0,0 +1,114 @@
+package munkres_test
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/charles-haynes/munkres"
+)
+
+// return the probability of each worker being assigned each job under the
+// decomposition.
+func marginals(d munkres.Decomposition, rows, cols int) [][]float64 {
+	m := make([][]float64, rows)
+	for w := range m {
+		m[w] = make([]float64, cols)
+	}
+	for k, a := range d.Assignments {
+		for w, j := range a {
+			if j != -1 {
+				m[w][j] += d.Weights[k]
+			}
+		}
+	}
+	return m
+}
+
+func TestDecomposeFractional(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 50; i++ {
+		rows, cols := rng.Intn(5), 1+rng.Intn(5)
+		// A random sub-stochastic matrix, by scaling down the rows
+		// and then the columns as needed.
+		f := randomMatrix(rng, rows, cols)
+		for w := range f {
+			sum := 0.0
+			for _, x := range f[w] {
+				sum += x
+			}
+			for j := range f[w] {
+				f[w][j] /= sum + 1
+			}
+		}
+		for j := 0; j < cols; j++ {
+			sum := 0.0
+			for w := range f {
+				sum += f[w][j]
+			}
+			for w := range f {
+				f[w][j] /= math.Max(1, sum)
+			}
+		}
+		d, err := munkres.DecomposeFractional(f)
+		if err != nil {
+			t.Fatal(err)
+		}
+		total := 0.0
+		for k, a := range d.Assignments {
+			total += d.Weights[k]
+			used := make([]bool, cols)
+			for _, j := range a {
+				if j != -1 && used[j] {
+					t.Fatalf("job %d assigned twice in %v", j, a)
+				} else if j != -1 {
+					used[j] = true
+				}
+			}
+		}
+		if math.Abs(total-1) > 1e-9 {
+			t.Errorf("want weights summing to 1 got %f", total)
+		}
+		m := marginals(d, rows, cols)
+		for w := range f {
+			for j := range f[w] {
+				if math.Abs(m[w][j]-f[w][j]) > 1e-9 {
+					t.Fatalf("want marginals %v got %v", f, m)
+				}
+			}
+		}
+	}
+}
+
+func TestDecompositionSample(t *testing.T) {
+	f := [][]float64{
+		{0.75, 0.25},
+		{0.25, 0.75},
+	}
+	d, err := munkres.DecomposeFractional(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rng := rand.New(rand.NewSource(1))
+	diagonal, samples := 0, 10000
+	for i := 0; i < samples; i++ {
+		if a := d.Sample(rng); a[0] == 0 {
+			diagonal++
+		}
+	}
+	if p := float64(diagonal) / float64(samples); math.Abs(p-0.75) > 0.02 {
+		t.Errorf("want the diagonal sampled with probability 0.75 got %f", p)
+	}
+}
+
+func TestDecomposeFractionalErrors(t *testing.T) {
+	if _, err := munkres.DecomposeFractional([][]float64{{0.5, 0.6}}); err != munkres.ErrorInfeasible {
+		t.Errorf("want err = %s got %v", munkres.ErrorInfeasible, err)
+	}
+	if _, err := munkres.DecomposeFractional([][]float64{{0.6}, {0.6}}); err != munkres.ErrorInfeasible {
+		t.Errorf("want err = %s got %v", munkres.ErrorInfeasible, err)
+	}
+	if _, err := munkres.DecomposeFractional([][]float64{{-0.5}}); err != munkres.ErrorNegativeQuantity {
+		t.Errorf("want err = %s got %v", munkres.ErrorNegativeQuantity, err)
+	}
+}
@@ -0,0 +1,60 @@
+package munkres
+
+// SolveRounds repeatedly solves the assignment problem, removing the jobs
+// matched in each round before the next, as in K-seat allocation, where
+// each worker gets up to one seat in each of K rounds, or in repeated
+// auctions. Every worker takes part in every round, and each round finds
+// a minimum cost assignment of the workers to the jobs which remain, as
+// Execute would.
+//
+// return the assignment of each round, where rounds[r][w] is the job
+// given to worker w in round r, or -1 if it is not matched in that round.
+// Fewer rounds are returned if the jobs run out first.
+func SolveRounds(costMatrix [][]float64, rounds int) ([][]int, error) {
+	if rounds < 0 {
+		return nil, ErrorNegativeQuantity
+	}
+	if err := checkCostMatrix(costMatrix, false); err != nil {
+		return nil, err
+	}
+	rows, cols := len(costMatrix), 0
+	if rows > 0 {
+		cols = len(costMatrix[0])
+	}
+	// The original indices of the remaining jobs.
+	jobs := make([]int, cols)
+	for j := range jobs {
+		jobs[j] = j
+	}
+	var result [][]int
+	for r := 0; r < rounds && rows > 0 && len(jobs) > 0; r++ {
+		sub := make([][]float64, rows)
+		for w := range sub {
+			sub[w] = make([]float64, len(jobs))
+			for k, j := range jobs {
+				sub[w][k] = costMatrix[w][j]
+			}
+		}
+		h, err := NewHungarianAlgorithm(sub)
+		if err != nil {
+			return nil, err
+		}
+		round := unassigned(rows)
+		matchedJobs := make([]bool, len(jobs))
+		for w, k := range h.Execute() {
+			if k != -1 {
+				round[w] = jobs[k]
+				matchedJobs[k] = true
+			}
+		}
+		var remainingJobs []int
+		for k, j := range jobs {
+			if !matchedJobs[k] {
+				remainingJobs = append(remainingJobs, j)
+			}
+		}
+		jobs = remainingJobs
+		result = append(result, round)
+	}
+	return result, nil
+}
@@ -0,0 +1,34 @@
+package munkres_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/charles-haynes/munkres"
+)
+
+func TestSolveRounds(t *testing.T) {
+	c := [][]float64{
+		{1, 2, 3, 8, 9},
+		{2, 1, 4, 9, 7},
+	}
+	got, err := munkres.SolveRounds(c, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The jobs run out after the third round.
+	want := [][]int{
+		{0, 1},
+		{2, 4},
+		{3, -1},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("want %v got %v", want, got)
+	}
+	if got, err := munkres.SolveRounds(c, 0); got != nil || err != nil {
+		t.Errorf("want no rounds got %v (%v)", got, err)
+	}
+	if _, err := munkres.SolveRounds(c, -1); err != munkres.ErrorNegativeQuantity {
+		t.Errorf("want err = %s got %v", munkres.ErrorNegativeQuantity, err)
+	}
+}
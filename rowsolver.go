@@ -0,0 +1,147 @@
+package munkres
+
+import "math"
+
+// A source of the rows of a cost matrix which need not all be held in
+// memory at once.
+type rowSource interface {
+	// return row w of the cost matrix, which is only valid until the
+	// next call.
+	row(w int) ([]float64, error)
+}
+
+// Solve the assignment problem for a rows x cols cost matrix by the
+// shortest augmenting path form of the Hungarian algorithm, which reads
+// the cost matrix one row at a time and never modifies it. Each step of
+// a phase reads a single row, so the matrix is read O(n) times in total.
+//
+// return the assignment as for Execute.
+func solveRows(rows, cols int, src rowSource) ([]int, error) {
+	if rows == 0 {
+		return nil, nil
+	}
+	n := rows
+	if cols > n {
+		n = cols
+	}
+	_, _, jobByWorker, err := augmentRows(rows, n, math.Inf(1), src.row)
+	if err != nil {
+		return nil, err
+	}
+	for w, j := range jobByWorker {
+		if j >= cols {
+			jobByWorker[w] = -1
+		}
+	}
+	return jobByWorker, nil
+}
+
+// A type of the labels and slacks of the shortest augmenting path form of
+// the algorithm, in whose arithmetic it runs.
+type labelNumber interface {
+	~int64 | ~float32 | ~float64
+}
+
+// Match each of rows workers to one of n >= rows jobs at minimum total
+// cost by the shortest augmenting path form of the Hungarian algorithm,
+// in time O(rows^2 n), in the arithmetic of T, in which inf is greater
+// than any slack. row(w) returns the costs of worker w, which are 0 for
+// the jobs beyond the end of the row, and need only be valid until the
+// next call. Unlike the padded square form of the algorithm, no phases
+// are spent on the jobs which are left unassigned.
+//
+// return labels for the workers and jobs, such that no cost is less than
+// the sum of the labels of its worker and job and every matched cost
+// equals it, and such that the label of every unmatched job is zero; and
+// the job of each worker; and ErrorInfeasible if forbidden (+Inf) costs
+// leave some worker with no job, or the error of row. On an error the
+// labels are still feasible, and the workers matched so far have their
+// jobs, and the others -1.
+func augmentRows[C Number, T labelNumber](rows, n int, inf T, row func(w int) ([]C, error)) (labelByWorker, labelByJob []T, jobByWorker []int, err error) {
+	// Workers and jobs are numbered from 1, with job 0 standing for the
+	// worker being matched in the current phase.
+	labelW := make([]T, rows+1)
+	labelJ := make([]T, n+1)
+	workerByJob := make([]int, n+1)
+	parentJob := make([]int, n+1)
+	minSlack := make([]T, n+1)
+	committed := make([]bool, n+1)
+	for w := 1; w <= rows && err == nil; w++ {
+		err = augmentPhase(w, n, inf, row, labelW, labelJ, workerByJob, parentJob, minSlack, committed)
+	}
+	jobByWorker = unassigned(rows)
+	for j := 1; j <= n; j++ {
+		if w := workerByJob[j]; w != 0 {
+			jobByWorker[w-1] = j - 1
+		}
+	}
+	return labelW[1:], labelJ[1:], jobByWorker, err
+}
+
+// Match worker w, numbered from 1 as by augmentRows, in one phase of the
+// shortest augmenting path form of the algorithm, from labels which are
+// feasible and a matching whose pairs are tight. parentJob, minSlack and
+// committed are scratch space of n+1 entries.
+//
+// return ErrorInfeasible if forbidden (+Inf) costs leave no augmenting
+// path.
+func augmentPhase[C Number, T labelNumber](w, n int, inf T, row func(w int) ([]C, error), labelW, labelJ []T, workerByJob, parentJob []int, minSlack []T, committed []bool) error {
+	workerByJob[0] = w
+	j0 := 0
+	for j := range minSlack {
+		minSlack[j] = inf
+		committed[j] = false
+	}
+	for {
+		committed[j0] = true
+		w0 := workerByJob[j0]
+		costs, err := row(w0 - 1)
+		if err != nil {
+			return err
+		}
+		delta, j1 := inf, 0
+		for j := 1; j <= n; j++ {
+			if committed[j] {
+				continue
+			}
+			var cost T
+			if j <= len(costs) {
+				cost = T(costs[j-1])
+			}
+			if slack := cost - labelW[w0] - labelJ[j]; slack < minSlack[j] {
+				minSlack[j] = slack
+				parentJob[j] = j0
+			}
+			if minSlack[j] < delta {
+				delta, j1 = minSlack[j], j
+			}
+		}
+		if j1 == 0 {
+			return ErrorInfeasible
+		}
+		for j := 0; j <= n; j++ {
+			if committed[j] {
+				labelW[workerByJob[j]] += delta
+				labelJ[j] -= delta
+			} else {
+				minSlack[j] -= delta
+			}
+		}
+		j0 = j1
+		if workerByJob[j0] == 0 {
+			break
+		}
+	}
+	augmentPath(workerByJob, parentJob, j0, 0)
+	return nil
+}
+
+// Augment the matching along the path of parent jobs from the unmatched
+// job j0 back to the job root, which stands for the worker being matched.
+func augmentPath(workerByJob, parentJob []int, j0, root int) {
+	for j0 != root {
+		j1 := parentJob[j0]
+		workerByJob[j0] = workerByJob[j1]
+		j0 = j1
+	}
+}
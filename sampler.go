@@ -0,0 +1,86 @@
+package munkres
+
+import (
+	"math"
+	"math/rand"
+)
+
+// A Sampler draws assignments at random from the Gibbs distribution, in
+// which each assignment has probability proportional to exp(-cost/T) for
+// a temperature T, so that probabilistic data association pipelines can
+// draw plausible alternatives to the optimum rather than committing to it.
+// As T falls the distribution concentrates on the optimum, and as it rises
+// it approaches the uniform distribution.
+//
+// Sampling is by the Metropolis algorithm over the assignments of the
+// padded square matrix, proposing to swap the jobs of two workers at each
+// step. The chain starts at the optimal assignment.
+type Sampler struct {
+	costMatrix  [][]float64
+	rows, cols  int
+	temperature float64
+	rng         *rand.Rand
+	jobByWorker []int
+}
+
+// NewSampler creates a sampler for the cost matrix, which is subject to
+// the same restrictions as for NewHungarianAlgorithm, at the given
+// temperature, which must be positive, drawing random numbers from rng.
+func NewSampler(costMatrix [][]float64, temperature float64, rng *rand.Rand) (*Sampler, error) {
+	h, err := NewHungarianAlgorithm(costMatrix)
+	if err != nil {
+		return nil, err
+	}
+	if !(temperature > 0) || math.IsInf(temperature, 1) {
+		return nil, ErrorInvalidOption
+	}
+	h.Execute()
+	s := &Sampler{
+		costMatrix:  make([][]float64, h.dim),
+		rows:        h.rows,
+		cols:        h.cols,
+		temperature: temperature,
+		rng:         rng,
+		jobByWorker: append([]int(nil), h.matchJobByWorker...),
+	}
+	// Padding workers and jobs cost nothing, and since every assignment
+	// has the same number of padded permutations, the distribution over
+	// the assignments is unaffected.
+	for w := range s.costMatrix {
+		s.costMatrix[w] = make([]float64, h.dim)
+		if w < h.rows {
+			copy(s.costMatrix[w], costMatrix[w])
+		}
+	}
+	return s, nil
+}
+
+// Sample advances the chain by the given number of sweeps, each of which
+// proposes a swap for every worker of the padded matrix, and returns the
+// assignment reached, as for Execute. Successive samples are correlated;
+// more sweeps between them make them closer to independent.
+func (s *Sampler) Sample(sweeps int) Assignment {
+	dim := len(s.jobByWorker)
+	if dim > 1 {
+		for step := 0; step < sweeps*dim; step++ {
+			a, b := s.rng.Intn(dim), s.rng.Intn(dim-1)
+			if b >= a {
+				b++
+			}
+			ja, jb := s.jobByWorker[a], s.jobByWorker[b]
+			delta := s.costMatrix[a][jb] + s.costMatrix[b][ja] -
+				s.costMatrix[a][ja] - s.costMatrix[b][jb]
+			if delta <= 0 || s.rng.Float64() < math.Exp(-delta/s.temperature) {
+				s.jobByWorker[a], s.jobByWorker[b] = jb, ja
+			}
+		}
+	}
+	result := make(Assignment, s.rows)
+	for w := range result {
+		result[w] = s.jobByWorker[w]
+		if result[w] >= s.cols {
+			result[w] = -1
+		}
+	}
+	return result
+}
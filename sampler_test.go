@@ -0,0 +1,57 @@
+package munkres_test
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/charles-haynes/munkres"
+)
+
+func TestSampler(t *testing.T) {
+	c := [][]float64{
+		{0, 1, 2},
+		{1, 0, 1},
+		{2, 1, 0},
+	}
+	s, err := munkres.NewSampler(c, 1, rand.New(rand.NewSource(1)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	counts := map[string]int{}
+	samples := 20000
+	for i := 0; i < samples; i++ {
+		counts[fmt.Sprint(s.Sample(1))]++
+	}
+	// Compare with the exact Gibbs distribution over the permutations.
+	perms := [][]int{{0, 1, 2}, {0, 2, 1}, {1, 0, 2}, {1, 2, 0}, {2, 0, 1}, {2, 1, 0}}
+	z := 0.0
+	for _, p := range perms {
+		z += math.Exp(-munkres.Assignment(p).Cost(c))
+	}
+	for _, p := range perms {
+		want := math.Exp(-munkres.Assignment(p).Cost(c)) / z
+		got := float64(counts[fmt.Sprint(munkres.Assignment(p))]) / float64(samples)
+		if math.Abs(got-want) > 0.02 {
+			t.Errorf("want %v sampled with probability %f got %f", p, want, got)
+		}
+	}
+}
+
+func TestSamplerRectangular(t *testing.T) {
+	// At a low temperature the optimum dominates.
+	c := [][]float64{{0, 5, 5}, {5, 0, 5}}
+	s, err := munkres.NewSampler(c, 0.1, rand.New(rand.NewSource(1)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 10; i++ {
+		if a := s.Sample(10); a[0] != 0 || a[1] != 1 {
+			t.Errorf("want [0 1] got %v", a)
+		}
+	}
+	if _, err := munkres.NewSampler(c, 0, rand.New(rand.NewSource(1))); err != munkres.ErrorInvalidOption {
+		t.Errorf("want err = %s got %v", munkres.ErrorInvalidOption, err)
+	}
+}
@@ -0,0 +1,75 @@
+package munkres
+
+import "math"
+
+// A SanitizePolicy says how WithSanitize replaces the NaN and infinite
+// entries of a cost matrix.
+type SanitizePolicy struct {
+	// Forbid makes such entries forbid the assignment of their worker to
+	// their job, as +Inf entries do for NewBipartite and NewFromEdges.
+	Forbid bool
+	// Penalty is the finite cost which replaces such entries if Forbid
+	// is false, typically a cost large enough that the pairs are only
+	// assigned as a last resort.
+	Penalty float64
+}
+
+// WithSanitize makes the constructors replace NaN, +Inf and -Inf entries
+// of the cost matrix according to policy, rather than rejecting the
+// matrix with ErrorNaNCost or ErrorInfiniteCost, so that a service fed by
+// a feature pipeline which occasionally emits them degrades rather than
+// fails. The caller's matrix is not modified. A penalty which is not
+// finite is invalid.
+//
+// If forbidden entries leave no assignment of as many workers as there
+// are workers or jobs, whichever is fewer, Execute returns nil.
+func WithSanitize(policy SanitizePolicy) Option {
+	return func(o *options) {
+		o.sanitize = &policy
+		if policy.Forbid {
+			o.forbidden = true
+		}
+	}
+}
+
+// return whether the policy is valid.
+func (p *SanitizePolicy) valid() bool {
+	return p.Forbid || !math.IsInf(p.Penalty, 0) && !math.IsNaN(p.Penalty)
+}
+
+// return the replacement for c, which is c itself unless it is invalid.
+func (p *SanitizePolicy) replace(c float64) float64 {
+	if !math.IsInf(c, 0) && !math.IsNaN(c) {
+		return c
+	}
+	if p.Forbid {
+		return math.Inf(1)
+	}
+	return p.Penalty
+}
+
+// Replace the invalid entries of row in place.
+func (p *SanitizePolicy) sanitizeRow(row []float64) {
+	for j, c := range row {
+		row[j] = p.replace(c)
+	}
+}
+
+// return the cost matrix with its invalid entries replaced, copying only
+// the rows which have any.
+func (p *SanitizePolicy) sanitize(costMatrix [][]float64) [][]float64 {
+	sanitized, copied := costMatrix, false
+	for w, row := range costMatrix {
+		for _, c := range row {
+			if math.IsInf(c, 0) || math.IsNaN(c) {
+				if !copied {
+					sanitized, copied = append([][]float64(nil), costMatrix...), true
+				}
+				sanitized[w] = append([]float64(nil), row...)
+				p.sanitizeRow(sanitized[w])
+				break
+			}
+		}
+	}
+	return sanitized
+}
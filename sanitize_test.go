@@ -0,0 +1,75 @@
+package munkres_test
+
+import (
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/charles-haynes/munkres"
+)
+
+func TestWithSanitizePenalty(t *testing.T) {
+	c := [][]float64{{math.NaN(), 2}, {3, math.Inf(1)}}
+	h, err := munkres.NewHungarianAlgorithm(c, munkres.WithSanitize(munkres.SanitizePolicy{Penalty: 100}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want, got := []int{1, 0}, h.Execute(); !reflect.DeepEqual(want, got) {
+		t.Errorf("want %v got %v", want, got)
+	}
+	if !math.IsNaN(c[0][0]) || !math.IsInf(c[1][1], 1) {
+		t.Errorf("want the cost matrix unchanged got %v", c)
+	}
+	// The penalty is only a last resort, not forbidden.
+	c = [][]float64{{math.Inf(-1)}}
+	h, err = munkres.NewHungarianAlgorithm(c, munkres.WithSanitize(munkres.SanitizePolicy{Penalty: 100}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want, got := []int{0}, h.Execute(); !reflect.DeepEqual(want, got) {
+		t.Errorf("want %v got %v", want, got)
+	}
+}
+
+func TestWithSanitizeForbid(t *testing.T) {
+	forbid := munkres.WithSanitize(munkres.SanitizePolicy{Forbid: true})
+	c := [][]float64{{1, math.NaN()}, {2, 1}}
+	h, err := munkres.NewHungarianAlgorithm(c, forbid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want, got := []int{0, 1}, h.Execute(); !reflect.DeepEqual(want, got) {
+		t.Errorf("want %v got %v", want, got)
+	}
+	h, err = munkres.NewHungarianAlgorithm([][]float64{{math.NaN()}}, forbid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := h.Execute(); got != nil {
+		t.Errorf("want nil for an infeasible matrix got %v", got)
+	}
+}
+
+func TestWithSanitizeInvalid(t *testing.T) {
+	for _, penalty := range []float64{math.NaN(), math.Inf(1)} {
+		opt := munkres.WithSanitize(munkres.SanitizePolicy{Penalty: penalty})
+		if _, err := munkres.NewHungarianAlgorithm([][]float64{{math.NaN()}}, opt); err != munkres.ErrorInvalidOption {
+			t.Errorf("want err = %s for penalty %v got %v", munkres.ErrorInvalidOption, penalty, err)
+		}
+		if _, err := munkres.NewFromReader(strings.NewReader("NaN\n"), munkres.FormatCSV, opt); err != munkres.ErrorInvalidOption {
+			t.Errorf("want err = %s for penalty %v got %v", munkres.ErrorInvalidOption, penalty, err)
+		}
+	}
+}
+
+func TestWithSanitizeReader(t *testing.T) {
+	opt := munkres.WithSanitize(munkres.SanitizePolicy{Penalty: 100})
+	h, err := munkres.NewFromReader(strings.NewReader("NaN,2\n3,+Inf\n"), munkres.FormatCSV, opt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want, got := []int{1, 0}, h.Execute(); !reflect.DeepEqual(want, got) {
+		t.Errorf("want %v got %v", want, got)
+	}
+}
@@ -0,0 +1,53 @@
+package munkres
+
+// SolveScenarios finds the single assignment minimizing the expected cost
+// over a set of cost scenarios, such as demand forecasts or simulated
+// travel times, where scenarios[s] is the cost matrix of scenario s and
+// weights[s] its probability or other non-negative weight. Since the
+// expected cost of an assignment is its cost under the weighted sum of
+// the matrices, that sum is solved. The scenarios must all have the same
+// dimensions and are subject to the same restrictions as for
+// NewHungarianAlgorithm, whose options apply.
+//
+// return the assignment, as for Execute, and its cost in each scenario.
+func SolveScenarios(scenarios [][][]float64, weights []float64, opts ...Option) (Assignment, []float64, error) {
+	if len(weights) != len(scenarios) {
+		return nil, nil, ErrorDimensionMismatch
+	}
+	var expected [][]float64
+	for s, c := range scenarios {
+		if err := checkCostMatrix(c, false); err != nil {
+			return nil, nil, err
+		}
+		if weights[s] < 0 {
+			return nil, nil, ErrorNegativeQuantity
+		}
+		if s == 0 {
+			expected = make([][]float64, len(c))
+			for w := range c {
+				expected[w] = make([]float64, len(c[w]))
+			}
+		}
+		if len(c) != len(expected) {
+			return nil, nil, ErrorDimensionMismatch
+		}
+		for w := range c {
+			if len(c[w]) != len(expected[w]) {
+				return nil, nil, ErrorDimensionMismatch
+			}
+			for j, x := range c[w] {
+				expected[w][j] += weights[s] * x
+			}
+		}
+	}
+	h, err := NewHungarianAlgorithm(expected, opts...)
+	if err != nil {
+		return nil, nil, err
+	}
+	a := Assignment(h.Execute())
+	costs := make([]float64, len(scenarios))
+	for s, c := range scenarios {
+		costs[s] = a.Cost(c)
+	}
+	return a, costs, nil
+}
@@ -0,0 +1,48 @@
+package munkres_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/charles-haynes/munkres"
+)
+
+func TestSolveScenarios(t *testing.T) {
+	// Each scenario favours a different assignment, but the hedge on
+	// the middle column is best in expectation.
+	scenarios := [][][]float64{
+		{{0, 2, 9}, {9, 2, 0}},
+		{{9, 2, 0}, {0, 2, 9}},
+	}
+	a, costs, err := munkres.SolveScenarios(scenarios, []float64{0.5, 0.5})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (munkres.Assignment{1, 2}); !reflect.DeepEqual(a, want) {
+		t.Errorf("want %v got %v", want, a)
+	}
+	if want := []float64{2, 11}; !reflect.DeepEqual(costs, want) {
+		t.Errorf("want costs = %v got %v", want, costs)
+	}
+	// A certain scenario decides the assignment.
+	a, costs, err = munkres.SolveScenarios(scenarios, []float64{1, 0})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (munkres.Assignment{0, 2}); !reflect.DeepEqual(a, want) || costs[0] != 0 {
+		t.Errorf("want %v at cost 0 got %v at %v", want, a, costs)
+	}
+}
+
+func TestSolveScenariosErrors(t *testing.T) {
+	c := [][]float64{{1, 2}, {2, 1}}
+	if _, _, err := munkres.SolveScenarios([][][]float64{c}, nil); err != munkres.ErrorDimensionMismatch {
+		t.Errorf("want err = %s got %v", munkres.ErrorDimensionMismatch, err)
+	}
+	if _, _, err := munkres.SolveScenarios([][][]float64{c, {{1, 2}}}, []float64{1, 1}); err != munkres.ErrorDimensionMismatch {
+		t.Errorf("want err = %s got %v", munkres.ErrorDimensionMismatch, err)
+	}
+	if _, _, err := munkres.SolveScenarios([][][]float64{c}, []float64{-1}); err != munkres.ErrorNegativeQuantity {
+		t.Errorf("want err = %s got %v", munkres.ErrorNegativeQuantity, err)
+	}
+}
@@ -0,0 +1,149 @@
+/*
+Package scheduler adapts the scores of a cluster scheduler, such as those
+of the score plugins of Kubernetes, to package munkres, so that pods are
+bound to nodes by an optimal assignment rather than one pod at a time by
+the greedy choice of the highest scoring node.
+*/
+package scheduler
+
+import (
+	"errors"
+	"math"
+
+	"github.com/charles-haynes/munkres"
+)
+
+var
+// The scores, masks or capacities do not have one entry for each pod
+// or node
+ErrorDimensionMismatch,
+	// A capacity is negative
+	ErrorNegativeCapacity error
+
+// A Problem is a set of pods to be placed on a set of nodes.
+type Problem struct {
+	// Pods and Nodes are the names of the pods and of the nodes.
+	Pods, Nodes []string
+	// Scores[p][n] is the score of placing pod p on node n, where higher
+	// is better. Scores must be non-infinite numbers, or a
+	// *munkres.CostError is returned.
+	Scores [][]float64
+	// Feasible[p][n] is whether pod p passes the filters of node n, or
+	// nil if every pod passes every filter.
+	Feasible [][]bool
+	// Capacity[n] is the number of the pods which node n may take, or
+	// nil if each node may take one.
+	Capacity []int
+}
+
+// A Binding is the placement of a pod on a node.
+type Binding struct {
+	Pod, Node string
+}
+
+// Schedule places as many pods as the filters and capacities allow on
+// nodes, and among such placements maximises the total score. The scores
+// are inverted into the costs of an assignment problem in which each node
+// has as many slots as its capacity and a pod may only take the slots of
+// the nodes whose filters it passes. Each pod may instead be left
+// unscheduled at a cost greater than any placement could save, so that a
+// pod which fits nowhere does not fail the whole problem.
+//
+// return the bindings, in the order of the pods, and the pods left
+// unscheduled.
+func Schedule(p Problem) ([]Binding, []string, error) {
+	if err := p.check(); err != nil {
+		return nil, nil, err
+	}
+	feasible := func(pod, node int) bool {
+		return p.Feasible == nil || p.Feasible[pod][node]
+	}
+	top, bottom := math.Inf(-1), math.Inf(1)
+	for pod := range p.Pods {
+		for node := range p.Nodes {
+			if s := p.Scores[pod][node]; feasible(pod, node) {
+				top, bottom = math.Max(top, s), math.Min(bottom, s)
+			}
+		}
+	}
+	if math.IsInf(top, -1) {
+		// No pod passes any filter.
+		top, bottom = 0, 0
+	}
+	penalty := (top - bottom + 1) * float64(len(p.Pods)+1)
+	b := munkres.NewBipartite()
+	var nodeOf []int
+	for node := range p.Nodes {
+		capacity := 1
+		if p.Capacity != nil {
+			capacity = p.Capacity[node]
+		}
+		for i := 0; i < capacity; i++ {
+			b.AddJob()
+			nodeOf = append(nodeOf, node)
+		}
+	}
+	for range p.Pods {
+		b.AddJob()
+	}
+	for pod := range p.Pods {
+		b.AddWorker()
+		for slot, node := range nodeOf {
+			if feasible(pod, node) {
+				if err := b.SetCost(pod, slot, top-p.Scores[pod][node]); err != nil {
+					return nil, nil, err
+				}
+			}
+		}
+		if err := b.SetCost(pod, len(nodeOf)+pod, penalty); err != nil {
+			return nil, nil, err
+		}
+	}
+	a, err := b.Solve()
+	if err != nil {
+		return nil, nil, err
+	}
+	var bindings []Binding
+	var unscheduled []string
+	for pod, slot := range a {
+		if slot < len(nodeOf) {
+			bindings = append(bindings, Binding{p.Pods[pod], p.Nodes[nodeOf[slot]]})
+		} else {
+			unscheduled = append(unscheduled, p.Pods[pod])
+		}
+	}
+	return bindings, unscheduled, nil
+}
+
+// Check that the problem has an entry for each pod and node, and that
+// the scores are numbers and the capacities are not negative.
+func (p *Problem) check() error {
+	if len(p.Scores) != len(p.Pods) || p.Feasible != nil && len(p.Feasible) != len(p.Pods) ||
+		p.Capacity != nil && len(p.Capacity) != len(p.Nodes) {
+		return ErrorDimensionMismatch
+	}
+	for pod := range p.Pods {
+		if len(p.Scores[pod]) != len(p.Nodes) || p.Feasible != nil && len(p.Feasible[pod]) != len(p.Nodes) {
+			return ErrorDimensionMismatch
+		}
+		for node, s := range p.Scores[pod] {
+			if math.IsNaN(s) {
+				return &munkres.CostError{Row: pod, Col: node, Value: s, Err: munkres.ErrorNaNCost}
+			}
+			if math.IsInf(s, 0) {
+				return &munkres.CostError{Row: pod, Col: node, Value: s, Err: munkres.ErrorInfiniteCost}
+			}
+		}
+	}
+	for _, c := range p.Capacity {
+		if c < 0 {
+			return ErrorNegativeCapacity
+		}
+	}
+	return nil
+}
+
+func init() {
+	ErrorDimensionMismatch = errors.New("Dimension mismatch")
+	ErrorNegativeCapacity = errors.New("Negative capacity")
+}
@@ -0,0 +1,91 @@
+package scheduler_test
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/charles-haynes/munkres"
+	"github.com/charles-haynes/munkres/scheduler"
+)
+
+func TestSchedule(t *testing.T) {
+	for _, test := range []struct {
+		name        string
+		problem     scheduler.Problem
+		bindings    []scheduler.Binding
+		unscheduled []string
+	}{
+		{
+			// Greedily a would take x, leaving b the poor y.
+			"optimal",
+			scheduler.Problem{
+				Pods:   []string{"a", "b"},
+				Nodes:  []string{"x", "y"},
+				Scores: [][]float64{{10, 9}, {10, 1}},
+			},
+			[]scheduler.Binding{{"a", "y"}, {"b", "x"}},
+			nil,
+		},
+		{
+			"filtered",
+			scheduler.Problem{
+				Pods:     []string{"a", "b", "c"},
+				Nodes:    []string{"x", "y"},
+				Scores:   [][]float64{{10, 9}, {10, 1}, {5, 5}},
+				Feasible: [][]bool{{true, true}, {false, true}, {false, false}},
+			},
+			[]scheduler.Binding{{"a", "x"}, {"b", "y"}},
+			[]string{"c"},
+		},
+		{
+			"capacity",
+			scheduler.Problem{
+				Pods:     []string{"a", "b", "c"},
+				Nodes:    []string{"x", "y"},
+				Scores:   [][]float64{{10, 0}, {10, 0}, {9, 8}},
+				Capacity: []int{2, 0},
+			},
+			[]scheduler.Binding{{"a", "x"}, {"b", "x"}},
+			[]string{"c"},
+		},
+		{
+			"nowhere",
+			scheduler.Problem{
+				Pods:     []string{"a"},
+				Nodes:    []string{"x"},
+				Scores:   [][]float64{{1}},
+				Feasible: [][]bool{{false}},
+			},
+			nil,
+			[]string{"a"},
+		},
+	} {
+		bindings, unscheduled, err := scheduler.Schedule(test.problem)
+		if err != nil {
+			t.Fatalf("%s: %v", test.name, err)
+		}
+		if !reflect.DeepEqual(bindings, test.bindings) || !reflect.DeepEqual(unscheduled, test.unscheduled) {
+			t.Errorf("%s: want %v and %v unscheduled got %v and %v", test.name,
+				test.bindings, test.unscheduled, bindings, unscheduled)
+		}
+	}
+}
+
+func TestScheduleInvalid(t *testing.T) {
+	for _, test := range []struct {
+		problem scheduler.Problem
+		err     error
+	}{
+		{scheduler.Problem{Pods: []string{"a"}, Nodes: []string{"x"}}, scheduler.ErrorDimensionMismatch},
+		{scheduler.Problem{Pods: []string{"a"}, Nodes: []string{"x"}, Scores: [][]float64{{1}},
+			Capacity: []int{-1}}, scheduler.ErrorNegativeCapacity},
+		{scheduler.Problem{Pods: []string{"a"}, Nodes: []string{"x"}, Scores: [][]float64{{math.NaN()}}},
+			munkres.ErrorNaNCost},
+	} {
+		if _, _, err := scheduler.Schedule(test.problem); !errors.Is(err, test.err) {
+			t.Errorf("%+v: want %v got %v", test.problem, test.err, err)
+		}
+	}
+}
@@ -0,0 +1,136 @@
+package munkres
+
+import "math"
+
+// WithScipyTieOrder makes Execute choose, among the optimal assignments of
+// a matrix with several, the one scipy.optimize.linear_sum_assignment
+// chooses, so that code ported from it keeps its results, such as those
+// of snapshot tests. The problem is solved as scipy solves it, by the
+// shortest augmenting path algorithm of Crouse (2016) on the unpadded
+// matrix, transposed if there are more workers than jobs, augmenting each
+// row in turn from zero labels and preferring an unassigned column among
+// those equally near.
+//
+// ErrorInvalidOption is returned if WithWorkerGroups, WithBackups,
+// WithSinkhornScaling, WithRejection, WithPhaseHooks, WithSubscriber or
+// WithAutoEpsilon is also set, since they need the padded form of the
+// algorithm.
+func WithScipyTieOrder() Option {
+	return func(o *options) {
+		o.scipy = true
+	}
+}
+
+// Execute the algorithm as scipy's linear_sum_assignment does, leaving
+// the labels and matching as the padded form of the algorithm would leave
+// them.
+//
+// return false if forbidden assignments leave no complete matching of
+// the smaller side.
+func (h *HungarianAlgorithm) executeScipy() bool {
+	for w := range h.matchJobByWorker {
+		h.matchJobByWorker[w] = -1
+		h.matchWorkerByJob[w] = -1
+	}
+	if h.rows == 0 || h.cols == 0 {
+		h.padMatching()
+		return true
+	}
+	nr, nc, transpose := h.rows, h.cols, h.cols < h.rows
+	cost := func(i, j int) float64 { return h.costs[i*h.dim+j] }
+	if transpose {
+		nr, nc = nc, nr
+		cost = func(i, j int) float64 { return h.costs[j*h.dim+i] }
+	}
+	u := make([]float64, nr)
+	v := make([]float64, nc)
+	shortest := make([]float64, nc)
+	path := make([]int, nc)
+	col4row := unassigned(nr)
+	row4col := unassigned(nc)
+	sr := make([]bool, nr)
+	sc := make([]bool, nc)
+	remaining := make([]int, nc)
+	h.progress.start(nr)
+	for row := 0; row < nr; row++ {
+		// Find the shortest augmenting path from the row.
+		for k := range remaining {
+			// In reverse, so that a constant matrix is solved by
+			// the identity.
+			remaining[k] = nc - k - 1
+		}
+		for k := range sr {
+			sr[k] = false
+		}
+		for k := range sc {
+			sc[k] = false
+			shortest[k] = math.Inf(1)
+		}
+		minVal, sink, i, n := 0.0, -1, row, nc
+		for sink == -1 {
+			index, lowest := -1, math.Inf(1)
+			sr[i] = true
+			for k, j := range remaining[:n] {
+				if r := minVal + cost(i, j) - u[i] - v[j]; r < shortest[j] {
+					path[j] = i
+					shortest[j] = r
+				}
+				// Among the nearest columns, take an unassigned
+				// one, which ends the path.
+				if shortest[j] < lowest || shortest[j] == lowest && row4col[j] == -1 {
+					lowest = shortest[j]
+					index = k
+				}
+			}
+			minVal = lowest
+			if math.IsInf(minVal, 1) {
+				return false
+			}
+			j := remaining[index]
+			if row4col[j] == -1 {
+				sink = j
+			} else {
+				i = row4col[j]
+			}
+			sc[j] = true
+			n--
+			remaining[index] = remaining[n]
+		}
+		h.updates.observe(minVal)
+		u[row] += minVal
+		for i := range u {
+			if sr[i] && i != row {
+				u[i] += minVal - shortest[col4row[i]]
+			}
+		}
+		for j := range v {
+			if sc[j] {
+				v[j] -= minVal - shortest[j]
+			}
+		}
+		for j := sink; ; {
+			i := path[j]
+			row4col[j] = i
+			col4row[i], j = j, col4row[i]
+			if i == row {
+				break
+			}
+		}
+		h.progress.phase()
+	}
+	if transpose {
+		copy(h.labelByJob, u)
+		copy(h.labelByWorker, v)
+		for j, w := range col4row {
+			h.match(w, j)
+		}
+	} else {
+		copy(h.labelByWorker, u)
+		copy(h.labelByJob, v)
+		for w, j := range col4row {
+			h.match(w, j)
+		}
+	}
+	h.padMatching()
+	return true
+}
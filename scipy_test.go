@@ -0,0 +1,80 @@
+package munkres_test
+
+import (
+	"math"
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/charles-haynes/munkres"
+)
+
+func TestWithScipyTieOrder(t *testing.T) {
+	for _, test := range []struct {
+		costMatrix [][]float64
+		want       []int
+	}{
+		// scipy solves a constant matrix by the identity.
+		{[][]float64{{1, 1, 1}, {1, 1, 1}, {1, 1, 1}}, []int{0, 1, 2}},
+		{[][]float64{{1, 1}, {1, 1}, {1, 1}}, []int{0, 1, -1}},
+		{[][]float64{{1, 1, 1}, {1, 1, 1}}, []int{0, 1}},
+		// The default form chooses [2 1 0] among the optima.
+		{[][]float64{{1, 2, 1}, {2, 1, 1}, {1, 1, 2}}, []int{0, 2, 1}},
+		{[][]float64{{4, 1, 3}, {2, 0, 5}, {3, 2, 2}}, []int{1, 0, 2}},
+	} {
+		h, err := munkres.NewHungarianAlgorithm(test.costMatrix, munkres.WithScipyTieOrder())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := h.Execute(); !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%v: want %v got %v", test.costMatrix, test.want, got)
+		}
+	}
+	h, _ := munkres.NewHungarianAlgorithm([][]float64{}, munkres.WithScipyTieOrder())
+	if got := h.Execute(); len(got) != 0 {
+		t.Errorf("want an empty assignment got %v", got)
+	}
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 100; i++ {
+		c := randomMatrix(rng, 1+rng.Intn(6), 1+rng.Intn(6))
+		for _, row := range c {
+			for j := range row {
+				row[j] = math.Floor(row[j] * 3)
+			}
+		}
+		h, _ = munkres.NewHungarianAlgorithm(c, munkres.WithScipyTieOrder())
+		a := munkres.Assignment(h.Execute())
+		if want, _ := bruteForceCost(c, func(w, j int) bool { return true }); math.Abs(a.Cost(c)-want) > 1e-9 {
+			t.Fatalf("%v: want cost %f got %v costing %f", c, want, a, a.Cost(c))
+		}
+		workerDuals, jobDuals := h.Duals()
+		total := 0.0
+		for w, row := range c {
+			total += workerDuals[w]
+			for j, cost := range row {
+				if workerDuals[w]+jobDuals[j] > cost+1e-9 {
+					t.Fatalf("%v: duals %v, %v infeasible", c, workerDuals, jobDuals)
+				}
+			}
+		}
+		for _, d := range jobDuals {
+			total += d
+		}
+		if math.Abs(total-a.Cost(c)) > 1e-9 {
+			t.Fatalf("%v: want duals summing to %f got %f", c, a.Cost(c), total)
+		}
+	}
+	b := munkres.NewBipartite()
+	b.AddWorker()
+	b.AddWorker()
+	b.AddJob()
+	b.AddJob()
+	b.SetCost(0, 0, 1)
+	b.SetCost(1, 0, 1)
+	if _, err := b.Solve(munkres.WithScipyTieOrder()); err != munkres.ErrorInfeasible {
+		t.Errorf("want %v got %v", munkres.ErrorInfeasible, err)
+	}
+	if _, err := munkres.NewHungarianAlgorithm([][]float64{{1}}, munkres.WithScipyTieOrder(), munkres.WithBackups()); err != munkres.ErrorInvalidOption {
+		t.Errorf("want %v got %v", munkres.ErrorInvalidOption, err)
+	}
+}
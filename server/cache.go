@@ -0,0 +1,108 @@
+package server
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"math"
+	"sync"
+	"time"
+)
+
+// A Cache stores the assignments solved by a Server by the fingerprints
+// of their cost matrices, so that identical problems submitted by many
+// clients within a window are solved once. It may be backed by an
+// external store such as Redis or memcached, shared by the instances of
+// a deployment, and must be safe for concurrent use.
+type Cache interface {
+	// Get returns the assignment stored for the fingerprint, and whether
+	// there was one which had not expired.
+	Get(ctx context.Context, fingerprint string) ([]int, bool, error)
+	// Set stores the assignment for the fingerprint, to expire after
+	// ttl.
+	Set(ctx context.Context, fingerprint string, assignment []int, ttl time.Duration) error
+}
+
+// Fingerprint returns the key of a cost matrix in a Cache: the hex
+// SHA-256 digest of its dimensions and costs, so that distinct matrices
+// have distinct keys but for a negligible chance.
+func Fingerprint(costMatrix [][]float64) string {
+	f := sha256.New()
+	var b [8]byte
+	binary.LittleEndian.PutUint64(b[:], uint64(len(costMatrix)))
+	f.Write(b[:])
+	for _, row := range costMatrix {
+		binary.LittleEndian.PutUint64(b[:], uint64(len(row)))
+		f.Write(b[:])
+		for _, c := range row {
+			binary.LittleEndian.PutUint64(b[:], math.Float64bits(c))
+			f.Write(b[:])
+		}
+	}
+	return hex.EncodeToString(f.Sum(nil))
+}
+
+// A MemoryCache is a Cache held in memory, for a single instance or for
+// tests. Expired entries are dropped as they are looked up, and by
+// Purge.
+type MemoryCache struct {
+	mu      sync.Mutex
+	entries map[string]cacheEntry
+}
+
+type cacheEntry struct {
+	assignment []int
+	expires    time.Time
+}
+
+// NewMemoryCache creates an empty cache in memory.
+func NewMemoryCache() *MemoryCache {
+	return &MemoryCache{entries: map[string]cacheEntry{}}
+}
+
+// Get returns a copy of the assignment stored for the fingerprint.
+func (c *MemoryCache) Get(ctx context.Context, fingerprint string) ([]int, bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.entries[fingerprint]
+	if !ok {
+		return nil, false, nil
+	}
+	if !time.Now().Before(e.expires) {
+		delete(c.entries, fingerprint)
+		return nil, false, nil
+	}
+	return append([]int{}, e.assignment...), true, nil
+}
+
+// Set stores a copy of the assignment for the fingerprint.
+func (c *MemoryCache) Set(ctx context.Context, fingerprint string, assignment []int, ttl time.Duration) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries[fingerprint] = cacheEntry{
+		assignment: append([]int{}, assignment...),
+		expires:    time.Now().Add(ttl),
+	}
+	return nil
+}
+
+// Purge drops the expired entries.
+func (c *MemoryCache) Purge() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := time.Now()
+	for k, e := range c.entries {
+		if !now.Before(e.expires) {
+			delete(c.entries, k)
+		}
+	}
+}
+
+// Len returns the number of entries, including those expired but not yet
+// dropped.
+func (c *MemoryCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.entries)
+}
@@ -0,0 +1,86 @@
+package server_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/charles-haynes/munkres"
+	"github.com/charles-haynes/munkres/server"
+)
+
+func TestFingerprint(t *testing.T) {
+	a := server.Fingerprint(costs)
+	if a != server.Fingerprint([][]float64{{4, 1, 3}, {2, 0, 5}, {3, 2, 2}}) {
+		t.Errorf("want equal matrices to have equal fingerprints")
+	}
+	for _, c := range [][][]float64{{{4, 1, 3}, {2, 0, 5}, {3, 2, 3}}, {{4, 1}, {3, 2}, {0, 5, 3, 2, 2}}, nil} {
+		if server.Fingerprint(c) == a {
+			t.Errorf("want %v to have a distinct fingerprint", c)
+		}
+	}
+}
+
+func TestMemoryCache(t *testing.T) {
+	ctx := context.Background()
+	c := server.NewMemoryCache()
+	a := []int{1, 0, 2}
+	c.Set(ctx, "a", a, time.Hour)
+	c.Set(ctx, "b", a, time.Millisecond)
+	a[0] = 2
+	if got, ok, err := c.Get(ctx, "a"); !ok || err != nil || !reflect.DeepEqual(got, []int{1, 0, 2}) {
+		t.Errorf("want a copy of [1 0 2] got %v %v %v", got, ok, err)
+	}
+	time.Sleep(2 * time.Millisecond)
+	if _, ok, _ := c.Get(ctx, "b"); ok {
+		t.Errorf("want b expired")
+	}
+	c.Set(ctx, "b", a, time.Millisecond)
+	time.Sleep(2 * time.Millisecond)
+	if c.Purge(); c.Len() != 1 {
+		t.Errorf("want 1 entry after purging got %d", c.Len())
+	}
+}
+
+// A cache which always fails.
+type brokenCache struct{}
+
+func (brokenCache) Get(context.Context, string) ([]int, bool, error) {
+	return nil, false, errors.New("down")
+}
+
+func (brokenCache) Set(context.Context, string, []int, time.Duration) error {
+	return errors.New("down")
+}
+
+func TestServerCache(t *testing.T) {
+	cache := server.NewMemoryCache()
+	s, err := server.New(server.Config{Workers: 1, QueueSize: 1, Cache: cache, CacheTTL: time.Hour})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+	want := []int{1, 0, 2}
+	for i := 0; i < 3; i++ {
+		if a, err := s.Solve(context.Background(), costs); err != nil || !reflect.DeepEqual(a, want) {
+			t.Fatalf("want %v got %v (%v)", want, a, err)
+		}
+	}
+	// Requests with options are not cached.
+	s.Solve(context.Background(), costs, munkres.WithIntegerCosts())
+	stats := s.Stats()
+	if stats.CacheHits != 2 || stats.CacheMisses != 1 || stats.Accepted != 2 || cache.Len() != 1 {
+		t.Errorf("want 2 hits, 1 miss and 2 accepted got %+v", stats)
+	}
+
+	broken, _ := server.New(server.Config{Workers: 1, QueueSize: 1, Cache: brokenCache{}, CacheTTL: time.Hour})
+	defer broken.Close()
+	if a, err := broken.Solve(context.Background(), costs); err != nil || !reflect.DeepEqual(a, want) {
+		t.Errorf("want %v despite the cache got %v (%v)", want, a, err)
+	}
+	if _, err := server.New(server.Config{Workers: 1, Cache: cache}); err != server.ErrorInvalidConfig {
+		t.Errorf("want ErrorInvalidConfig without a TTL got %v", err)
+	}
+}
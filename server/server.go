@@ -0,0 +1,343 @@
+/*
+Package server runs the solvers of package munkres as a service. Solve
+requests wait on a bounded queue for a fixed pool of workers; a request
+which finds the queue full is refused at once, rather than waiting without
+bound, so that an overloaded service sheds load with a clear error. The
+depth of the queue and counts of the requests are kept for monitoring.
+Solutions may be kept in a Cache, so that identical problems are solved
+once.
+*/
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/charles-haynes/munkres"
+)
+
+var
+// The queue is full, so the request was refused
+ErrorSaturated,
+	// The server has been closed
+	ErrorClosed,
+	// The configuration is out of range
+	ErrorInvalidConfig error
+
+// A Config configures a Server.
+type Config struct {
+	// Workers is the number of requests solved at once, which must be
+	// positive.
+	Workers int
+	// QueueSize is the number of requests which may wait for a worker,
+	// which must not be negative.
+	QueueSize int
+	// Cache, if not nil, stores the assignments solved for requests
+	// without options, for CacheTTL, which must then be positive. A
+	// request found in it is answered without queueing, and an error
+	// of the cache is treated as a miss, so that the service outlives
+	// its cache.
+	Cache    Cache
+	CacheTTL time.Duration
+	// MaxBodySize is the greatest size in bytes of the body of a request
+	// to ServeHTTP, which is refused with status 413 if it is larger,
+	// and MaxDimension the greatest padded dimension of its costs, the
+	// greater of their numbers of rows and columns, which is refused
+	// with status 400 if it is greater. They are DefaultMaxBodySize and
+	// DefaultMaxDimension if zero, and must not be negative.
+	MaxBodySize  int64
+	MaxDimension int
+}
+
+// The limits of a request to ServeHTTP if Config sets none: enough for
+// the costs of a 1000 x 1000 matrix written with several digits each.
+const (
+	DefaultMaxBodySize  = 16 << 20
+	DefaultMaxDimension = 1000
+)
+
+// Stats are the state and counters of a Server.
+type Stats struct {
+	// QueueDepth is the number of requests waiting for a worker, of at
+	// most QueueSize.
+	QueueDepth, QueueSize int
+	// Busy is the number of the Workers solving a request.
+	Busy, Workers int
+	// Accepted and Rejected are the numbers of requests queued and
+	// refused since the server started, and Completed and Failed the
+	// numbers of those accepted which were solved and which were not.
+	Accepted, Rejected, Completed, Failed int64
+	// CacheHits and CacheMisses are the numbers of requests found in the
+	// Cache, and of those looked up there but not found.
+	CacheHits, CacheMisses int64
+}
+
+// A Server solves requests with a pool of workers.
+type Server struct {
+	// The counters, first so that they are aligned for atomic access on
+	// 32-bit platforms.
+	accepted, rejected, completed, failed, busy, hits, misses int64
+	queue                                                     chan *request
+	workers                                                   int
+	cache                                                     Cache
+	ttl                                                       time.Duration
+	maxBodySize                                               int64
+	maxDimension                                              int
+	// Held to queue a request, and exclusively to close the queue.
+	mu     sync.RWMutex
+	closed bool
+	wg     sync.WaitGroup
+}
+
+// A request to solve a cost matrix, answered on done.
+type request struct {
+	ctx        context.Context
+	costMatrix [][]float64
+	opts       []munkres.Option
+	// fingerprint is the key of costMatrix in the cache, or empty if
+	// the solution is not cached.
+	fingerprint string
+	done        chan response
+}
+
+type response struct {
+	assignment []int
+	err        error
+}
+
+// New starts a server with the given configuration.
+func New(config Config) (*Server, error) {
+	if config.Workers <= 0 || config.QueueSize < 0 || config.Cache != nil && config.CacheTTL <= 0 ||
+		config.MaxBodySize < 0 || config.MaxDimension < 0 {
+		return nil, ErrorInvalidConfig
+	}
+	s := &Server{
+		queue:        make(chan *request, config.QueueSize),
+		workers:      config.Workers,
+		cache:        config.Cache,
+		ttl:          config.CacheTTL,
+		maxBodySize:  config.MaxBodySize,
+		maxDimension: config.MaxDimension,
+	}
+	if s.maxBodySize == 0 {
+		s.maxBodySize = DefaultMaxBodySize
+	}
+	if s.maxDimension == 0 {
+		s.maxDimension = DefaultMaxDimension
+	}
+	for i := 0; i < config.Workers; i++ {
+		s.wg.Add(1)
+		go s.work()
+	}
+	return s, nil
+}
+
+// Solve solves the assignment problem for costMatrix with the given
+// options, as NewHungarianAlgorithm and ExecuteContext do, once a worker
+// is free. If ctx is done first, its error is returned and the solve is
+// abandoned at the end of its current phase. A request without options
+// is first looked up in the Cache, if there is one.
+//
+// return ErrorSaturated at once if every worker is busy and the queue is
+// full, or ErrorClosed if the server has been closed.
+func (s *Server) Solve(ctx context.Context, costMatrix [][]float64, opts ...munkres.Option) ([]int, error) {
+	r := &request{ctx: ctx, costMatrix: costMatrix, opts: opts, done: make(chan response, 1)}
+	if s.cache != nil && len(opts) == 0 {
+		r.fingerprint = Fingerprint(costMatrix)
+		if a, ok, err := s.cache.Get(ctx, r.fingerprint); err == nil && ok {
+			atomic.AddInt64(&s.hits, 1)
+			return a, nil
+		}
+		atomic.AddInt64(&s.misses, 1)
+	}
+	s.mu.RLock()
+	if s.closed {
+		s.mu.RUnlock()
+		return nil, ErrorClosed
+	}
+	select {
+	case s.queue <- r:
+		atomic.AddInt64(&s.accepted, 1)
+	default:
+		s.mu.RUnlock()
+		atomic.AddInt64(&s.rejected, 1)
+		return nil, ErrorSaturated
+	}
+	s.mu.RUnlock()
+	select {
+	case res := <-r.done:
+		return res.assignment, res.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// Solve the queued requests until the queue is closed.
+func (s *Server) work() {
+	defer s.wg.Done()
+	for r := range s.queue {
+		atomic.AddInt64(&s.busy, 1)
+		a, err := solve(r)
+		atomic.AddInt64(&s.busy, -1)
+		if err != nil {
+			atomic.AddInt64(&s.failed, 1)
+		} else {
+			atomic.AddInt64(&s.completed, 1)
+			if r.fingerprint != "" {
+				s.cache.Set(r.ctx, r.fingerprint, a, s.ttl)
+			}
+		}
+		r.done <- response{a, err}
+	}
+}
+
+func solve(r *request) ([]int, error) {
+	if err := r.ctx.Err(); err != nil {
+		return nil, err
+	}
+	h, err := munkres.NewHungarianAlgorithm(r.costMatrix, r.opts...)
+	if err != nil {
+		return nil, err
+	}
+	return h.ExecuteContext(r.ctx)
+}
+
+// Stats returns the state and counters of the server.
+func (s *Server) Stats() Stats {
+	return Stats{
+		QueueDepth:  len(s.queue),
+		QueueSize:   cap(s.queue),
+		Busy:        int(atomic.LoadInt64(&s.busy)),
+		Workers:     s.workers,
+		Accepted:    atomic.LoadInt64(&s.accepted),
+		Rejected:    atomic.LoadInt64(&s.rejected),
+		Completed:   atomic.LoadInt64(&s.completed),
+		Failed:      atomic.LoadInt64(&s.failed),
+		CacheHits:   atomic.LoadInt64(&s.hits),
+		CacheMisses: atomic.LoadInt64(&s.misses),
+	}
+}
+
+// Close refuses further requests, and returns once those queued have
+// been solved.
+func (s *Server) Close() {
+	s.mu.Lock()
+	if !s.closed {
+		s.closed = true
+		close(s.queue)
+	}
+	s.mu.Unlock()
+	s.wg.Wait()
+}
+
+// A Problem is the body of a request to ServeHTTP.
+type Problem struct {
+	Costs [][]float64 `json:"costs"`
+}
+
+// A Solution is the body of a successful response from ServeHTTP.
+type Solution struct {
+	Assignment []int   `json:"assignment"`
+	Cost       float64 `json:"cost"`
+}
+
+// ServeHTTP solves the Problem POSTed as JSON, responding with its
+// Solution as JSON. An invalid problem, or one larger than MaxDimension,
+// is answered with status 400, a body larger than MaxBodySize with
+// status 413, and a refused request with status 503 and a Retry-After
+// header, so that clients back off.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	var p Problem
+	if !s.Decode(w, r, &p) {
+		return
+	}
+	if err := s.CheckDimension(p.Costs); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	a, err := s.Solve(r.Context(), p.Costs)
+	switch {
+	case err == ErrorSaturated || err == ErrorClosed:
+		w.Header().Set("Retry-After", "1")
+		http.Error(w, err.Error(), http.StatusServiceUnavailable)
+		return
+	case err == context.Canceled || err == context.DeadlineExceeded:
+		http.Error(w, err.Error(), http.StatusServiceUnavailable)
+		return
+	case err != nil:
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	cost, _ := munkres.ComputeCost(p.Costs, a)
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(Solution{Assignment: a, Cost: cost})
+}
+
+// Decode decodes the JSON body of r into v, as ServeHTTP decodes a
+// Problem, so that handlers beside it are held to the same MaxBodySize.
+// A body which is too large is answered with status 413, and one which
+// is malformed with status 400.
+//
+// return whether v was decoded, the request having been answered if not.
+func (s *Server) Decode(w http.ResponseWriter, r *http.Request, v interface{}) bool {
+	body := &limitedBody{r: http.MaxBytesReader(w, r.Body, s.maxBodySize), limit: s.maxBodySize}
+	if err := json.NewDecoder(body).Decode(v); err != nil {
+		if body.tooLarge {
+			http.Error(w, err.Error(), http.StatusRequestEntityTooLarge)
+		} else {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+		return false
+	}
+	return true
+}
+
+// CheckDimension returns a *munkres.DimensionLimitError if the padded
+// dimension of costMatrix, the greater of its numbers of rows and of
+// columns in any row, exceeds MaxDimension, as ServeHTTP checks it.
+func (s *Server) CheckDimension(costMatrix [][]float64) error {
+	dim := len(costMatrix)
+	for _, row := range costMatrix {
+		if len(row) > dim {
+			dim = len(row)
+		}
+	}
+	if dim > s.maxDimension {
+		return &munkres.DimensionLimitError{Dim: dim, Limit: s.maxDimension}
+	}
+	return nil
+}
+
+// A request body read through http.MaxBytesReader, which records whether
+// it failed for being larger than limit, rather than for being malformed.
+type limitedBody struct {
+	r           io.Reader
+	read, limit int64
+	tooLarge    bool
+}
+
+func (b *limitedBody) Read(p []byte) (int, error) {
+	n, err := b.r.Read(p)
+	b.read += int64(n)
+	if err != nil && err != io.EOF && b.read >= b.limit {
+		b.tooLarge = true
+	}
+	return n, err
+}
+
+func init() {
+	ErrorSaturated = errors.New("Server saturated")
+	ErrorClosed = errors.New("Server closed")
+	ErrorInvalidConfig = errors.New("Invalid configuration")
+}
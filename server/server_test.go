@@ -0,0 +1,133 @@
+package server_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/charles-haynes/munkres"
+	"github.com/charles-haynes/munkres/server"
+)
+
+var costs = [][]float64{{4, 1, 3}, {2, 0, 5}, {3, 2, 2}}
+
+// Wait until f holds, failing the test if it never does.
+func eventually(t *testing.T, f func() bool) {
+	for deadline := time.Now().Add(5 * time.Second); !f(); time.Sleep(time.Millisecond) {
+		if time.Now().After(deadline) {
+			t.Fatal("timed out")
+		}
+	}
+}
+
+func TestSolve(t *testing.T) {
+	s, err := server.New(server.Config{Workers: 2, QueueSize: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+	a, err := s.Solve(context.Background(), costs)
+	if want := []int{1, 0, 2}; err != nil || !reflect.DeepEqual(a, want) {
+		t.Errorf("want %v got %v (%v)", want, a, err)
+	}
+	if _, err := s.Solve(context.Background(), [][]float64{{1}, {}}); err == nil {
+		t.Errorf("want an error for an irregular matrix")
+	}
+	stats := s.Stats()
+	if stats.Accepted != 2 || stats.Completed != 1 || stats.Failed != 1 || stats.Workers != 2 || stats.QueueSize != 2 {
+		t.Errorf("want 2 accepted, 1 completed and 1 failed got %+v", stats)
+	}
+}
+
+func TestSaturated(t *testing.T) {
+	s, _ := server.New(server.Config{Workers: 1, QueueSize: 1})
+	release := make(chan struct{})
+	// Hold the worker in its first phase.
+	hold := munkres.WithPhaseHooks(func(munkres.PhaseInfo) { <-release }, nil)
+	results := make(chan error, 2)
+	solve := func() {
+		_, err := s.Solve(context.Background(), costs, hold)
+		results <- err
+	}
+	go solve()
+	eventually(t, func() bool { return s.Stats().Busy == 1 })
+	go solve()
+	eventually(t, func() bool { return s.Stats().QueueDepth == 1 })
+	if _, err := s.Solve(context.Background(), costs); err != server.ErrorSaturated {
+		t.Errorf("want %v got %v", server.ErrorSaturated, err)
+	}
+	if stats := s.Stats(); stats.Rejected != 1 || stats.Accepted != 2 {
+		t.Errorf("want 1 rejected and 2 accepted got %+v", stats)
+	}
+	close(release)
+	for i := 0; i < 2; i++ {
+		if err := <-results; err != nil {
+			t.Error(err)
+		}
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := s.Solve(ctx, costs); err != context.Canceled {
+		t.Errorf("want %v got %v", context.Canceled, err)
+	}
+	s.Close()
+	if _, err := s.Solve(context.Background(), costs); err != server.ErrorClosed {
+		t.Errorf("want %v got %v", server.ErrorClosed, err)
+	}
+	for _, config := range []server.Config{
+		{},
+		{Workers: 1, MaxBodySize: -1},
+		{Workers: 1, MaxDimension: -1},
+	} {
+		if _, err := server.New(config); err != server.ErrorInvalidConfig {
+			t.Errorf("%+v: want %v got %v", config, server.ErrorInvalidConfig, err)
+		}
+	}
+}
+
+func TestServeHTTP(t *testing.T) {
+	s, _ := server.New(server.Config{Workers: 1, MaxBodySize: 200, MaxDimension: 4})
+	defer s.Close()
+	ts := httptest.NewServer(s)
+	defer ts.Close()
+	body, _ := json.Marshal(server.Problem{Costs: costs})
+	resp, err := http.Post(ts.URL, "application/json", bytes.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var solution server.Solution
+	json.NewDecoder(resp.Body).Decode(&solution)
+	resp.Body.Close()
+	want := server.Solution{Assignment: []int{1, 0, 2}, Cost: 5}
+	if resp.StatusCode != http.StatusOK || !reflect.DeepEqual(solution, want) {
+		t.Errorf("want %v got %d %v", want, resp.StatusCode, solution)
+	}
+	for _, test := range []struct {
+		method, body string
+		status       int
+	}{
+		{http.MethodGet, "", http.StatusMethodNotAllowed},
+		{http.MethodPost, "{", http.StatusBadRequest},
+		{http.MethodPost, `{"costs": [[1], []]}`, http.StatusBadRequest},
+		{http.MethodPost, `{"costs": [[1, 2, 3, 4, 5]]}`, http.StatusBadRequest},
+		{http.MethodPost, `{"costs": [[1], [2], [3], [4], [5]]}`, http.StatusBadRequest},
+		{http.MethodPost, `{"costs": [[1, 2], [2, 1]]}` + strings.Repeat(" ", 100), http.StatusOK},
+		{http.MethodPost, `{"costs": [[1, 2], [2, 1], ` + strings.Repeat("[1, 2], ", 100) + `]}`, http.StatusRequestEntityTooLarge},
+	} {
+		req, _ := http.NewRequest(test.method, ts.URL, bytes.NewReader([]byte(test.body)))
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode != test.status {
+			t.Errorf("%s %q: want status %d got %d", test.method, test.body, test.status, resp.StatusCode)
+		}
+	}
+}
@@ -0,0 +1,110 @@
+package munkres
+
+import "math"
+
+// The parameters of Sinkhorn scaling.
+type sinkhornSchedule struct {
+	iterations int
+	tolerance  float64
+}
+
+// WithSinkhornScaling makes Execute precondition the cost matrix by up to
+// the given number of iterations of Sinkhorn scaling before solving,
+// which on badly conditioned matrices greatly reduces the number of label
+// updates. Scaling stops early once no potential changes by more than
+// tolerance times the temperature. The iterations must be positive and
+// the tolerance must not be negative.
+//
+// Scaling finds potentials for the workers and jobs of the padded square
+// matrix which make exp(-(c - u - v)/t) doubly stochastic, for a
+// temperature t of a tenth of the range of the costs, and subtracts them
+// from the costs. Every complete assignment of the square matrix contains
+// exactly one cost of each worker and each job, so this changes the cost
+// of every assignment equally and the assignment found remains optimal.
+// Duals and backups are reported for the original costs.
+func WithSinkhornScaling(iterations int, tolerance float64) Option {
+	return func(o *options) {
+		o.sinkhorn = sinkhornSchedule{iterations, tolerance}
+	}
+}
+
+// return whether the parameters are in range.
+func (s sinkhornSchedule) valid() bool {
+	return s.iterations > 0 && s.tolerance >= 0
+}
+
+// Subtract Sinkhorn potentials from the cost matrix, recording them as
+// scaleByWorker and scaleByJob.
+func (h *HungarianAlgorithm) scale() {
+	min, max := math.Inf(1), math.Inf(-1)
+	for w := 0; w < h.dim; w++ {
+		row := h.row(w)
+		for _, c := range row {
+			if !math.IsInf(c, 1) {
+				min = math.Min(min, c)
+				max = math.Max(max, c)
+			}
+		}
+	}
+	if !(max > min) {
+		return
+	}
+	t := (max - min) / 10
+	u, v := make([]float64, h.dim), make([]float64, h.dim)
+	terms := make([]float64, h.dim)
+	for i := 0; i < h.opts.sinkhorn.iterations; i++ {
+		change := 0.0
+		for w := range u {
+			for j := range terms {
+				terms[j] = (v[j] - h.costs[w*h.dim+j]) / t
+			}
+			updated := -t * logSumExp(terms)
+			change = math.Max(change, math.Abs(updated-u[w]))
+			u[w] = updated
+		}
+		for j := range v {
+			for w := range terms {
+				terms[w] = (u[w] - h.costs[w*h.dim+j]) / t
+			}
+			updated := -t * logSumExp(terms)
+			change = math.Max(change, math.Abs(updated-v[j]))
+			v[j] = updated
+		}
+		if change <= h.opts.sinkhorn.tolerance*t {
+			break
+		}
+	}
+	for w := 0; w < h.dim; w++ {
+		for j := 0; j < h.dim; j++ {
+			h.costs[w*h.dim+j] -= u[w] + v[j]
+		}
+	}
+	if h.scaleByWorker != nil {
+		// Scaled already by an earlier solve, which this adds to.
+		for w := range u {
+			u[w] += h.scaleByWorker[w]
+		}
+		for j := range v {
+			v[j] += h.scaleByJob[j]
+		}
+	}
+	h.scaleByWorker, h.scaleByJob = u, v
+}
+
+// return log(sum(exp(x))) computed without overflow, or 0 if every term
+// is -Inf, so that a worker or job which is entirely forbidden gets a
+// zero potential.
+func logSumExp(x []float64) float64 {
+	m := math.Inf(-1)
+	for _, xi := range x {
+		m = math.Max(m, xi)
+	}
+	if math.IsInf(m, -1) {
+		return 0
+	}
+	sum := 0.0
+	for _, xi := range x {
+		sum += math.Exp(xi - m)
+	}
+	return m + math.Log(sum)
+}
@@ -0,0 +1,59 @@
+package munkres_test
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/charles-haynes/munkres"
+)
+
+func TestSinkhornScaling(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 50; i++ {
+		rows, cols := 1+rng.Intn(10), 1+rng.Intn(10)
+		// Badly conditioned: each row and column on its own scale.
+		c := make([][]float64, rows)
+		for w := range c {
+			c[w] = make([]float64, cols)
+			for j := range c[w] {
+				c[w][j] = float64(rng.Intn(10)) + 1e6*float64(w) + 1e3*float64(j)
+			}
+		}
+		h, _ := munkres.NewHungarianAlgorithm(c)
+		want, _ := munkres.ComputeCost(c, h.Execute())
+		h, err := munkres.NewHungarianAlgorithm(c, munkres.WithSinkhornScaling(50, 1e-9))
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := munkres.ComputeCost(c, h.Execute())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != want {
+			t.Errorf("%v: want cost = %f got %f", c, want, got)
+		}
+		workerDuals, jobDuals := h.Duals()
+		total := 0.0
+		for _, d := range workerDuals {
+			total += d
+		}
+		for _, d := range jobDuals {
+			total += d
+		}
+		if math.Abs(total-want) > 1e-6*math.Abs(want) {
+			t.Errorf("%v: want duals totalling %f got %f", c, want, total)
+		}
+	}
+}
+
+func TestSinkhornScalingInvalid(t *testing.T) {
+	for _, opt := range []munkres.Option{
+		munkres.WithSinkhornScaling(0, 1e-9),
+		munkres.WithSinkhornScaling(10, -1),
+	} {
+		if _, err := munkres.NewHungarianAlgorithm([][]float64{{1}}, opt); err != munkres.ErrorInvalidOption {
+			t.Errorf("want err = %s got %v", munkres.ErrorInvalidOption, err)
+		}
+	}
+}
@@ -0,0 +1,114 @@
+package munkres
+
+// WithMonotoneCosts declares that the cost matrix satisfies the Monge
+// condition, as costs which are convex functions of the difference of
+// positions on a line do, so that every submatrix is totally monotone and
+// the minima of its rows or columns can be found by the SMAWK algorithm
+// in time O(n) rather than O(n^2). Execute then finds the minima of the
+// row and column reductions of a square matrix that way, and skips the
+// scan for the initial labels, which the reductions make zero; other
+// matrices, and the integer form of WithIntegerCosts, are solved as
+// usual. SolveMonge is faster still, but supports none of the options of
+// the Hungarian algorithm, such as WithBackups.
+//
+// NewHungarianAlgorithm checks the condition, as IsMonge does, and returns
+// ErrorInvalidOption if it does not hold, or if WithRankTransform or
+// WithSinkhornScaling, which break it, are also set.
+func WithMonotoneCosts() Option {
+	return func(o *options) {
+		o.monotone = true
+	}
+}
+
+// return whether the reductions use monotoneMinima, as they do
+// WithMonotoneCosts for a square matrix.
+func (h *HungarianAlgorithm) monotone() bool {
+	return h.opts.monotone && h.rows == h.cols
+}
+
+// Reduce the rows and columns of a square Monge cost matrix as reduce
+// does, finding their minima by monotoneMinima. Subtracting the minima of
+// the rows leaves the matrix Monge, so the minima of its columns can be
+// found the same way.
+func (h *HungarianAlgorithm) reduceMonotone() {
+	at := func(w, j int) float64 { return h.costs[w*h.dim+j] }
+	for w, j := range monotoneMinima(h.dim, h.dim, at) {
+		min := at(w, j)
+		subConst(h.row(w), min)
+		h.reductionByWorker[w] += min
+	}
+	min := h.minSlackValueByJob
+	for j, w := range monotoneMinima(h.dim, h.dim, func(j, w int) float64 { return at(w, j) }) {
+		min[j] = at(w, j)
+	}
+	for w := 0; w < h.dim; w++ {
+		subInto(h.row(w), min)
+	}
+	for j := range min {
+		h.reductionByJob[j] += min[j]
+	}
+}
+
+// return the column of the leftmost minimum of each of the n rows of an
+// n x m totally monotone matrix, where at(i, k) is the entry in row i and
+// column k, by the SMAWK algorithm, which evaluates O(n + m) entries.
+func monotoneMinima(n, m int, at func(i, k int) float64) []int {
+	rows := make([]int, n)
+	for i := range rows {
+		rows[i] = i
+	}
+	cols := make([]int, m)
+	for k := range cols {
+		cols[k] = k
+	}
+	result := make([]int, n)
+	smawk(rows, cols, at, result)
+	return result
+}
+
+// Record in result the column of the leftmost minimum of each of the
+// given rows among the given columns, which are both in increasing order.
+func smawk(rows, cols []int, at func(i, k int) float64, result []int) {
+	if len(rows) == 0 {
+		return
+	}
+	// Drop the columns which hold the leftmost minimum of no row, leaving
+	// at most one per row. A column is dropped when the row of its
+	// position in the stack prefers the next column, since then every
+	// later row does too.
+	stack := make([]int, 0, len(rows))
+	for _, k := range cols {
+		for len(stack) > 0 {
+			i := rows[len(stack)-1]
+			if at(i, stack[len(stack)-1]) <= at(i, k) {
+				break
+			}
+			stack = stack[:len(stack)-1]
+		}
+		if len(stack) < len(rows) {
+			stack = append(stack, k)
+		}
+	}
+	odd := make([]int, 0, len(rows)/2)
+	for r := 1; r < len(rows); r += 2 {
+		odd = append(odd, rows[r])
+	}
+	smawk(odd, stack, at, result)
+	// The minimum of each even row lies between those of its neighbours.
+	s := 0
+	for r := 0; r < len(rows); r += 2 {
+		i := rows[r]
+		last := stack[len(stack)-1]
+		if r+1 < len(rows) {
+			last = result[rows[r+1]]
+		}
+		best := s
+		for ; stack[s] != last; s++ {
+			if at(i, stack[s+1]) < at(i, stack[best]) {
+				best = s + 1
+			}
+		}
+		result[i] = stack[best]
+		s = best
+	}
+}
@@ -0,0 +1,57 @@
+package munkres_test
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/charles-haynes/munkres"
+)
+
+func TestWithMonotoneCosts(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 200; i++ {
+		n := 1 + rng.Intn(12)
+		c := mongeMatrix(rng, n, n)
+		// Adding to a row or column keeps the matrix Monge.
+		colShift := make([]float64, n)
+		for j := range colShift {
+			colShift[j] = float64(rng.Intn(50))
+		}
+		for w := range c {
+			shift := float64(rng.Intn(50))
+			for j := range c[w] {
+				c[w][j] += shift + colShift[j]
+			}
+		}
+		plain, _ := munkres.NewHungarianAlgorithm(c)
+		want, _ := munkres.ComputeCost(c, plain.Execute())
+		h, err := munkres.NewHungarianAlgorithm(c, munkres.WithMonotoneCosts(), munkres.WithDebugChecks())
+		if err != nil {
+			t.Fatalf("%v: %v", c, err)
+		}
+		a, err := h.Solve()
+		if err != nil {
+			t.Fatalf("%v: %v", c, err)
+		}
+		if got, err := munkres.ComputeCost(c, a); err != nil || math.Abs(got-want) > 1e-9 {
+			t.Errorf("%v: want cost %f got %f (%v)", c, want, got, err)
+		}
+	}
+}
+
+func TestWithMonotoneCostsInvalid(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for _, test := range []struct {
+		c    [][]float64
+		opts []munkres.Option
+	}{
+		{[][]float64{{1, 0}, {0, 1}}, nil},
+		{mongeMatrix(rng, 3, 3), []munkres.Option{munkres.WithRankTransform()}},
+	} {
+		opts := append(test.opts, munkres.WithMonotoneCosts())
+		if _, err := munkres.NewHungarianAlgorithm(test.c, opts...); err != munkres.ErrorInvalidOption {
+			t.Errorf("%v: want %v got %v", test.c, munkres.ErrorInvalidOption, err)
+		}
+	}
+}
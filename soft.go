@@ -0,0 +1,25 @@
+package munkres
+
+// NearestPermutation hardens a soft assignment, such as the doubly
+// stochastic output of Sinkhorn normalization in a learned matcher,
+// returning the assignment which captures the greatest total mass.
+// soft[w][j] is the mass of worker w on job j; soft need not be square,
+// in which case as many workers are assigned as there are workers or
+// jobs, whichever is fewer.
+//
+// return the assignment as for Execute, or nil if soft is irregular or
+// has an infinite or NaN entry.
+func NearestPermutation(soft [][]float64) []int {
+	costMatrix := make([][]float64, len(soft))
+	for w, row := range soft {
+		costMatrix[w] = make([]float64, len(row))
+		for j, mass := range row {
+			costMatrix[w][j] = -mass
+		}
+	}
+	h, err := NewHungarianAlgorithm(costMatrix)
+	if err != nil {
+		return nil
+	}
+	return h.Execute()
+}
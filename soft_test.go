@@ -0,0 +1,22 @@
+package munkres_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/charles-haynes/munkres"
+)
+
+func TestNearestPermutation(t *testing.T) {
+	soft := [][]float64{
+		{0.1, 0.6, 0.3},
+		{0.5, 0.4, 0.1},
+		{0.4, 0.0, 0.6},
+	}
+	if got, want := munkres.NearestPermutation(soft), []int{1, 0, 2}; !reflect.DeepEqual(got, want) {
+		t.Errorf("want %v got %v", want, got)
+	}
+	if got := munkres.NearestPermutation([][]float64{{1, 2}, {3}}); got != nil {
+		t.Errorf("want nil for an irregular matrix got %v", got)
+	}
+}
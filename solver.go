@@ -0,0 +1,66 @@
+package munkres
+
+// A ReusableSolver solves a sequence of assignment problems, such as one
+// per frame of an object tracker, reusing the memory of each solve for
+// the next, so that once it has solved a problem as large as any that
+// follow, Reset and SolveInto allocate nothing for problems which are
+// not far from square. The cost matrix is copied, as by
+// NewHungarianAlgorithm, into a single row-major slice.
+//
+// A ReusableSolver is not safe for concurrent use.
+type ReusableSolver struct {
+	opts options
+	// The arena holding the state of the algorithm, with room for that of
+	// a capacity x capacity problem.
+	arena    *Arena
+	capacity int
+	h        HungarianAlgorithm
+	err      error
+}
+
+// NewReusableSolver creates a ReusableSolver which solves with the given
+// options, loaded with the empty problem. Any WithAllocator option is
+// overridden by the solver's own arena.
+func NewReusableSolver(opts ...Option) *ReusableSolver {
+	s := &ReusableSolver{arena: NewArena(0), opts: newOptions(opts)}
+	s.opts.allocator = s.arena
+	s.opts.progress = &progress{}
+	s.Reset(nil)
+	return s
+}
+
+// Reset loads a cost matrix to be solved by SolveInto, discarding the
+// previous problem. Any assignment previously returned by the solver
+// remains valid.
+//
+// return the errors of NewHungarianAlgorithm for costMatrix, which are
+// also returned by SolveInto until the next Reset.
+func (s *ReusableSolver) Reset(costMatrix [][]float64) error {
+	dim := len(costMatrix)
+	if dim > 0 && len(costMatrix[0]) > dim {
+		dim = len(costMatrix[0])
+	}
+	if dim > s.capacity {
+		// Replace the slabs in place, as the options hold the arena.
+		*s.arena, s.capacity = *NewArena(dim), dim
+	}
+	s.arena.Reset()
+	s.h, s.err = newHungarianAlgorithmFor(costMatrix, s.opts)
+	return s.err
+}
+
+// SolveInto solves the problem loaded by Reset as Solve does, appending
+// the job of each worker to dst[:0], so that dst is reused if it has the
+// capacity.
+//
+// return the assignment, or the errors of Reset or Solve.
+func (s *ReusableSolver) SolveInto(dst []int) ([]int, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	result, err := s.h.Solve()
+	if err != nil {
+		return nil, err
+	}
+	return append(dst[:0], result...), nil
+}
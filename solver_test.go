@@ -0,0 +1,92 @@
+package munkres_test
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/charles-haynes/munkres"
+)
+
+func TestReusableSolver(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	s := munkres.NewReusableSolver()
+	var dst []int
+	for i := 0; i < 100; i++ {
+		c := randomMatrix(rng, 1+rng.Intn(8), 1+rng.Intn(8))
+		if err := s.Reset(c); err != nil {
+			t.Fatal(err)
+		}
+		a, err := s.SolveInto(dst)
+		if err != nil {
+			t.Fatal(err)
+		}
+		h, _ := munkres.NewHungarianAlgorithm(c)
+		if want := h.Execute(); !reflect.DeepEqual(a, want) {
+			t.Fatalf("%v: got %v want %v", c, a, want)
+		}
+		dst = a
+	}
+}
+
+func TestReusableSolverErrors(t *testing.T) {
+	s := munkres.NewReusableSolver()
+	if _, err := s.SolveInto(nil); err != nil {
+		t.Errorf("unloaded: got %v", err)
+	}
+	if err := s.Reset([][]float64{{1, 2}, {3}}); !errors.Is(err, munkres.ErrorIrregularCostMatrix) {
+		t.Errorf("ragged: got %v", err)
+	}
+	if _, err := s.SolveInto(nil); !errors.Is(err, munkres.ErrorIrregularCostMatrix) {
+		t.Errorf("solve after ragged: got %v", err)
+	}
+	if err := s.Reset([][]float64{{1, 2}, {2, 1}}); err != nil {
+		t.Fatal(err)
+	}
+	if a, err := s.SolveInto(nil); err != nil || !reflect.DeepEqual(a, []int{0, 1}) {
+		t.Errorf("got %v %v", a, err)
+	}
+}
+
+func TestReusableSolverAllocations(t *testing.T) {
+	rng := rand.New(rand.NewSource(2))
+	c := randomMatrix(rng, 100, 100)
+	s := munkres.NewReusableSolver()
+	dst := make([]int, 100)
+	allocs := testing.AllocsPerRun(10, func() {
+		if err := s.Reset(c); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := s.SolveInto(dst); err != nil {
+			t.Fatal(err)
+		}
+	})
+	if allocs != 0 {
+		t.Errorf("got %v allocations per solve, want 0", allocs)
+	}
+}
+
+func BenchmarkReusableSolver(b *testing.B) {
+	rng := rand.New(rand.NewSource(1))
+	for _, n := range []int{100, 500} {
+		c := randomMatrix(rng, n, n)
+		b.Run(fmt.Sprintf("new/%d", n), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				h, _ := munkres.NewHungarianAlgorithm(c)
+				h.Execute()
+			}
+		})
+		b.Run(fmt.Sprintf("reuse/%d", n), func(b *testing.B) {
+			b.ReportAllocs()
+			s := munkres.NewReusableSolver()
+			dst := make([]int, n)
+			for i := 0; i < b.N; i++ {
+				s.Reset(c)
+				dst, _ = s.SolveInto(dst)
+			}
+		})
+	}
+}
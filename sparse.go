@@ -0,0 +1,62 @@
+package munkres
+
+// An edge of a sparse assignment problem: the cost of assigning worker w
+// to job j.
+type sparseEdge struct {
+	w, j int
+	cost float64
+}
+
+// Solve an assignment problem for rows workers and cols jobs in which only
+// the pairs given by edges may be assigned, as a minimum cost flow, taking
+// time and memory proportional to the number of edges rather than to the
+// size of the cost matrix.
+//
+// return the minimum cost assignment of as many workers as there are
+// workers or jobs, whichever is fewer, or ErrorInfeasible if the edges
+// admit no such assignment; and dual prices for the workers and jobs, the
+// sum of which for a worker and a job is at most the cost of any edge
+// between them and equal to it for assigned pairs. The assignment is
+// optimal among all pairs, not just those with edges, if that is true of
+// every pair.
+func solveSparse(rows, cols int, edges []sparseEdge) ([]int, []float64, []float64, error) {
+	// Nodes are the source, the workers, the jobs and the sink, in that
+	// order.
+	source, sink := 0, rows+cols+1
+	g := newFlowNetwork(rows + cols + 2)
+	for w := 0; w < rows; w++ {
+		g.addEdge(source, 1+w, 1, 0)
+	}
+	ids := make([]int, len(edges))
+	for i, e := range edges {
+		ids[i] = g.addEdge(1+e.w, 1+rows+e.j, 1, e.cost)
+	}
+	for j := 0; j < cols; j++ {
+		g.addEdge(1+rows+j, sink, 1, 0)
+	}
+	assigned := rows
+	if cols < assigned {
+		assigned = cols
+	}
+	if flow, _ := g.minCostFlow(source, sink, float64(assigned)); flow < float64(assigned) {
+		return nil, nil, nil, ErrorInfeasible
+	}
+	result := unassigned(rows)
+	for i, e := range edges {
+		if g.flow(ids[i]) > 0 {
+			result[e.w] = e.j
+		}
+	}
+	// The node potentials keep the reduced costs of the residual edges
+	// non-negative, which makes them the negated worker prices and the
+	// job prices.
+	workerDuals := make([]float64, rows)
+	jobDuals := make([]float64, cols)
+	for w := range workerDuals {
+		workerDuals[w] = -g.potential[1+w]
+	}
+	for j := range jobDuals {
+		jobDuals[j] = g.potential[1+rows+j]
+	}
+	return result, workerDuals, jobDuals, nil
+}
@@ -0,0 +1,92 @@
+package munkres
+
+import "sort"
+
+// SolveSparsified solves the assignment problem for a dense cost matrix
+// dominated by hopeless pairs, such as a large tracking problem, by
+// pruning the cost matrix to the m cheapest jobs of each worker before
+// solving it as a sparse problem. As a safety fallback the m cheapest
+// workers of each job are kept too, and if the pruned problem admits no
+// complete assignment the full problem is solved instead.
+//
+// If repair is true, the result is verified to be optimal for the full
+// problem by checking that no pruned pair has a negative reduced cost
+// with respect to the dual prices of the pruned problem. Any which do are
+// restored and the problem solved again, until the result is optimal.
+// Otherwise the result is optimal among the pairs kept, which is usually,
+// but not always, optimal overall.
+//
+// costMatrix is subject to the same restrictions as for
+// NewHungarianAlgorithm.
+func SolveSparsified(costMatrix [][]float64, m int, repair bool) ([]int, error) {
+	if err := checkCostMatrix(costMatrix, false); err != nil {
+		return nil, err
+	}
+	rows, cols := len(costMatrix), 0
+	if rows > 0 {
+		cols = len(costMatrix[0])
+	}
+	kept := make([][]bool, rows)
+	for w := range kept {
+		kept[w] = make([]bool, cols)
+	}
+	var edges []sparseEdge
+	keep := func(w, j int) {
+		if !kept[w][j] {
+			kept[w][j] = true
+			edges = append(edges, sparseEdge{w: w, j: j, cost: costMatrix[w][j]})
+		}
+	}
+	for w := 0; w < rows; w++ {
+		for _, j := range cheapest(cols, m, func(j int) float64 { return costMatrix[w][j] }) {
+			keep(w, j)
+		}
+	}
+	for j := 0; j < cols; j++ {
+		for _, w := range cheapest(rows, m, func(w int) float64 { return costMatrix[w][j] }) {
+			keep(w, j)
+		}
+	}
+
+	for {
+		result, workerDuals, jobDuals, err := solveSparse(rows, cols, edges)
+		if err == ErrorInfeasible {
+			h, err := NewHungarianAlgorithm(costMatrix)
+			if err != nil {
+				return nil, err
+			}
+			return h.Execute(), nil
+		}
+		if err != nil || !repair {
+			return result, err
+		}
+		violated := false
+		for w := range costMatrix {
+			for j, c := range costMatrix[w] {
+				if !kept[w][j] && c-workerDuals[w]-jobDuals[j] < -pricingTolerance {
+					keep(w, j)
+					violated = true
+				}
+			}
+		}
+		if !violated {
+			return result, nil
+		}
+	}
+}
+
+// return the indices of the m least values among n, where value(i) is the
+// i'th value.
+func cheapest(n, m int, value func(i int) float64) []int {
+	index := make([]int, n)
+	for i := range index {
+		index[i] = i
+	}
+	sort.Slice(index, func(a, b int) bool {
+		return value(index[a]) < value(index[b])
+	})
+	if m < n {
+		index = index[:m]
+	}
+	return index
+}
@@ -0,0 +1,46 @@
+package munkres_test
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/charles-haynes/munkres"
+)
+
+func TestSolveSparsified(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 100; i++ {
+		rows, cols := 1+rng.Intn(20), 1+rng.Intn(20)
+		c := randomMatrix(rng, rows, cols)
+		h, _ := munkres.NewHungarianAlgorithm(c)
+		want, _ := munkres.ComputeCost(c, h.Execute())
+		for _, m := range []int{1, 2, 5} {
+			res, err := munkres.SolveSparsified(c, m, true)
+			if err != nil {
+				t.Fatal(err)
+			}
+			cost, err := munkres.ComputeCost(c, res)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if math.Abs(cost-want) > 1e-9 {
+				t.Errorf("%v, m = %d: want cost = %f got %f",
+					c, m, want, cost)
+			}
+
+			res, err = munkres.SolveSparsified(c, m, false)
+			if err != nil {
+				t.Fatal(err)
+			}
+			cost, err = munkres.ComputeCost(c, res)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if cost < want-1e-9 {
+				t.Errorf("%v, m = %d: cost %f below optimum %f",
+					c, m, cost, want)
+			}
+		}
+	}
+}
@@ -0,0 +1,46 @@
+package munkres
+
+// SolveSticky re-optimizes an assignment whose costs have changed while
+// limiting churn: it minimizes the total cost plus the switching penalty
+// switchCost[w][j] for each worker w moved from its current job to a
+// different job j, so that the current assignment is only reshuffled
+// where the gain outweighs the disruption.
+//
+// current gives the job currently assigned to each worker, or -1 if it is
+// unassigned, in which case assigning it any job incurs no penalty.
+// switchCost must have the same dimensions as costMatrix and must not be
+// negative. The options are those of NewHungarianAlgorithm.
+//
+// return the new assignment, as for Execute.
+func SolveSticky(costMatrix [][]float64, current []int, switchCost [][]float64, opts ...Option) (Assignment, error) {
+	if err := checkCostMatrix(costMatrix, false); err != nil {
+		return nil, err
+	}
+	if err := checkCostMatrix(switchCost, false); err != nil {
+		return nil, err
+	}
+	if len(current) != len(costMatrix) || len(switchCost) != len(costMatrix) {
+		return nil, ErrorDimensionMismatch
+	}
+	penalized := make([][]float64, len(costMatrix))
+	for w, row := range costMatrix {
+		if len(switchCost[w]) != len(row) || current[w] < -1 || current[w] >= len(row) {
+			return nil, ErrorDimensionMismatch
+		}
+		penalized[w] = make([]float64, len(row))
+		for j, c := range row {
+			if switchCost[w][j] < 0 {
+				return nil, ErrorNegativeQuantity
+			}
+			penalized[w][j] = c
+			if current[w] != -1 && current[w] != j {
+				penalized[w][j] += switchCost[w][j]
+			}
+		}
+	}
+	h, err := NewHungarianAlgorithm(penalized, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return h.Execute(), nil
+}
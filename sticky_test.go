@@ -0,0 +1,69 @@
+package munkres_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/charles-haynes/munkres"
+)
+
+// return a switching cost matrix with every entry equal to penalty.
+func uniformPenalty(rows, cols int, penalty float64) [][]float64 {
+	m := make([][]float64, rows)
+	for w := range m {
+		m[w] = make([]float64, cols)
+		for j := range m[w] {
+			m[w][j] = penalty
+		}
+	}
+	return m
+}
+
+func TestSolveSticky(t *testing.T) {
+	c := [][]float64{
+		{2, 1, 5},
+		{1, 2, 5},
+		{5, 5, 1},
+	}
+	current := []int{0, 1, 2}
+	// The swap saves 2, which does not pay for two moves at 2 each...
+	got, err := munkres.SolveSticky(c, current, uniformPenalty(3, 3, 2))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (munkres.Assignment{0, 1, 2}); !reflect.DeepEqual(got, want) {
+		t.Errorf("want %v got %v", want, got)
+	}
+	// ...but does at 0.5 each.
+	got, err = munkres.SolveSticky(c, current, uniformPenalty(3, 3, 0.5))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (munkres.Assignment{1, 0, 2}); !reflect.DeepEqual(got, want) {
+		t.Errorf("want %v got %v", want, got)
+	}
+	// Unassigned workers move freely.
+	got, err = munkres.SolveSticky(c, []int{-1, -1, 2}, uniformPenalty(3, 3, 2))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (munkres.Assignment{1, 0, 2}); !reflect.DeepEqual(got, want) {
+		t.Errorf("want %v got %v", want, got)
+	}
+}
+
+func TestSolveStickyErrors(t *testing.T) {
+	c := [][]float64{{1, 2}, {2, 1}}
+	if _, err := munkres.SolveSticky(c, []int{0}, uniformPenalty(2, 2, 1)); err != munkres.ErrorDimensionMismatch {
+		t.Errorf("want err = %s got %v", munkres.ErrorDimensionMismatch, err)
+	}
+	if _, err := munkres.SolveSticky(c, []int{0, 2}, uniformPenalty(2, 2, 1)); err != munkres.ErrorDimensionMismatch {
+		t.Errorf("want err = %s got %v", munkres.ErrorDimensionMismatch, err)
+	}
+	if _, err := munkres.SolveSticky(c, []int{0, 1}, uniformPenalty(2, 3, 1)); err != munkres.ErrorDimensionMismatch {
+		t.Errorf("want err = %s got %v", munkres.ErrorDimensionMismatch, err)
+	}
+	if _, err := munkres.SolveSticky(c, []int{0, 1}, uniformPenalty(2, 2, -1)); err != munkres.ErrorNegativeQuantity {
+		t.Errorf("want err = %s got %v", munkres.ErrorNegativeQuantity, err)
+	}
+}
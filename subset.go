@@ -0,0 +1,93 @@
+package munkres
+
+import "math"
+
+// SolveSubset solves the assignment problem for the submatrix of
+// costMatrix with the given rows and columns, without copying it, so that
+// interactive tools can solve filtered views of a large matrix again and
+// again. Only the selected costs are read, one row or column of the
+// submatrix at a time, by the shortest augmenting path form of the
+// Hungarian algorithm.
+//
+// The selected rows of costMatrix must have the same length, and the
+// selected costs are subject to the same restrictions as for
+// NewHungarianAlgorithm. The indices must be rows and columns of
+// costMatrix, with none repeated, or ErrorInvalidOption is returned.
+//
+// return the column of costMatrix assigned to each selected row, in the
+// order of rows, or -1 if it is unassigned.
+func SolveSubset(costMatrix [][]float64, rows, cols []int) ([]int, error) {
+	if !distinct(rows, len(costMatrix)) {
+		return nil, ErrorInvalidOption
+	}
+	n := 0
+	if len(rows) > 0 {
+		n = len(costMatrix[rows[0]])
+	} else if len(costMatrix) > 0 {
+		n = len(costMatrix[0])
+	}
+	for _, w := range rows {
+		if len(costMatrix[w]) != n {
+			return nil, &ShapeError{Row: w, Cols: len(costMatrix[w]), Want: n}
+		}
+	}
+	if !distinct(cols, n) {
+		return nil, ErrorInvalidOption
+	}
+	for _, w := range rows {
+		for _, j := range cols {
+			if err := checkCost(w, j, costMatrix[w][j], false); err != nil {
+				return nil, err
+			}
+		}
+	}
+	a := unassigned(len(rows))
+	if len(rows) == 0 || len(cols) == 0 {
+		return a, nil
+	}
+	if len(rows) <= len(cols) {
+		row := make([]float64, len(cols))
+		_, _, jobByWorker, err := augmentRows(len(rows), len(cols), math.Inf(1),
+			func(w int) ([]float64, error) {
+				for j, col := range cols {
+					row[j] = costMatrix[rows[w]][col]
+				}
+				return row, nil
+			})
+		if err != nil {
+			return nil, err
+		}
+		for w, j := range jobByWorker {
+			a[w] = cols[j]
+		}
+		return a, nil
+	}
+	// Solve the transposed problem, reading each column as a row.
+	column := make([]float64, len(rows))
+	_, _, workerByJob, err := augmentRows(len(cols), len(rows), math.Inf(1),
+		func(j int) ([]float64, error) {
+			for w, row := range rows {
+				column[w] = costMatrix[row][cols[j]]
+			}
+			return column, nil
+		})
+	if err != nil {
+		return nil, err
+	}
+	for j, w := range workerByJob {
+		a[w] = cols[j]
+	}
+	return a, nil
+}
+
+// Check that the indices are in [0, n) with none repeated.
+func distinct(indices []int, n int) bool {
+	seen := make(map[int]bool, len(indices))
+	for _, i := range indices {
+		if i < 0 || i >= n || seen[i] {
+			return false
+		}
+		seen[i] = true
+	}
+	return true
+}
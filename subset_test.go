@@ -0,0 +1,69 @@
+package munkres_test
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/charles-haynes/munkres"
+	"github.com/charles-haynes/munkres/matrixutil"
+)
+
+// return a random selection of distinct indices in [0, n).
+func randomIndices(rng *rand.Rand, n int) []int {
+	return rng.Perm(n)[:rng.Intn(n+1)]
+}
+
+func TestSolveSubset(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 100; i++ {
+		c := randomMatrix(rng, 1+rng.Intn(10), 1+rng.Intn(10))
+		rows, cols := randomIndices(rng, len(c)), randomIndices(rng, len(c[0]))
+		a, err := munkres.SolveSubset(c, rows, cols)
+		if err != nil {
+			t.Fatal(err)
+		}
+		sub, _ := matrixutil.Select(c, rows, cols)
+		h, _ := munkres.NewHungarianAlgorithm(sub)
+		want, _ := munkres.ComputeCost(sub, h.Execute())
+		if len(a) != len(rows) {
+			t.Fatalf("want %d rows got %v", len(rows), a)
+		}
+		got, assigned, used := 0.0, 0, map[int]bool{}
+		for i, j := range a {
+			if j == -1 {
+				continue
+			}
+			if used[j] {
+				t.Fatalf("column %d assigned twice in %v", j, a)
+			}
+			used[j] = true
+			got += c[rows[i]][j]
+			assigned++
+		}
+		if math.Abs(got-want) > 1e-9 || len(sub) > 0 && assigned != count(h.Execute()) {
+			t.Errorf("%v %v: want cost %f got %f from %v", rows, cols, want, got, a)
+		}
+	}
+}
+
+func TestSolveSubsetErrors(t *testing.T) {
+	c := [][]float64{{1, 2, 3}, {4, math.NaN(), 6}, {7, 8}}
+	for _, test := range []struct {
+		rows, cols []int
+		err        error
+	}{
+		{[]int{0, 3}, nil, munkres.ErrorInvalidOption},
+		{[]int{0, 0}, nil, munkres.ErrorInvalidOption},
+		{[]int{0}, []int{-1}, munkres.ErrorInvalidOption},
+		{[]int{0}, []int{2, 2}, munkres.ErrorInvalidOption},
+		{[]int{0, 2}, []int{0}, munkres.ErrorIrregularCostMatrix},
+		{[]int{1}, []int{1}, munkres.ErrorNaNCost},
+		{[]int{1}, []int{0, 2}, nil},
+	} {
+		if _, err := munkres.SolveSubset(c, test.rows, test.cols); !errors.Is(err, test.err) {
+			t.Errorf("%v %v: want %v got %v", test.rows, test.cols, test.err, err)
+		}
+	}
+}
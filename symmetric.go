@@ -0,0 +1,49 @@
+package munkres
+
+import "math"
+
+// SolveSymmetric pairs the members of a set matched against itself, such
+// as roommates or exchange partners, at minimum total cost, where
+// costMatrix[u][v] holds the cost of pairing u with v, or +Inf if they
+// may not be paired. It must be square and symmetric, and its diagonal is
+// ignored: no member is paired with itself.
+//
+// The members are first covered by cycles at minimum cost by the
+// Hungarian algorithm, reading only the upper triangle, with the diagonal
+// forbidden without the caller marking it. When every cycle is a pair, as
+// is common, that cover is the best pairing; otherwise the pairing is
+// found by MinWeightPerfectMatching.
+//
+// It returns the member paired with each member, so that each pair appears
+// twice. ErrorInfeasible is returned if there is no pairing of every
+// member, as when there are an odd number of members.
+func SolveSymmetric(costMatrix [][]float64) (Assignment, error) {
+	if err := checkGraph(costMatrix); err != nil {
+		return nil, err
+	}
+	n := len(costMatrix)
+	if n%2 != 0 {
+		return nil, ErrorInfeasible
+	}
+	h := newHungarianAlgorithm(n, n, newOptions([]Option{allowForbidden()}))
+	for u := 0; u < n; u++ {
+		h.costs[u*h.dim+u] = math.Inf(1)
+		for v := u + 1; v < n; v++ {
+			h.costs[u*h.dim+v] = costMatrix[u][v]
+			h.costs[v*h.dim+u] = costMatrix[u][v]
+		}
+	}
+	// A pairing is a cover by cycles of two, so a cover costing least
+	// which pairs every member is the best pairing, and without any
+	// cover there is no pairing.
+	cover, err := h.execute()
+	if err != nil {
+		return nil, err
+	}
+	for u, v := range cover {
+		if cover[v] != u {
+			return MinWeightPerfectMatching(costMatrix)
+		}
+	}
+	return cover, nil
+}
@@ -0,0 +1,113 @@
+package munkres_test
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/charles-haynes/munkres"
+)
+
+// bruteForcePairing returns the least cost of pairing the members of c,
+// by trying every partner for the lowest unpaired member.
+func bruteForcePairing(c [][]float64, paired []bool) float64 {
+	u := 0
+	for u < len(c) && paired[u] {
+		u++
+	}
+	if u == len(c) {
+		return 0
+	}
+	best := math.Inf(1)
+	paired[u] = true
+	for v := u + 1; v < len(c); v++ {
+		if !paired[v] {
+			paired[v] = true
+			best = math.Min(best, 2*c[u][v]+bruteForcePairing(c, paired))
+			paired[v] = false
+		}
+	}
+	paired[u] = false
+	return best
+}
+
+func TestSolveSymmetric(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 200; i++ {
+		n := 1 + rng.Intn(8)
+		c := randomMatrix(rng, n, n)
+		for u := range c {
+			// The diagonal is ignored, however cheap.
+			c[u][u] = -1
+			for v := 0; v < u; v++ {
+				c[u][v] = c[v][u]
+			}
+		}
+		a, err := munkres.SolveSymmetric(c)
+		if n%2 != 0 {
+			if err != munkres.ErrorInfeasible {
+				t.Errorf("%v: want %v got %v", c, munkres.ErrorInfeasible, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		for u, v := range a {
+			if u == v || a[v] != u {
+				t.Fatalf("%v: %d is not paired in %v", c, u, a)
+			}
+		}
+		want := bruteForcePairing(c, make([]bool, n))
+		if got := a.Cost(c); math.Abs(got-want) > 1e-9 {
+			t.Errorf("%v: want cost %f got %f", c, want, got)
+		}
+	}
+}
+
+func TestSolveSymmetricOddCycles(t *testing.T) {
+	// The cheapest cover is the triangles 0-1-2 and 3-4-5, but the
+	// members can only be paired across them.
+	c := [][]float64{
+		{0, 1, 1, 9, 8, 9},
+		{1, 0, 1, 9, 9, 8},
+		{1, 1, 0, 8, 9, 9},
+		{9, 9, 8, 0, 1, 1},
+		{8, 9, 9, 1, 0, 1},
+		{9, 8, 9, 1, 1, 0},
+	}
+	a, err := munkres.SolveSymmetric(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want, got := bruteForcePairing(c, make([]bool, len(c))), a.Cost(c); got != want {
+		t.Errorf("want cost %f got %f in %v", want, got, a)
+	}
+}
+
+func TestSolveSymmetricInvalid(t *testing.T) {
+	inf := math.Inf(1)
+	for _, test := range []struct {
+		c   [][]float64
+		err error
+	}{
+		{[][]float64{{0}}, munkres.ErrorInfeasible},
+		{[][]float64{{0, inf, 1}, {inf, 0, inf}, {1, inf, 0}}, munkres.ErrorInfeasible},
+		{[][]float64{{0, 1, 1, inf}, {1, 0, 1, inf}, {1, 1, 0, inf}, {inf, inf, inf, 0}}, munkres.ErrorInfeasible},
+		// Two triangles can be covered by cycles, but not paired.
+		{[][]float64{
+			{0, 1, 1, inf, inf, inf},
+			{1, 0, 1, inf, inf, inf},
+			{1, 1, 0, inf, inf, inf},
+			{inf, inf, inf, 0, 1, 1},
+			{inf, inf, inf, 1, 0, 1},
+			{inf, inf, inf, 1, 1, 0},
+		}, munkres.ErrorInfeasible},
+		{[][]float64{{0, 1}, {2, 0}}, munkres.ErrorAsymmetricCostMatrix},
+		{[][]float64{{0, 1}}, munkres.ErrorDimensionMismatch},
+	} {
+		if _, err := munkres.SolveSymmetric(test.c); err != test.err {
+			t.Errorf("%v: want %v got %v", test.c, test.err, err)
+		}
+	}
+}
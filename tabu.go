@@ -0,0 +1,94 @@
+package munkres
+
+import "math"
+
+// A TabuProblem describes a variant of the assignment problem and the
+// parameters of the search for ImproveTabu.
+type TabuProblem struct {
+	LocalSearchProblem
+	// Tenure is the number of iterations for which a worker may not
+	// return to a job it has left, which must not be negative.
+	Tenure int
+	// Iterations is the number of moves made, which must not be negative.
+	Iterations int
+}
+
+// Check that the problem is valid.
+func (p *TabuProblem) valid() bool {
+	return p.LocalSearchProblem.valid() && p.Tenure >= 0 && p.Iterations >= 0
+}
+
+// ImproveTabu improves an assignment for a variant of the assignment
+// problem whose side constraints break the structure that the Hungarian
+// algorithm relies on, by tabu search. Any workers it leaves unassigned
+// which could be are first given the cheapest jobs left. Each iteration
+// then makes the best move, on the objective plus the penalized violation
+// of the constraints, even if that is worse, among those which swap the
+// jobs of two workers or shift a worker to a job no worker has, or give
+// its job to an unassigned worker. To escape local minima a worker may
+// not return to a job it has left for the tenure of the problem, unless
+// that would give the best assignment found which satisfies every
+// constraint. The assignment given is not modified.
+//
+// costMatrix is subject to the same restrictions as for
+// NewHungarianAlgorithm, the assignment must be valid for it, as for
+// ComputeCost, and the problem must be valid, or ErrorInvalidOption is
+// returned.
+//
+// return the assignment of least objective value found which satisfies
+// every constraint, which assigns as many workers as Execute would, and
+// its value; or ErrorInfeasible if none was found.
+func ImproveTabu(costMatrix [][]float64, assignment []int, problem TabuProblem) (Assignment, float64, error) {
+	if err := checkCostMatrix(costMatrix, false); err != nil {
+		return nil, 0, err
+	}
+	rows, cols := len(costMatrix), 0
+	if rows > 0 {
+		cols = len(costMatrix[0])
+	}
+	if err := Assignment(assignment).check(rows, cols); err != nil {
+		return nil, 0, err
+	}
+	if !problem.valid() {
+		return nil, 0, ErrorInvalidOption
+	}
+	s := newLocalSearch(costMatrix, assignment, &problem.LocalSearchProblem)
+	dim := len(s.jobByWorker)
+	// The iteration until which each padded worker may not take each job.
+	tabuUntil := make([]int, dim*dim)
+	tabu := func(iteration, w, j int) bool {
+		return tabuUntil[w*dim+j] > iteration
+	}
+	for iteration := 0; iteration < problem.Iterations; iteration++ {
+		bestX, bestY := -1, -1
+		var bestValue, bestViolation float64
+		bestEnergy := math.Inf(1)
+		for x := 0; x < dim; x++ {
+			for y := x + 1; y < dim; y++ {
+				if !s.moves(x, y) {
+					continue
+				}
+				s.exchange(x, y)
+				value, violation := s.evaluate()
+				s.exchange(x, y)
+				e := s.energy(value, violation)
+				aspires := violation == 0 && value < s.bestValue
+				if e < bestEnergy && (aspires ||
+					!tabu(iteration, x, s.jobByWorker[y]) &&
+						!tabu(iteration, y, s.jobByWorker[x])) {
+					bestX, bestY, bestEnergy = x, y, e
+					bestValue, bestViolation = value, violation
+				}
+			}
+		}
+		if bestX == -1 {
+			break
+		}
+		tabuUntil[bestX*dim+s.jobByWorker[bestX]] = iteration + 1 + problem.Tenure
+		tabuUntil[bestY*dim+s.jobByWorker[bestY]] = iteration + 1 + problem.Tenure
+		s.exchange(bestX, bestY)
+		s.value, s.violation = bestValue, bestViolation
+		s.record()
+	}
+	return s.result()
+}
@@ -0,0 +1,85 @@
+package munkres_test
+
+import (
+	"errors"
+	"math/rand"
+	"testing"
+
+	"github.com/charles-haynes/munkres"
+)
+
+func TestImproveTabu(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 50; i++ {
+		c := randomMatrix(rng, 1+rng.Intn(5), 1+rng.Intn(5))
+		cols := len(c[0])
+		classOf := make([]int, cols)
+		for j := range classOf {
+			classOf[j] = rng.Intn(3) - 1
+		}
+		minimum := []int{rng.Intn(2), rng.Intn(2)}
+		want, feasible := bruteForceCoverage(c, classOf, minimum)
+		start, _, _ := munkres.SolveGreedy(c)
+		a, got, err := munkres.ImproveTabu(c, start, munkres.TabuProblem{
+			LocalSearchProblem: munkres.LocalSearchProblem{
+				Constraints: []func(munkres.Assignment) float64{
+					coverageConstraint(classOf, minimum),
+				},
+				Penalty: 10,
+			},
+			Tenure:     3,
+			Iterations: 50,
+		})
+		if !feasible {
+			if !errors.Is(err, munkres.ErrorInfeasible) {
+				t.Errorf("%v %v %v: want %v got %v", c, classOf, minimum,
+					munkres.ErrorInfeasible, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%v %v %v: %v", c, classOf, minimum, err)
+		}
+		cost, _ := munkres.ComputeCost(c, a)
+		if got != want || cost != got || count(a) != count(start) {
+			t.Errorf("%v %v %v: want %f got %v cost %f", c, classOf, minimum,
+				want, a, got)
+		}
+	}
+}
+
+func TestImproveTabuObjective(t *testing.T) {
+	c := [][]float64{{0, 5}, {1, 9}}
+	start := []int{0, 1}
+	a, got, err := munkres.ImproveTabu(c, start, munkres.TabuProblem{
+		LocalSearchProblem: munkres.LocalSearchProblem{
+			Objective: greatestCost(c),
+			Penalty:   1,
+		},
+		Iterations: 10,
+	})
+	if err != nil || got != 5 || a[0] != 1 || a[1] != 0 {
+		t.Errorf("want [1 0] 5 got %v %f, %v", a, got, err)
+	}
+	if start[0] != 0 {
+		t.Errorf("start modified to %v", start)
+	}
+}
+
+func TestImproveTabuErrors(t *testing.T) {
+	c := [][]float64{{1, 2}, {2, 10}}
+	valid := munkres.LocalSearchProblem{Penalty: 1}
+	for _, p := range []munkres.TabuProblem{
+		{},
+		{LocalSearchProblem: valid, Tenure: -1},
+		{LocalSearchProblem: valid, Iterations: -1},
+	} {
+		if _, _, err := munkres.ImproveTabu(c, []int{0, 1}, p); err != munkres.ErrorInvalidOption {
+			t.Errorf("%+v: want %v got %v", p, munkres.ErrorInvalidOption, err)
+		}
+	}
+	p := munkres.TabuProblem{LocalSearchProblem: valid}
+	if _, _, err := munkres.ImproveTabu(c, []int{1, 1}, p); !errors.Is(err, munkres.ErrorDuplicateJob) {
+		t.Errorf("want %v got %v", munkres.ErrorDuplicateJob, err)
+	}
+}
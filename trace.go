@@ -0,0 +1,163 @@
+package munkres
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash/fnv"
+	"math"
+)
+
+// A Trace records every decision of a solve, so that a solve which gives
+// different results on different machines can be replayed with Replay to
+// find where it first diverges. It is written and read as compact JSON
+// with encoding/json.
+type Trace struct {
+	Rows int `json:"rows"`
+	Cols int `json:"cols"`
+	// Checksum identifies the cost matrix solved, so that a replay of a
+	// different matrix is not mistaken for a divergent solve.
+	Checksum uint64       `json:"checksum"`
+	Events   []TraceEvent `json:"events"`
+}
+
+// A TraceEvent is a change to the matching or labels of a solve, as
+// reported to a Subscriber. Each augmenting path is recorded as the
+// matches it breaks and makes.
+type TraceEvent struct {
+	// Kind is "m" for a match made, "b" for a match broken, or "l" for
+	// a label update.
+	Kind   string  `json:"k"`
+	Worker int     `json:"w,omitempty"`
+	Job    int     `json:"j,omitempty"`
+	Delta  float64 `json:"d,omitempty"`
+}
+
+// A Recorder is a Subscriber which records a Trace of a solve. Pass it to
+// WithSubscriber.
+type Recorder struct {
+	trace Trace
+}
+
+// NewRecorder creates a recorder for a solve of the cost matrix.
+func NewRecorder(costMatrix [][]float64) *Recorder {
+	rows, cols := len(costMatrix), 0
+	if rows > 0 {
+		cols = len(costMatrix[0])
+	}
+	return &Recorder{trace: Trace{
+		Rows:     rows,
+		Cols:     cols,
+		Checksum: checksum(costMatrix),
+		Events:   []TraceEvent{},
+	}}
+}
+
+// return a checksum of the dimensions and costs of the cost matrix.
+func checksum(costMatrix [][]float64) uint64 {
+	f := fnv.New64a()
+	var b [8]byte
+	binary.LittleEndian.PutUint64(b[:], uint64(len(costMatrix)))
+	f.Write(b[:])
+	for _, row := range costMatrix {
+		binary.LittleEndian.PutUint64(b[:], uint64(len(row)))
+		f.Write(b[:])
+		for _, c := range row {
+			binary.LittleEndian.PutUint64(b[:], math.Float64bits(c))
+			f.Write(b[:])
+		}
+	}
+	return f.Sum64()
+}
+
+// Trace returns the trace recorded so far.
+func (r *Recorder) Trace() Trace {
+	t := r.trace
+	t.Events = append([]TraceEvent{}, t.Events...)
+	return t
+}
+
+func (r *Recorder) MatchMade(w, j int) {
+	r.trace.Events = append(r.trace.Events, TraceEvent{Kind: "m", Worker: w, Job: j})
+}
+
+func (r *Recorder) MatchBroken(w, j int) {
+	r.trace.Events = append(r.trace.Events, TraceEvent{Kind: "b", Worker: w, Job: j})
+}
+
+func (r *Recorder) LabelsUpdated(delta float64) {
+	r.trace.Events = append(r.trace.Events, TraceEvent{Kind: "l", Delta: delta})
+}
+
+// A ReplayError is returned by Replay when a solve does not repeat the
+// trace it is replayed against.
+type ReplayError struct {
+	// Event is the index of the first event which differs, or -1 if the
+	// cost matrix is not the one traced.
+	Event int
+	// Want and Got are the traced and replayed events, either of which
+	// is nil if its trace ended first.
+	Want, Got *TraceEvent
+}
+
+func (e *ReplayError) Error() string {
+	if e.Event == -1 {
+		return fmt.Sprintf("%v: cost matrix differs from the one traced", ErrorReplayMismatch)
+	}
+	return fmt.Sprintf("%v at event %d: want %s got %s",
+		ErrorReplayMismatch, e.Event, formatEvent(e.Want), formatEvent(e.Got))
+}
+
+// Unwrap returns ErrorReplayMismatch.
+func (e *ReplayError) Unwrap() error {
+	return ErrorReplayMismatch
+}
+
+func formatEvent(e *TraceEvent) string {
+	if e == nil {
+		return "end of trace"
+	}
+	switch e.Kind {
+	case "m":
+		return fmt.Sprintf("match (%d, %d)", e.Worker, e.Job)
+	case "b":
+		return fmt.Sprintf("break (%d, %d)", e.Worker, e.Job)
+	}
+	return fmt.Sprintf("label update by %v", e.Delta)
+}
+
+// Replay solves the cost matrix again with the given options, which
+// should be those of the traced solve, and checks that it makes exactly
+// the decisions of the trace, with label updates equal to the last bit.
+// Every label update is positive, so comparing them as numbers compares
+// their bits.
+//
+// return a *ReplayError describing the first difference, or the error
+// from NewHungarianAlgorithm or Solve.
+func Replay(costMatrix [][]float64, trace Trace, opts ...Option) error {
+	r := NewRecorder(costMatrix)
+	if r.trace.Checksum != trace.Checksum || r.trace.Rows != trace.Rows ||
+		r.trace.Cols != trace.Cols {
+		return &ReplayError{Event: -1}
+	}
+	h, err := NewHungarianAlgorithm(costMatrix, append(opts, WithSubscriber(r))...)
+	if err != nil {
+		return err
+	}
+	if _, err := h.Solve(); err != nil {
+		return err
+	}
+	want, got := trace.Events, r.trace.Events
+	for i := 0; i < len(want) || i < len(got); i++ {
+		e := &ReplayError{Event: i}
+		if i < len(want) {
+			e.Want = &want[i]
+		}
+		if i < len(got) {
+			e.Got = &got[i]
+		}
+		if e.Want == nil || e.Got == nil || *e.Want != *e.Got {
+			return e
+		}
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+package munkres_test
+
+import (
+	"encoding/json"
+	"errors"
+	"math/rand"
+	"testing"
+
+	"github.com/charles-haynes/munkres"
+)
+
+// return the trace of solving c, after writing and reading it as JSON.
+func recordTrace(t *testing.T, c [][]float64) munkres.Trace {
+	t.Helper()
+	r := munkres.NewRecorder(c)
+	h, _ := munkres.NewHungarianAlgorithm(c, munkres.WithSubscriber(r))
+	h.Execute()
+	b, err := json.Marshal(r.Trace())
+	if err != nil {
+		t.Fatal(err)
+	}
+	var trace munkres.Trace
+	if err := json.Unmarshal(b, &trace); err != nil {
+		t.Fatal(err)
+	}
+	return trace
+}
+
+func TestReplay(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 20; i++ {
+		c := randomMatrix(rng, 1+rng.Intn(10), 1+rng.Intn(10))
+		for w := range c {
+			for j := range c[w] {
+				c[w][j] += rng.Float64()
+			}
+		}
+		if err := munkres.Replay(c, recordTrace(t, c)); err != nil {
+			t.Errorf("%v: %v", c, err)
+		}
+	}
+}
+
+func TestReplayMismatch(t *testing.T) {
+	c := [][]float64{{1, 2, 3}, {2, 4, 6}, {3, 6, 9.5}}
+	trace := recordTrace(t, c)
+	var e *munkres.ReplayError
+
+	changed := [][]float64{{1, 2, 3}, {2, 4, 6}, {3, 6, 9}}
+	err := munkres.Replay(changed, trace)
+	if !errors.As(err, &e) || e.Event != -1 || !errors.Is(err, munkres.ErrorReplayMismatch) {
+		t.Errorf("want mismatched matrix got %v", err)
+	}
+
+	tampered := trace
+	tampered.Events = append([]munkres.TraceEvent(nil), trace.Events...)
+	last := len(tampered.Events) - 1
+	tampered.Events[last].Job = 99
+	if err := munkres.Replay(c, tampered); !errors.As(err, &e) || e.Event != last ||
+		e.Want.Job != 99 || e.Got.Job == 99 {
+		t.Errorf("want mismatch at %d got %v", last, err)
+	}
+
+	truncated := trace
+	truncated.Events = trace.Events[:last]
+	if err := munkres.Replay(c, truncated); !errors.As(err, &e) || e.Event != last ||
+		e.Want != nil || e.Got == nil {
+		t.Errorf("want extra event at %d got %v", last, err)
+	}
+}
@@ -0,0 +1,80 @@
+package munkres
+
+import "math"
+
+// SolveTransport solves the discrete optimal transport problem between
+// sources with masses supply and sinks with masses demand, which may be
+// any non-negative numbers, generalising SolveCapacitated. When the total
+// masses differ, as much mass as possible is moved: all of the lesser
+// total, leaving the excess of the other side in place.
+//
+// costMatrix[i][j] holds the cost of moving each unit of mass from source
+// i to sink j, and is subject to the same restrictions as for
+// NewHungarianAlgorithm. The problem is solved as a minimum cost flow.
+//
+// return the transport plan, where plan[i][j] holds the mass moved from
+// source i to sink j, and its total cost.
+func SolveTransport(costMatrix [][]float64, supply, demand []float64) ([][]float64, float64, error) {
+	if err := checkCostMatrix(costMatrix, false); err != nil {
+		return nil, 0, err
+	}
+	rows, cols := len(costMatrix), 0
+	if rows > 0 {
+		cols = len(costMatrix[0])
+	}
+	if len(supply) != rows || len(demand) != cols {
+		return nil, 0, ErrorDimensionMismatch
+	}
+	totalSupply, err := sumMass(supply)
+	if err != nil {
+		return nil, 0, err
+	}
+	totalDemand, err := sumMass(demand)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Nodes are the source, the sources, the sinks and the sink, in
+	// that order.
+	source, sink := 0, rows+cols+1
+	g := newFlowNetwork(rows + cols + 2)
+	for i, a := range supply {
+		g.addEdge(source, 1+i, a, 0)
+	}
+	edges := make([][]int, rows)
+	for i := range costMatrix {
+		edges[i] = make([]int, cols)
+		for j, c := range costMatrix[i] {
+			edges[i][j] = g.addEdge(1+i, 1+rows+j, math.Min(supply[i], demand[j]), c)
+		}
+	}
+	for j, b := range demand {
+		g.addEdge(1+rows+j, sink, b, 0)
+	}
+	_, cost := g.minCostFlow(source, sink, math.Min(totalSupply, totalDemand))
+
+	plan := make([][]float64, rows)
+	for i := range edges {
+		plan[i] = make([]float64, cols)
+		for j, e := range edges[i] {
+			plan[i][j] = g.flow(e)
+		}
+	}
+	return plan, cost, nil
+}
+
+// return the total of the masses, which must be finite non-negative
+// numbers.
+func sumMass(masses []float64) (float64, error) {
+	if err := checkRow(0, masses, false); err != nil {
+		return 0, err
+	}
+	total := 0.0
+	for _, m := range masses {
+		if m < 0 {
+			return 0, ErrorNegativeQuantity
+		}
+		total += m
+	}
+	return total, nil
+}
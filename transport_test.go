@@ -0,0 +1,52 @@
+package munkres_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/charles-haynes/munkres"
+)
+
+func TestSolveTransport(t *testing.T) {
+	c := [][]float64{
+		{1, 3},
+		{2, 1},
+	}
+	// Source 0 must send half of its mass to the dearer sink.
+	plan, cost, err := munkres.SolveTransport(c, []float64{1.5, 0.5}, []float64{0.75, 1.25})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := [][]float64{{0.75, 0.75}, {0, 0.5}}
+	for i := range want {
+		for j := range want[i] {
+			if math.Abs(plan[i][j]-want[i][j]) > 1e-12 {
+				t.Errorf("want plan %v got %v", want, plan)
+			}
+		}
+	}
+	if math.Abs(cost-3.5) > 1e-12 {
+		t.Errorf("want cost = 3.5 got %f", cost)
+	}
+}
+
+func TestSolveTransportUnbalanced(t *testing.T) {
+	c := [][]float64{
+		{1, 3},
+		{2, 1},
+	}
+	// Only the demand of 1 is moved, from the cheapest sources.
+	plan, cost, err := munkres.SolveTransport(c, []float64{2, 2}, []float64{0.5, 0.5})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if plan[0][0] != 0.5 || plan[1][1] != 0.5 || cost != 1 {
+		t.Errorf("want the diagonal at cost 1 got %v at %f", plan, cost)
+	}
+	if _, _, err := munkres.SolveTransport(c, []float64{-1, 2}, []float64{1, 1}); err != munkres.ErrorNegativeQuantity {
+		t.Errorf("want err = %s got %v", munkres.ErrorNegativeQuantity, err)
+	}
+	if _, _, err := munkres.SolveTransport(c, []float64{1}, []float64{1, 1}); err != munkres.ErrorDimensionMismatch {
+		t.Errorf("want err = %s got %v", munkres.ErrorDimensionMismatch, err)
+	}
+}
@@ -0,0 +1,132 @@
+package munkres
+
+import (
+	"context"
+	"math"
+)
+
+// The padded square form of the algorithm spends a phase on every worker
+// of the square matrix, so when one side of the cost matrix is at least
+// this many times the other, Execute instead matches the smaller side
+// directly, transposing the problem if there are more workers than jobs.
+const transposeRatio = 2
+
+// return whether Execute should solve the problem by executeRectangular,
+// which supports neither backups, Sinkhorn scaling, rejection, phase hooks
+// nor subscribers.
+func (h *HungarianAlgorithm) rectangular() bool {
+	if h.opts.backups || h.opts.sinkhorn.iterations > 0 || h.opts.reject != nil ||
+		h.opts.phaseHooks() || h.opts.subscriber != nil {
+		return false
+	}
+	if h.rows == 0 || h.cols == 0 {
+		return false
+	}
+	return h.cols >= transposeRatio*h.rows || h.rows >= transposeRatio*h.cols
+}
+
+// Execute the algorithm by matching each worker, or each job if there are
+// fewer jobs, by a shortest augmenting path in the unpadded matrix, in
+// time O(m^2 n) rather than O(n^3), where m and n are the lesser and
+// greater of the numbers of workers and jobs, stopping between phases if
+// ctx is done or Stop is called. The labels and matching are left as the
+// padded form of the algorithm would leave them, whether the solve
+// completes or not, so that a stopped solve continues in that form.
+//
+// return the error from stopping if the solve is stopped, or
+// ErrorInfeasible if forbidden assignments leave no complete matching of
+// the smaller side.
+func (h *HungarianAlgorithm) executeRectangular(ctx context.Context) error {
+	// Each phase of augmentRows first reads the row it matches, so the
+	// phases are counted, and the solve stopped between them, by the
+	// rows read for the first time.
+	started := 0
+	begin := func(w int) error {
+		if w == started {
+			if started > 0 {
+				h.progress.phase()
+				if err := h.stopping(ctx); err != nil {
+					return err
+				}
+			}
+			started++
+		}
+		return nil
+	}
+	if h.rows <= h.cols {
+		h.progress.start(h.rows)
+		labelByWorker, labelByJob, jobByWorker, err := augmentRows(h.rows, h.cols, math.Inf(1),
+			func(w int) ([]float64, error) {
+				if err := begin(w); err != nil {
+					return nil, err
+				}
+				return h.row(w)[:h.cols], nil
+			})
+		if err == ErrorInfeasible {
+			return err
+		}
+		copy(h.labelByWorker, labelByWorker)
+		copy(h.labelByJob, labelByJob)
+		for w, j := range jobByWorker {
+			if j != -1 {
+				h.match(w, j)
+			}
+		}
+		return h.finishRectangular(err)
+	}
+	// Solve the transposed problem, in which the jobs are matched to
+	// the workers, reading each column of the matrix as a row.
+	h.progress.start(h.cols)
+	column := make([]float64, h.rows)
+	labelByJob, labelByWorker, workerByJob, err := augmentRows(h.cols, h.rows, math.Inf(1),
+		func(j int) ([]float64, error) {
+			if err := begin(j); err != nil {
+				return nil, err
+			}
+			for w := range column {
+				column[w] = h.costs[w*h.dim+j]
+			}
+			return column, nil
+		})
+	if err == ErrorInfeasible {
+		return err
+	}
+	copy(h.labelByWorker, labelByWorker)
+	copy(h.labelByJob, labelByJob)
+	for j, w := range workerByJob {
+		if w != -1 {
+			h.match(w, j)
+		}
+	}
+	return h.finishRectangular(err)
+}
+
+// Finish a solve by executeRectangular whose matching is in place, which
+// was stopped with err, or completed if err is nil.
+//
+// return err.
+func (h *HungarianAlgorithm) finishRectangular(err error) error {
+	if err != nil {
+		h.interrupted = true
+		return err
+	}
+	h.progress.phase()
+	h.padMatching()
+	return nil
+}
+
+// Match the unmatched workers to the unmatched jobs in order. Each such
+// pair involves a padding worker or job, and both have zero labels, so
+// the matching remains optimal.
+func (h *HungarianAlgorithm) padMatching() {
+	j := 0
+	for w := range h.matchJobByWorker {
+		if h.matchJobByWorker[w] != -1 {
+			continue
+		}
+		for h.matchWorkerByJob[j] != -1 {
+			j++
+		}
+		h.match(w, j)
+	}
+}
@@ -0,0 +1,54 @@
+package munkres_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/charles-haynes/munkres"
+)
+
+func TestUnbalanced(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 50; i++ {
+		rows, cols := 1+rng.Intn(10), 20+rng.Intn(20)
+		if i%2 == 1 {
+			rows, cols = cols, rows
+		}
+		c := randomMatrix(rng, rows, cols)
+		// Backups need the padded form, so solve that way too.
+		h, _ := munkres.NewHungarianAlgorithm(c, munkres.WithBackups())
+		want, _ := munkres.ComputeCost(c, h.Execute())
+		h, _ = munkres.NewHungarianAlgorithm(c)
+		got, err := munkres.ComputeCost(c, h.Execute())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != want {
+			t.Errorf("%v: want cost = %f got %f", c, want, got)
+		}
+	}
+}
+
+func TestUnbalancedInfeasible(t *testing.T) {
+	b := munkres.NewBipartite()
+	w := b.AddWorker()
+	for i := 0; i < 3; i++ {
+		b.AddJob()
+	}
+	for j := 0; j < 3; j++ {
+		b.Forbid(w, j)
+	}
+	if _, err := b.Solve(); err != munkres.ErrorInfeasible {
+		t.Errorf("want err = %s got %v", munkres.ErrorInfeasible, err)
+	}
+}
+
+func BenchmarkUnbalanced(b *testing.B) {
+	rng := rand.New(rand.NewSource(1))
+	c := randomMatrix(rng, 50, 1000)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		h, _ := munkres.NewHungarianAlgorithm(c)
+		h.Execute()
+	}
+}
@@ -0,0 +1,187 @@
+package munkres
+
+import "math"
+
+// A Number is a type of the costs accepted by SolveNumbers: any integer
+// or floating-point type, or a type defined on one.
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64
+}
+
+// NewHungarianAlgorithmOf constructs an instance of the algorithm for a
+// cost matrix of any Number type, as NewHungarianAlgorithm does for
+// float64 costs, with the same options and the same errors, so that its
+// duals, explanations and the rest are available for integer costs too.
+// The costs are converted to float64, which is exact for integers no
+// greater in magnitude than 2^53; SolveInts solves greater ones exactly.
+func NewHungarianAlgorithmOf[T Number](costMatrix [][]T, opts ...Option) (HungarianAlgorithm, error) {
+	return newHungarianAlgorithmFor(float64s(costMatrix), newOptions(opts))
+}
+
+// return costMatrix converted to float64, or costMatrix itself if its
+// costs are already float64s.
+func float64s[T Number](costMatrix [][]T) [][]float64 {
+	if m, ok := any(costMatrix).([][]float64); ok {
+		return m
+	}
+	m := make([][]float64, len(costMatrix))
+	for w, row := range costMatrix {
+		m[w] = make([]float64, len(row))
+		for j, c := range row {
+			m[w][j] = float64(c)
+		}
+	}
+	return m
+}
+
+// SolveNumbers solves the assignment problem for costs of any Number
+// type, converting each row as it is read rather than the matrix to
+// float64 first. Integer costs are solved exactly in int64 arithmetic, as
+// by SolveInts, and floating-point costs in float64, as by SolveFloat32s,
+// with their errors.
+//
+// return the assignment, which assigns as many workers as Execute would.
+func SolveNumbers[T Number](costMatrix [][]T) (Assignment, error) {
+	// Only integer division truncates a half to zero.
+	if one := T(1); one/2 == 0 {
+		a, _, err := solveIntegers(costMatrix)
+		return a, err
+	}
+	return solveFloats(costMatrix)
+}
+
+// SolveInts solves the assignment problem for integer costs, such as
+// cents or millisecond latencies, exactly in int64 arithmetic, as
+// SolveFixedPoint does, so that ties are broken the same way whatever
+// the magnitude of the costs. Each row is converted as it is read, so no
+// copy of the matrix is made.
+//
+// The costs must be small enough that no sum of them along an augmenting
+// path can overflow, as for SolveFixedPoint, or a *CostError wrapping
+// ErrorCostOverflow is returned.
+//
+// return the assignment, which assigns as many workers as Execute would,
+// and its total cost.
+func SolveInts(costMatrix [][]int) (Assignment, int64, error) {
+	return solveIntegers(costMatrix)
+}
+
+// SolveInt64s solves the assignment problem for int64 costs, as SolveInts
+// does for int costs.
+func SolveInt64s(costMatrix [][]int64) (Assignment, int64, error) {
+	return solveIntegers(costMatrix)
+}
+
+// Solve the assignment problem for costs of an integer type, as SolveInts
+// does.
+func solveIntegers[T Number](costMatrix [][]T) (Assignment, int64, error) {
+	rows, cols := len(costMatrix), 0
+	if rows > 0 {
+		cols = len(costMatrix[0])
+	}
+	n := rows
+	if cols > n {
+		n = cols
+	}
+	limit := math.MaxInt64 / int64(2*n+2)
+	for w, row := range costMatrix {
+		if len(row) != cols {
+			return nil, 0, &ShapeError{Row: w, Cols: len(row), Want: cols}
+		}
+		for j, c := range row {
+			// An unsigned cost too great for an int64 wraps to a
+			// negative one.
+			if v := int64(c); (c > 0) != (v > 0) || v > limit || v < -limit {
+				return nil, 0, &CostError{Row: w, Col: j, Value: float64(c), Err: ErrorCostOverflow}
+			}
+		}
+	}
+	a := Assignment(unassigned(rows))
+	if rows <= cols {
+		_, _, jobByWorker, _ := augmentRows(rows, cols, int64(math.MaxInt64),
+			func(w int) ([]T, error) { return costMatrix[w], nil })
+		copy(a, jobByWorker)
+	} else {
+		column := make([]T, rows)
+		_, _, workerByJob, _ := augmentRows(cols, rows, int64(math.MaxInt64),
+			func(j int) ([]T, error) {
+				for w := range column {
+					column[w] = costMatrix[w][j]
+				}
+				return column, nil
+			})
+		for j, w := range workerByJob {
+			a[w] = j
+		}
+	}
+	total := int64(0)
+	for w, j := range a {
+		if j != -1 {
+			total += int64(costMatrix[w][j])
+		}
+	}
+	return a, total, nil
+}
+
+// SolveFloat32s solves the assignment problem for float32 costs, such as
+// those of a float32 pipeline, without converting the matrix to float64
+// first: each row is converted as it is read, exactly, since every
+// float32 is a float64, and the problem solved in float64 by the shortest
+// augmenting path form of the algorithm.
+//
+// The cost matrix must be rectangular and its costs finite numbers, or
+// the errors of NewHungarianAlgorithm are returned.
+//
+// return the assignment, which assigns as many workers as Execute would.
+func SolveFloat32s(costMatrix [][]float32) (Assignment, error) {
+	return solveFloats(costMatrix)
+}
+
+// Solve the assignment problem for costs of a floating-point type, as
+// SolveFloat32s does.
+func solveFloats[T Number](costMatrix [][]T) (Assignment, error) {
+	rows, cols := len(costMatrix), 0
+	if rows > 0 {
+		cols = len(costMatrix[0])
+	}
+	for w, row := range costMatrix {
+		if len(row) != cols {
+			return nil, &ShapeError{Row: w, Cols: len(row), Want: cols}
+		}
+		for j, c := range row {
+			if err := checkCost(w, j, float64(c), false); err != nil {
+				return nil, err
+			}
+		}
+	}
+	a := Assignment(unassigned(rows))
+	if rows == 0 || cols == 0 {
+		return a, nil
+	}
+	if rows <= cols {
+		_, _, jobByWorker, err := augmentRows(rows, cols, math.Inf(1),
+			func(w int) ([]T, error) { return costMatrix[w], nil })
+		if err != nil {
+			return nil, err
+		}
+		copy(a, jobByWorker)
+		return a, nil
+	}
+	column := make([]T, rows)
+	_, _, workerByJob, err := augmentRows(cols, rows, math.Inf(1),
+		func(j int) ([]T, error) {
+			for w := range column {
+				column[w] = costMatrix[w][j]
+			}
+			return column, nil
+		})
+	if err != nil {
+		return nil, err
+	}
+	for j, w := range workerByJob {
+		a[w] = j
+	}
+	return a, nil
+}
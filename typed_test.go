@@ -0,0 +1,261 @@
+package munkres_test
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/charles-haynes/munkres"
+)
+
+func TestSolveInts(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 100; i++ {
+		rows, cols := 1+rng.Intn(6), 1+rng.Intn(6)
+		c := make([][]int, rows)
+		wide := make([][]int64, rows)
+		for w := range c {
+			c[w] = make([]int, cols)
+			wide[w] = make([]int64, cols)
+			for j := range c[w] {
+				c[w][j] = rng.Intn(2000) - 1000
+				wide[w][j] = int64(c[w][j])
+			}
+		}
+		a, total, err := munkres.SolveInts(c)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := bruteFixedPoint(wide); total != want {
+			t.Fatalf("%v: got total %d, want %d", c, total, want)
+		}
+		sum, n := int64(0), 0
+		for w, j := range a {
+			if j != -1 {
+				sum += int64(c[w][j])
+				n++
+			}
+		}
+		if sum != total || n != assignable(rows, cols) {
+			t.Fatalf("%v: assignment %v has total %d of %d workers", c, a, sum, n)
+		}
+	}
+}
+
+func TestSolveIntsErrors(t *testing.T) {
+	if _, _, err := munkres.SolveInts([][]int{{1, 2}, {3}}); !errors.Is(err, munkres.ErrorIrregularCostMatrix) {
+		t.Errorf("ragged: got %v", err)
+	}
+	_, _, err := munkres.SolveInts([][]int{{1, math.MaxInt64 / 4}})
+	var ce *munkres.CostError
+	if !errors.As(err, &ce) || !errors.Is(err, munkres.ErrorCostOverflow) || ce.Col != 1 {
+		t.Errorf("overflow: got %v", err)
+	}
+	if a, total, err := munkres.SolveInts(nil); err != nil || len(a) != 0 || total != 0 {
+		t.Errorf("empty: got %v %d %v", a, total, err)
+	}
+}
+
+func TestSolveFloat32s(t *testing.T) {
+	rng := rand.New(rand.NewSource(2))
+	for i := 0; i < 100; i++ {
+		rows, cols := 1+rng.Intn(6), 1+rng.Intn(6)
+		c := make([][]float32, rows)
+		wide := make([][]float64, rows)
+		for w := range c {
+			c[w] = make([]float32, cols)
+			wide[w] = make([]float64, cols)
+			for j := range c[w] {
+				c[w][j] = rng.Float32() * 100
+				wide[w][j] = float64(c[w][j])
+			}
+		}
+		a, err := munkres.SolveFloat32s(c)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want, _ := bruteForceCost(wide, func(w, j int) bool { return true })
+		if got := a.Cost(wide); math.Abs(got-want) > 1e-9 {
+			t.Fatalf("%v: got cost %v, want %v", c, got, want)
+		}
+		if n := count(a); n != assignable(rows, cols) {
+			t.Fatalf("%v: %d workers assigned", c, n)
+		}
+	}
+}
+
+func TestSolveFloat32sErrors(t *testing.T) {
+	if _, err := munkres.SolveFloat32s([][]float32{{1}, {2, 3}}); !errors.Is(err, munkres.ErrorIrregularCostMatrix) {
+		t.Errorf("ragged: got %v", err)
+	}
+	nan := float32(math.NaN())
+	if _, err := munkres.SolveFloat32s([][]float32{{1, nan}}); err == nil {
+		t.Error("NaN: got no error")
+	}
+}
+
+func TestSolveInt64s(t *testing.T) {
+	rng := rand.New(rand.NewSource(3))
+	for i := 0; i < 100; i++ {
+		rows, cols := 1+rng.Intn(6), 1+rng.Intn(6)
+		c := make([][]int64, rows)
+		for w := range c {
+			c[w] = make([]int64, cols)
+			for j := range c[w] {
+				// Costs which float64 cannot tell apart.
+				c[w][j] = 1<<55 + rng.Int63n(8)
+			}
+		}
+		a, total, err := munkres.SolveInt64s(c)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := bruteFixedPoint(c); total != want {
+			t.Fatalf("%v: got total %d, want %d", c, total, want)
+		}
+		if n := count(a); n != assignable(rows, cols) {
+			t.Fatalf("%v: %d workers assigned", c, n)
+		}
+	}
+	_, _, err := munkres.SolveInt64s([][]int64{{math.MinInt64}})
+	if !errors.Is(err, munkres.ErrorCostOverflow) {
+		t.Errorf("overflow: got %v", err)
+	}
+}
+
+// A cost type defined on an integer type.
+type cents int32
+
+func TestSolveNumbers(t *testing.T) {
+	rng := rand.New(rand.NewSource(4))
+	for i := 0; i < 100; i++ {
+		rows, cols := 1+rng.Intn(6), 1+rng.Intn(6)
+		small := make([][]int8, rows)
+		money := make([][]cents, rows)
+		single := make([][]float32, rows)
+		wide := make([][]float64, rows)
+		for w := range wide {
+			small[w] = make([]int8, cols)
+			money[w] = make([]cents, cols)
+			single[w] = make([]float32, cols)
+			wide[w] = make([]float64, cols)
+			for j := range wide[w] {
+				c := rng.Intn(200) - 100
+				small[w][j], money[w][j] = int8(c), cents(c)
+				single[w][j], wide[w][j] = float32(c)/4, float64(c)/4
+			}
+		}
+		want, _ := bruteForceCost(wide, func(w, j int) bool { return true })
+		var as [4]munkres.Assignment
+		var errs [4]error
+		as[0], errs[0] = munkres.SolveNumbers(small)
+		as[1], errs[1] = munkres.SolveNumbers(money)
+		as[2], errs[2] = munkres.SolveNumbers(single)
+		as[3], errs[3] = munkres.SolveNumbers(wide)
+		for k, a := range as {
+			if errs[k] != nil {
+				t.Fatal(errs[k])
+			}
+			if got := a.Cost(wide); math.Abs(got-want) > 1e-9 || count(a) != assignable(rows, cols) {
+				t.Fatalf("%v: solution %d %v costs %v, want %v", wide, k, a, got, want)
+			}
+		}
+	}
+	if _, err := munkres.SolveNumbers([][]int8{{1}, {2, 3}}); !errors.Is(err, munkres.ErrorIrregularCostMatrix) {
+		t.Errorf("ragged: got %v", err)
+	}
+	if _, err := munkres.SolveNumbers([][]float64{{1, math.Inf(1)}}); !errors.Is(err, munkres.ErrorInfiniteCost) {
+		t.Errorf("infinite: got %v", err)
+	}
+}
+
+func TestSolveNumbersUnsigned(t *testing.T) {
+	rng := rand.New(rand.NewSource(5))
+	for i := 0; i < 100; i++ {
+		rows, cols := 1+rng.Intn(6), 1+rng.Intn(6)
+		small := make([][]uint8, rows)
+		large := make([][]uint64, rows)
+		wide := make([][]float64, rows)
+		for w := range wide {
+			small[w] = make([]uint8, cols)
+			large[w] = make([]uint64, cols)
+			wide[w] = make([]float64, cols)
+			for j := range wide[w] {
+				c := rng.Intn(256)
+				small[w][j], large[w][j], wide[w][j] = uint8(c), uint64(c), float64(c)
+			}
+		}
+		want, _ := bruteForceCost(wide, func(w, j int) bool { return true })
+		for k, solve := range []func() (munkres.Assignment, error){
+			func() (munkres.Assignment, error) { return munkres.SolveNumbers(small) },
+			func() (munkres.Assignment, error) { return munkres.SolveNumbers(large) },
+		} {
+			a, err := solve()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := a.Cost(wide); got != want || count(a) != assignable(rows, cols) {
+				t.Fatalf("%v: solution %d %v costs %v, want %v", wide, k, a, got, want)
+			}
+		}
+	}
+	// Too great for an int64, so it would wrap to a negative cost.
+	_, err := munkres.SolveNumbers([][]uint64{{1, math.MaxUint64}})
+	var ce *munkres.CostError
+	if !errors.As(err, &ce) || !errors.Is(err, munkres.ErrorCostOverflow) || ce.Col != 1 {
+		t.Errorf("overflow: got %v", err)
+	}
+}
+
+func TestNewHungarianAlgorithmOf(t *testing.T) {
+	rng := rand.New(rand.NewSource(6))
+	for i := 0; i < 50; i++ {
+		rows, cols := 1+rng.Intn(6), 1+rng.Intn(6)
+		money := make([][]cents, rows)
+		wide := make([][]float64, rows)
+		for w := range wide {
+			money[w] = make([]cents, cols)
+			wide[w] = make([]float64, cols)
+			for j := range wide[w] {
+				c := rng.Intn(200) - 100
+				money[w][j], wide[w][j] = cents(c), float64(c)
+			}
+		}
+		h, err := munkres.NewHungarianAlgorithmOf(money, munkres.WithMaximize())
+		if err != nil {
+			t.Fatal(err)
+		}
+		g, err := munkres.NewHungarianAlgorithm(wide, munkres.WithMaximize())
+		if err != nil {
+			t.Fatal(err)
+		}
+		a, b := h.Execute(), g.Execute()
+		if munkres.Assignment(a).Cost(wide) != munkres.Assignment(b).Cost(wide) {
+			t.Fatalf("%v: got %v, want %v", wide, a, b)
+		}
+		hw, hj := h.Duals()
+		gw, gj := g.Duals()
+		for w := range hw {
+			if hw[w] != gw[w] {
+				t.Fatalf("%v: got worker duals %v, want %v", wide, hw, gw)
+			}
+		}
+		for j := range hj {
+			if hj[j] != gj[j] {
+				t.Fatalf("%v: got job duals %v, want %v", wide, hj, gj)
+			}
+		}
+	}
+	if _, err := munkres.NewHungarianAlgorithmOf([][]int{{1}, {2, 3}}); !errors.Is(err, munkres.ErrorIrregularCostMatrix) {
+		t.Errorf("ragged: got %v", err)
+	}
+}
+
+// return the number of workers assigned in a rows x cols problem.
+func assignable(rows, cols int) int {
+	if rows < cols {
+		return rows
+	}
+	return cols
+}
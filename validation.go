@@ -0,0 +1,87 @@
+package munkres
+
+import "math"
+
+// A Validation is how strictly the constructors check a cost matrix.
+type Validation int
+
+const (
+	// DefaultValidation rejects an irregular cost matrix, and one with NaN
+	// or infinite entries.
+	DefaultValidation Validation = iota
+	// StrictValidation rejects everything DefaultValidation does and
+	// negative costs too, for services which must not solve a matrix
+	// built from bad input.
+	StrictValidation
+	// PermissiveValidation pads short rows to the length of the longest
+	// with the largest permitted cost, and clamps NaN and infinite entries,
+	// and those of larger magnitude than the largest permitted cost, to
+	// it, for notebooks which would rather see an answer than an error. A
+	// padded entry, like a NaN, is then the worst choice for its worker.
+	PermissiveValidation
+)
+
+// The largest magnitude of a cost allowed by PermissiveValidation, small
+// enough that sums and differences of costs over the largest practical
+// matrices stay finite.
+const permissiveLimit = 1e150
+
+// WithValidation sets how strictly NewHungarianAlgorithm and
+// NewFromReader check the cost matrix. The default is DefaultValidation.
+// Entries replaced by WithSanitize are replaced before validation, and
+// +Inf entries which forbid an assignment are not clamped.
+func WithValidation(v Validation) Option {
+	return func(o *options) {
+		o.validation = v
+	}
+}
+
+// return whether the validation is one of the defined values.
+func (v Validation) valid() bool {
+	return v >= DefaultValidation && v <= PermissiveValidation
+}
+
+// return a copy of the cost matrix with its rows padded and its entries
+// clamped as for PermissiveValidation.
+func (o *options) permit(costMatrix [][]float64) [][]float64 {
+	cols := 0
+	for _, row := range costMatrix {
+		if len(row) > cols {
+			cols = len(row)
+		}
+	}
+	permitted := make([][]float64, len(costMatrix))
+	for w, row := range costMatrix {
+		permitted[w] = make([]float64, cols)
+		copy(permitted[w], row)
+		for j := len(row); j < cols; j++ {
+			permitted[w][j] = permissiveLimit
+		}
+		o.clampRow(permitted[w])
+	}
+	return permitted
+}
+
+// Clamp the entries of row in place as for PermissiveValidation.
+func (o *options) clampRow(row []float64) {
+	for j, c := range row {
+		switch {
+		case math.IsInf(c, 1) && o.forbidden:
+		case math.IsNaN(c) || c > permissiveLimit:
+			row[j] = permissiveLimit
+		case c < -permissiveLimit:
+			row[j] = -permissiveLimit
+		}
+	}
+}
+
+// Check row w of a cost matrix as for StrictValidation, in addition to
+// the checks of checkRow.
+func checkStrictRow(w int, row []float64) error {
+	for j, c := range row {
+		if c < 0 {
+			return &CostError{Row: w, Col: j, Value: c, Err: ErrorNegativeCost}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,75 @@
+package munkres_test
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/charles-haynes/munkres"
+)
+
+func TestStrictValidation(t *testing.T) {
+	strict := munkres.WithValidation(munkres.StrictValidation)
+	if _, err := munkres.NewHungarianAlgorithm([][]float64{{1, 2}, {3, 4}}, strict); err != nil {
+		t.Errorf("want no error got %v", err)
+	}
+	for _, d := range []struct {
+		name string
+		c    [][]float64
+		err  error
+	}{
+		{"negative", [][]float64{{1, -2}, {3, 4}}, munkres.ErrorNegativeCost},
+		{"ragged", [][]float64{{1, 2}, {3}}, munkres.ErrorIrregularCostMatrix},
+		{"infinite", [][]float64{{math.Inf(1)}}, munkres.ErrorInfiniteCost},
+	} {
+		if _, err := munkres.NewHungarianAlgorithm(d.c, strict); !errors.Is(err, d.err) {
+			t.Errorf("%s: want err = %v got %v", d.name, d.err, err)
+		}
+	}
+	// Negative costs are still allowed by default.
+	if _, err := munkres.NewHungarianAlgorithm([][]float64{{-1}}); err != nil {
+		t.Errorf("want no error by default got %v", err)
+	}
+	_, err := munkres.NewFromReader(strings.NewReader("1,2\n3,-4\n"), munkres.FormatCSV, strict)
+	var perr *munkres.ParseError
+	if !errors.As(err, &perr) || perr.Row != 1 || !errors.Is(err, munkres.ErrorNegativeCost) {
+		t.Errorf("want a negative cost on row 1 got %v", err)
+	}
+}
+
+func TestPermissiveValidation(t *testing.T) {
+	permissive := munkres.WithValidation(munkres.PermissiveValidation)
+	// The short row is padded, so worker 1 avoids job 1.
+	c := [][]float64{{2, 1}, {1}}
+	h, err := munkres.NewHungarianAlgorithm(c, permissive)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want, got := []int{1, 0}, h.Execute(); !reflect.DeepEqual(want, got) {
+		t.Errorf("want %v got %v", want, got)
+	}
+	c = [][]float64{{math.NaN(), 1}, {math.Inf(-1), math.Inf(1)}}
+	h, err = munkres.NewHungarianAlgorithm(c, permissive)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want, got := []int{1, 0}, h.Execute(); !reflect.DeepEqual(want, got) {
+		t.Errorf("want %v got %v", want, got)
+	}
+	h, err = munkres.NewFromReader(strings.NewReader("NaN,1\n1\n"), munkres.FormatCSV, permissive)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want, got := []int{1, 0}, h.Execute(); !reflect.DeepEqual(want, got) {
+		t.Errorf("want %v got %v", want, got)
+	}
+}
+
+func TestWithValidationInvalid(t *testing.T) {
+	opt := munkres.WithValidation(munkres.Validation(-1))
+	if _, err := munkres.NewHungarianAlgorithm([][]float64{{1}}, opt); err != munkres.ErrorInvalidOption {
+		t.Errorf("want err = %s got %v", munkres.ErrorInvalidOption, err)
+	}
+}
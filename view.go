@@ -0,0 +1,157 @@
+package munkres
+
+// A Matrix is a cost matrix read one entry at a time, which need not be
+// held in memory as a [][]float64. Its methods are those of the matrices
+// of gonum, which therefore satisfy it.
+type Matrix interface {
+	// Dims returns the number of rows and columns.
+	Dims() (rows, cols int)
+	// At returns the cost of assigning worker w to job j.
+	At(w, j int) float64
+}
+
+// SolveMatrix solves the assignment problem for a Matrix by the shortest
+// augmenting path form of the Hungarian algorithm, which reads it one row
+// at a time, never copying it whole, and needs only O(n) further memory.
+// The costs are subject to the same restrictions as for
+// NewHungarianAlgorithm.
+//
+// return the assignment as for Execute.
+func SolveMatrix(m Matrix) ([]int, error) {
+	rows, cols := m.Dims()
+	if rows < 0 || cols < 0 {
+		return nil, ErrorDimensionMismatch
+	}
+	src := &matrixRows{m: m, buf: make([]float64, cols)}
+	for w := 0; w < rows; w++ {
+		row, _ := src.row(w)
+		if err := checkRow(w, row, false); err != nil {
+			return nil, err
+		}
+	}
+	return solveRows(rows, cols, src)
+}
+
+// A rowSource reading the rows of a Matrix into a buffer.
+type matrixRows struct {
+	m   Matrix
+	buf []float64
+}
+
+func (s *matrixRows) row(w int) ([]float64, error) {
+	for j := range s.buf {
+		s.buf[j] = s.m.At(w, j)
+	}
+	return s.buf, nil
+}
+
+// A View is a Matrix showing the rows and columns of a base cost matrix
+// which are not masked, with some of their costs overridden, so that
+// what-if explorations of a large matrix never copy or modify it. Rows
+// and columns of the view are numbered in the order of the base matrix,
+// skipping the masked ones.
+type View struct {
+	base     [][]float64
+	maskRows []bool
+	maskCols []bool
+	// The rows and columns of the base matrix shown, which are
+	// recomputed when the masks change.
+	rows, cols []int
+	// The costs overridden, by row and column of the base matrix.
+	overrides map[[2]int]float64
+}
+
+// NewView returns a view of the whole of base, which is subject to the
+// same restrictions as for NewHungarianAlgorithm and must not be modified
+// while the view is in use.
+func NewView(base [][]float64) (*View, error) {
+	if err := checkCostMatrix(base, false); err != nil {
+		return nil, err
+	}
+	cols := 0
+	if len(base) > 0 {
+		cols = len(base[0])
+	}
+	v := &View{
+		base:      base,
+		maskRows:  make([]bool, len(base)),
+		maskCols:  make([]bool, cols),
+		overrides: map[[2]int]float64{},
+	}
+	v.rows, v.cols = shown(v.maskRows), shown(v.maskCols)
+	return v, nil
+}
+
+// return the indices which are not masked.
+func shown(masked []bool) []int {
+	indices := []int{}
+	for i, m := range masked {
+		if !m {
+			indices = append(indices, i)
+		}
+	}
+	return indices
+}
+
+// MaskRow hides or shows row w of the base matrix.
+func (v *View) MaskRow(w int, masked bool) {
+	v.maskRows[w] = masked
+	v.rows = shown(v.maskRows)
+}
+
+// MaskCol hides or shows column j of the base matrix.
+func (v *View) MaskCol(j int, masked bool) {
+	v.maskCols[j] = masked
+	v.cols = shown(v.maskCols)
+}
+
+// Override replaces the cost at row w and column j of the base matrix,
+// which must be valid as for NewHungarianAlgorithm.
+func (v *View) Override(w, j int, cost float64) error {
+	if w < 0 || w >= len(v.maskRows) || j < 0 || j >= len(v.maskCols) {
+		return ErrorInvalidOption
+	}
+	if err := checkCost(w, j, cost, false); err != nil {
+		return err
+	}
+	v.overrides[[2]int{w, j}] = cost
+	return nil
+}
+
+// ClearOverride restores the cost at row w and column j of the base
+// matrix.
+func (v *View) ClearOverride(w, j int) {
+	delete(v.overrides, [2]int{w, j})
+}
+
+// Dims returns the number of rows and columns shown.
+func (v *View) Dims() (rows, cols int) {
+	return len(v.rows), len(v.cols)
+}
+
+// At returns the cost at row w and column j of the view.
+func (v *View) At(w, j int) float64 {
+	w, j = v.rows[w], v.cols[j]
+	if cost, ok := v.overrides[[2]int{w, j}]; ok {
+		return cost
+	}
+	return v.base[w][j]
+}
+
+// Solve solves the assignment problem for the view, as for SolveMatrix.
+//
+// return the column of the base matrix assigned to each of its rows, or
+// -1 if the row is masked or unassigned.
+func (v *View) Solve() ([]int, error) {
+	a, err := SolveMatrix(v)
+	if err != nil {
+		return nil, err
+	}
+	jobByWorker := unassigned(len(v.base))
+	for w, j := range a {
+		if j != -1 {
+			jobByWorker[v.rows[w]] = v.cols[j]
+		}
+	}
+	return jobByWorker, nil
+}
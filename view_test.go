@@ -0,0 +1,97 @@
+package munkres_test
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/charles-haynes/munkres"
+)
+
+// A Matrix held in row-major order.
+type flat struct {
+	costs      []float64
+	rows, cols int
+}
+
+func (m flat) Dims() (int, int) {
+	return m.rows, m.cols
+}
+
+func (m flat) At(w, j int) float64 {
+	return m.costs[w*m.cols+j]
+}
+
+func TestSolveMatrix(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 50; i++ {
+		c := randomMatrix(rng, 1+rng.Intn(10), 1+rng.Intn(10))
+		m := flat{rows: len(c), cols: len(c[0])}
+		for _, row := range c {
+			m.costs = append(m.costs, row...)
+		}
+		h, _ := munkres.NewHungarianAlgorithm(c)
+		want, _ := munkres.ComputeCost(c, h.Execute())
+		a, err := munkres.SolveMatrix(m)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, err := munkres.ComputeCost(c, a); err != nil || math.Abs(got-want) > 1e-9 {
+			t.Errorf("%v: want cost %f got %f (%v)", c, want, got, err)
+		}
+	}
+	m := flat{costs: []float64{1, math.Inf(1)}, rows: 1, cols: 2}
+	if _, err := munkres.SolveMatrix(m); !errors.Is(err, munkres.ErrorInfiniteCost) {
+		t.Errorf("want %v got %v", munkres.ErrorInfiniteCost, err)
+	}
+}
+
+func TestView(t *testing.T) {
+	c := [][]float64{
+		{1, 9, 9, 9},
+		{9, 1, 9, 9},
+		{9, 9, 1, 9},
+		{9, 9, 9, 1},
+	}
+	v, err := munkres.NewView(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	v.MaskRow(1, true)
+	v.MaskCol(0, true)
+	if err := v.Override(3, 3, 20); err != nil {
+		t.Fatal(err)
+	}
+	if rows, cols := v.Dims(); rows != 3 || cols != 3 {
+		t.Fatalf("want 3x3 got %dx%d", rows, cols)
+	}
+	if got := v.At(2, 2); got != 20 {
+		t.Errorf("want overridden cost 20 got %f", got)
+	}
+	// Worker 0 has lost its job, and worker 3 its cheap one.
+	want := []int{3, -1, 2, 1}
+	if got, err := v.Solve(); err != nil || !reflect.DeepEqual(got, want) {
+		t.Errorf("want %v got %v (%v)", want, got, err)
+	}
+	v.ClearOverride(3, 3)
+	v.MaskRow(1, false)
+	v.MaskCol(0, false)
+	want = []int{0, 1, 2, 3}
+	if got, err := v.Solve(); err != nil || !reflect.DeepEqual(got, want) {
+		t.Errorf("want %v got %v (%v)", want, got, err)
+	}
+	if c[3][3] != 1 {
+		t.Errorf("base matrix modified")
+	}
+	if err := v.Override(0, 4, 1); err != munkres.ErrorInvalidOption {
+		t.Errorf("want %v got %v", munkres.ErrorInvalidOption, err)
+	}
+	if err := v.Override(0, 0, math.NaN()); !errors.Is(err, munkres.ErrorNaNCost) {
+		t.Errorf("want %v got %v", munkres.ErrorNaNCost, err)
+	}
+	if _, err := munkres.NewView([][]float64{{1}, {}}); !errors.Is(err, munkres.ErrorIrregularCostMatrix) {
+		t.Errorf("want %v got %v", munkres.ErrorIrregularCostMatrix, err)
+	}
+}